@@ -2,13 +2,21 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	stdlog "log"
+	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 	"syscall"
+	"text/tabwriter"
 	"time"
 
 	cmdConfig "github.com/InkyQuill/gitlab-mcp-server/cmd/config"
@@ -31,7 +39,12 @@ var version = "dev"
 var commit = "none"
 var date = "unknown"
 
+// configFileName is the base name (without extension) of the optional YAML/TOML config file
+const configFileName = "gitlab-mcp-server"
+
 var (
+	cfgFile string
+
 	rootCmd = &cobra.Command{
 		Use:   "gitlab-mcp-server",
 		Short: "GitLab MCP Server",
@@ -43,6 +56,13 @@ This server supports multiple GitLab instances and can be configured via:
 - Environment variables (GITLAB_TOKEN, GITLAB_HOST)
 - Project-specific .gmcprc files`,
 		Version: fmt.Sprintf("Version: %s\nCommit: %s\nBuild Date: %s", version, commit, date),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if list, _ := cmd.Flags().GetBool("list-tools"); list {
+				output, _ := cmd.Flags().GetString("output")
+				return listTools(cmd.OutOrStdout(), output)
+			}
+			return cmd.Help()
+		},
 	}
 
 	versionCmd = &cobra.Command{
@@ -64,294 +84,23 @@ This server supports multiple GitLab instances and can be configured via:
 		Short: "Start server communicating via standard input/output",
 		Long:  `Starts the GitLab MCP server, listening for JSON-RPC messages on stdin and sending responses to stdout.`,
 		Run: func(_ *cobra.Command, _ []string) {
-			// Check if secure memory is enabled
-			useSecureMemory := viper.GetBool("use-secure-memory")
-			if useSecureMemory {
-				// Initialize memguard for secure memory handling
-				memguard.CatchInterrupt()
-				defer memguard.Purge()
-			}
-
-			// Initialize Logger
-			logLevel := viper.GetString("log.level")
-			logFile := viper.GetString("log.file")
-			logger, err := initLogger(logLevel, logFile)
-			if err != nil {
-				stdlog.Fatalf("Failed to initialize logger: %v", err)
-			}
-			logger.Info("Logger initialized")
-
-			if useSecureMemory {
-				logger.Info("Secure memory (memguard) enabled - tokens will be stored in encrypted memory")
-			}
-
-			// Initialize Translations
-			t, dumpTranslations := translations.TranslationHelper(logger)
-			defer func() {
-				if viper.GetBool("export-translations") {
-					logger.Info("Exporting translations...")
-					dumpTranslations()
-				}
-			}()
-
-			// Handle export-translations flag
-			if viper.GetBool("export-translations") {
-				logger.Info("Exporting translations and exiting...")
-				dumpTranslations()
-				return
-			}
-
-			// Initialize Signal Handling
-			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
-			defer stop()
-			logger.Info("Signal handling initialized")
-
-			logger.Info("Starting main execution flow...")
-
-			// Build the secret backend registry.
-			registry := config.NewBackendRegistry()
-			if err := registry.Register(config.NewKeyringBackend("gitlab-mcp-server")); err != nil {
-				logger.Warnf("Failed to register keyring backend: %v", err)
-			}
-			cfgManager, err := config.NewManagerWithRegistry("", registry)
-			if err != nil {
-				logger.Warnf("Failed to create config manager: %v", err)
-			}
-
-			// If config has external-cmd templates, register those schemes too.
-			if cfgManager != nil && cfgManager.Config().Backends != nil &&
-				len(cfgManager.Config().Backends.External) > 0 {
-				ext := config.NewExternalCmdBackend(cfgManager.Config().Backends.External)
-				if err := ext.RegisterAll(registry); err != nil {
-					logger.Warnf("Failed to register external-cmd backends: %v", err)
-				} else {
-					logger.Infof("Registered external-cmd schemes: %v", ext.Schemes())
-				}
-			}
-
-			// If any server uses file:// refs, register the encrypted-file backend with its path.
-			if cfgManager != nil {
-				for _, s := range cfgManager.ListServers() {
-					if strings.HasPrefix(s.TokenRef, "file://") {
-						if path, _, refErr := parseFileRefFromRef(s.TokenRef); refErr == nil {
-							crypto, cerr := config.NewCryptoManager(true)
-							if cerr == nil {
-								fb, ferr := config.NewEncryptedFileBackend(path, crypto)
-								if ferr == nil {
-									_ = registry.Register(fb)
-								}
-							}
-							break // one file backend suffices
-						}
-					}
-				}
-			}
-
-			hasConfigServers := false
-			if cfgManager != nil && cfgManager.ServerCount() > 0 {
-				hasConfigServers = true
-				logger.Infof("Loaded global config with %d server(s)", cfgManager.ServerCount())
-			}
-
-			// Get configuration from flags/env (for backward compatibility)
-			token := viper.GetString("token")
-			host := viper.GetString("host")
-			readOnly := viper.GetBool("read-only")
-
-			// Initialize Token Store with secure memory option
-			var tokenStore *gitlab.TokenStore
-			if useSecureMemory {
-				tokenStore = gitlab.NewTokenStoreWithConfig(gitlab.TokenStoreConfig{
-					UseSecureMemory: true,
-					Logger:          logger,
-				})
-			} else {
-				tokenStore = gitlab.NewTokenStore()
-			}
-			logger.Info("Token store initialized")
-
-			// Initialize Client Pool
-			clientPool := gitlab.NewClientPool(tokenStore, logger)
-			logger.Info("Client pool initialized")
-
-			// Priority 1: Use global config if available and has servers
-			defaultServer := "default"
-			if hasConfigServers {
-				servers := cfgManager.ListServers()
-				resolveToken := func(ctx context.Context, name string) (string, error) {
-					return cfgManager.ResolveServerToken(ctx, name)
-				}
-				for _, serverCfg := range servers {
-					if err := clientPool.AddServerFromConfig(ctx, serverCfg, resolveToken); err != nil {
-						logger.Warnf("Failed to initialize client '%s': %v", serverCfg.Name, err)
-					} else {
-						logger.Infof("Added client '%s' from config", serverCfg.Name)
-						if serverCfg.IsDefault {
-							defaultServer = serverCfg.Name
-						}
-					}
-				}
-			}
-
-			// Priority 2: Fallback to environment variables (backward compatibility)
-			if token != "" {
-				logger.Warn("DEPRECATION: GITLAB_TOKEN env var usage is deprecated and will be removed in v3.0. " +
-					"Run 'gitlab-mcp-server config add <name> --host <url>' to migrate to the global config. " +
-					"See docs/MULTI_SERVER_SETUP.md.")
-				logger.Info("Using environment variables for client initialization")
-				if err := clientPool.InitializeFromEnv(ctx, token, host); err != nil {
-					logger.Fatalf("Failed to initialize client from environment: %v", err)
-				}
-				logger.Info("GitLab client initialized from environment")
-			}
-
-			// Check if we have any clients
-			clientList := clientPool.ListClients()
-			if len(clientList) == 0 {
-				logger.Fatal("No clients configured. Please either:")
-				logger.Fatal("  1. Run 'gitlab-mcp-server config add' to add servers")
-				logger.Fatal("  2. Set GITLAB_TOKEN environment variable")
-			}
-
-			// Get toolsets
-			var enabledToolsets []string
-			toolsetsStr := viper.GetString("toolsets")
-			if toolsetsStr != "" {
-				enabledToolsets = strings.Split(toolsetsStr, ",")
-			} else {
-				enabledToolsets = gitlab.DefaultTools
-				logger.Infof("No toolsets specified via config/env, using default: %v", enabledToolsets)
-			}
-
-			logger.Infof("Enabled toolsets: %v", enabledToolsets)
-			logger.Infof("Read-only mode: %t", readOnly)
-
-			// Validate default token on startup
-			logger.Info("Validating GitLab token...")
-			glClient, serverName, err := clientPool.GetDefaultClient()
-			if err != nil {
-				logger.Warnf("Failed to get default client: %v", err)
-			} else {
-				// Use the actual server name from the pool
-				if serverName == "" || serverName == "default" {
-					serverName = defaultServer
-				}
-
-				// Try to get token for validation
-				var tokenToValidate string
-				if hasConfigServers {
-					if serverCfg, err := cfgManager.GetServer(serverName); err == nil {
-						tokenToValidate = serverCfg.Token
-					}
-				}
-				if tokenToValidate == "" {
-					tokenToValidate = token
-				}
-
-				if tokenToValidate != "" {
-					tokenMetadata, err := validateTokenOnStartup(ctx, glClient, tokenToValidate)
-					if err != nil {
-						logger.Warnf("Token validation warning: %v", err)
-					} else {
-						tokenMetadata.Name = serverName
-						if host != "" {
-							tokenMetadata.GitLabHost = host
-						}
-						if addErr := tokenStore.AddToken(serverName, tokenMetadata); addErr != nil {
-							logger.Warnf("Failed to store token metadata: %v", addErr)
-						} else {
-							logger.Infof("Token validated successfully for user %s (ID: %d) on server '%s'",
-								tokenMetadata.Username, tokenMetadata.UserID, serverName)
-							if tokenMetadata.ExpiresAt != nil {
-								daysUntil := tokenMetadata.DaysUntilExpiry()
-								if daysUntil > 0 && daysUntil <= 30 {
-									logger.Warnf("Token will expire in %d days. Please create a new token and update it.", daysUntil)
-								}
-							}
-						}
-					}
-				}
-			}
-
-			// Create Client Resolver — strict (opt-in via env) or legacy (default).
-			var resolverFn gitlab.GetClientFn
-			if os.Getenv("GITLAB_MCP_STRICT_RESOLVER") == "1" {
-				hostsByName := map[string]string{}
-				if hasConfigServers {
-					for _, s := range cfgManager.ListServers() {
-						hostsByName[s.Name] = s.Host
-					}
-				}
-				sr := gitlab.NewStrictResolver(clientPool, hostsByName, logger)
-				resolverFn = sr.GetClientFn()
-				logger.Info("Strict resolver enabled (GITLAB_MCP_STRICT_RESOLVER=1) — no fallbacks, host verified per session")
-			} else {
-				resolver := gitlab.NewClientResolver(clientPool, defaultServer, logger)
-				resolverFn = resolver.GetClientFn()
-				logger.Infof("Client resolver initialized with default server '%s' (legacy — set GITLAB_MCP_STRICT_RESOLVER=1 for strict mode)", defaultServer)
-			}
-
-			// Check if dynamic toolsets mode is enabled
-			dynamicToolsets := viper.GetBool("dynamic-toolsets")
-
-			// Initialize Toolsets
-			toolsetGroup, err := gitlab.InitToolsets(enabledToolsets, readOnly, resolverFn, logger, tokenStore, t, dynamicToolsets)
-			if err != nil {
-				logger.Fatalf("Failed to initialize toolsets: %v", err)
-			}
-			logger.Info("Toolsets initialized")
-
-			// Create MCP Server
-			mcpServer := gitlab.NewServer("gitlab-mcp-server", version)
-			logger.Info("MCP server wrapper created")
-
-			// Register toolsets with the server
-			if dynamicToolsets {
-				dynamicManager := gitlab.NewDynamicToolsetManager(toolsetGroup, mcpServer, logger)
-				dynamicManager.SetDynamicMode(true)
-				dynamicManager.RegisterDiscoveryTools()
-				logger.Info("Dynamic toolset discovery tools registered")
-			} else {
-				toolsetGroup.RegisterTools(mcpServer)
-				logger.Info("Toolsets registered with MCP server")
-			}
+			runServer(serveStdio)
+		},
+	}
 
-			// Create Stdio Server
-			stdioServer := server.NewStdioServer(mcpServer)
-			stdioLogger := stdlog.New(logger.Writer(), "[StdioServer] ", 0)
-			stdioServer.SetErrorLogger(stdioLogger)
-			logger.Info("Stdio server transport created")
-
-			// Start Listening in a goroutine
-			errC := make(chan error, 1)
-			go func() {
-				logger.Info("Starting to listen on stdio...")
-				in, out := io.Reader(os.Stdin), io.Writer(os.Stdout)
-				if viper.GetBool("enable-command-logging") {
-					logger.Warn("Command logging enabled - sensitive data will be redacted but not guaranteed")
-					loggedIO := iolog.NewIOLogger(in, out, logger)
-					in, out = loggedIO, loggedIO
-				}
-				errC <- stdioServer.Listen(ctx, in, out)
-			}()
-
-			// Announce readiness on stderr
-			fmt.Fprintf(os.Stderr, "GitLab MCP Server running on stdio (Version: %s, Commit: %s)\n", version, commit)
-			logger.Info("Server running, waiting for requests or signals...")
-
-			// Wait for shutdown signal or server error
-			select {
-			case <-ctx.Done():
-				logger.Info("Shutdown signal received, context cancelled.")
-			case err := <-errC:
-				if err != nil && err != context.Canceled {
-					logger.Errorf("Server encountered an error: %v", err)
-				} else {
-					logger.Info("Server listener stopped gracefully.")
-				}
+	httpCmd = &cobra.Command{
+		Use:   "http",
+		Short: "Start server communicating via HTTP with Server-Sent Events",
+		Long: `Starts the GitLab MCP server as an HTTP service. Clients open an SSE stream at /sse
+and post JSON-RPC messages to /message. Pass --tls-cert and --tls-key to serve HTTPS.`,
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			if (viper.GetString("http.tls-cert") == "") != (viper.GetString("http.tls-key") == "") {
+				return fmt.Errorf("--tls-cert and --tls-key must be provided together")
 			}
-
-			logger.Info("Server shutting down.")
+			return nil
+		},
+		Run: func(_ *cobra.Command, _ []string) {
+			runServer(serveHTTP)
 		},
 	}
 )
@@ -363,16 +112,22 @@ func init() {
 	rootCmd.SetVersionTemplate("{{.Short}}\n{{.Version}}\n")
 
 	// Define persistent flags for the root command (and inherited by subcommands)
+	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "Optional: Path to a YAML or TOML config file (default: search for gitlab-mcp-server.yaml/.toml)")
 	rootCmd.PersistentFlags().StringSlice("toolsets", gitlab.DefaultTools, "Comma-separated list of toolsets to enable (e.g., 'projects,issues' or 'all')")
 	rootCmd.PersistentFlags().Bool("read-only", false, "Restrict the server to read-only operations")
 	rootCmd.PersistentFlags().String("gitlab-host", "", "Optional: Specify the GitLab hostname for self-managed instances (e.g., gitlab.example.com)")
 	rootCmd.PersistentFlags().String("gitlab-token", "", "GitLab Personal Access Token (required if not using config)")
 	rootCmd.PersistentFlags().String("log-file", "", "Optional: Path to write log output to a file")
 	rootCmd.PersistentFlags().String("log-level", "info", "Log level (e.g., debug, info, warn, error)")
+	rootCmd.PersistentFlags().String("log-format", "text", "Log output format (text or json)")
 	rootCmd.PersistentFlags().Bool("enable-command-logging", false, "Enable logging of all MCP JSON-RPC requests/responses to stderr (WARNING: may contain sensitive data)")
 	rootCmd.PersistentFlags().Bool("export-translations", false, "Generate gitlab-mcp-server-config.json with all translation keys and exit")
 	rootCmd.PersistentFlags().Bool("dynamic-toolsets", false, "Enable dynamic toolset discovery (toolsets loaded on-demand)")
+	rootCmd.PersistentFlags().Duration("request-timeout", gitlab.DefaultRequestTimeout, "Maximum duration of a single tool invocation, including GitLab API calls (0 disables the limit)")
+	rootCmd.PersistentFlags().Int("token-expiry-warning-days", gitlab.DefaultTokenExpiryWarningDays, "Warn when the GitLab token expires within this many days")
 	rootCmd.PersistentFlags().Bool("use-secure-memory", false, "Use secure memory (memguard) for storing tokens in encrypted memory to prevent swapping to disk")
+	rootCmd.PersistentFlags().String("ca-cert", "", "Optional: Path to a PEM file with CA certificates to trust for the GitLab server, in addition to the system's")
+	rootCmd.PersistentFlags().Bool("insecure-skip-tls-verify", false, "Skip TLS certificate verification of the GitLab server (INSECURE: development only, refused for gitlab.com)")
 
 	// Bind persistent flags to Viper
 	_ = viper.BindPFlag("toolsets", rootCmd.PersistentFlags().Lookup("toolsets"))
@@ -381,14 +136,43 @@ func init() {
 	_ = viper.BindPFlag("token", rootCmd.PersistentFlags().Lookup("gitlab-token"))
 	_ = viper.BindPFlag("log.file", rootCmd.PersistentFlags().Lookup("log-file"))
 	_ = viper.BindPFlag("log.level", rootCmd.PersistentFlags().Lookup("log-level"))
+	_ = viper.BindPFlag("log.format", rootCmd.PersistentFlags().Lookup("log-format"))
 	_ = viper.BindPFlag("enable-command-logging", rootCmd.PersistentFlags().Lookup("enable-command-logging"))
 	_ = viper.BindPFlag("export-translations", rootCmd.PersistentFlags().Lookup("export-translations"))
 	_ = viper.BindPFlag("dynamic-toolsets", rootCmd.PersistentFlags().Lookup("dynamic-toolsets"))
 	_ = viper.BindPFlag("use-secure-memory", rootCmd.PersistentFlags().Lookup("use-secure-memory"))
+	_ = viper.BindPFlag("request_timeout", rootCmd.PersistentFlags().Lookup("request-timeout"))
+	_ = viper.BindPFlag("token_expiry_warning_days", rootCmd.PersistentFlags().Lookup("token-expiry-warning-days"))
+	_ = viper.BindPFlag("ca_cert", rootCmd.PersistentFlags().Lookup("ca-cert"))
+	_ = viper.BindPFlag("insecure_skip_tls_verify", rootCmd.PersistentFlags().Lookup("insecure-skip-tls-verify"))
+
+	// Settings configurable via environment (GITLAB_ prefix) or config file only
+	viper.SetDefault("max_retries", gitlab.DefaultMaxRetries)
+	viper.SetDefault("rate_limit_threshold", gitlab.DefaultRateLimitThreshold)
+
+	// Define local flags for the root command
+	rootCmd.Flags().Bool("list-tools", false, "Print the tools enabled by --toolsets and --read-only, then exit")
+	rootCmd.Flags().String("output", "table", "Output format for --list-tools (table or json)")
+
+	// Define flags for the stdio command
+	stdioCmd.Flags().String("server", "", "Optional: Name of the configured server to use by default (e.g. a [servers.<name>] profile)")
+	_ = viper.BindPFlag("server", stdioCmd.Flags().Lookup("server"))
+
+	// Define flags for the http command
+	httpCmd.Flags().Int("port", 8080, "Port to listen on")
+	httpCmd.Flags().String("host", "127.0.0.1", "Address to bind to (use 0.0.0.0 to listen on all interfaces)")
+	httpCmd.Flags().String("tls-cert", "", "Optional: Path to a TLS certificate file; serves HTTPS together with --tls-key")
+	httpCmd.Flags().String("tls-key", "", "Optional: Path to the TLS private key file for --tls-cert")
+
+	_ = viper.BindPFlag("http.port", httpCmd.Flags().Lookup("port"))
+	_ = viper.BindPFlag("http.host", httpCmd.Flags().Lookup("host"))
+	_ = viper.BindPFlag("http.tls-cert", httpCmd.Flags().Lookup("tls-cert"))
+	_ = viper.BindPFlag("http.tls-key", httpCmd.Flags().Lookup("tls-key"))
 
 	// Add subcommands
 	rootCmd.AddCommand(
 		stdioCmd,
+		httpCmd,
 		cmdConfig.Cmd,
 		cmdProject.Cmd,
 		cmdInstall.Cmd,
@@ -398,16 +182,581 @@ func init() {
 
 // initConfig reads in config file and ENV variables if set.
 func initConfig() {
+	if cfgFile != "" {
+		// Use config file from the flag
+		viper.SetConfigFile(cfgFile)
+	} else if f := findConfigFile(); f != "" {
+		viper.SetConfigFile(f)
+	}
+
 	// Set ENV var prefix
 	viper.SetEnvPrefix("GITLAB")
 	// Read in environment variables that match defined flags/keys
 	viper.AutomaticEnv()
+
+	// Flags and environment variables take precedence over file values
+	if viper.ConfigFileUsed() != "" {
+		if err := viper.ReadInConfig(); err != nil {
+			cobra.CheckErr(fmt.Errorf("failed to read config file %s: %w", viper.ConfigFileUsed(), err))
+		}
+	}
+}
+
+// findConfigFile returns the first gitlab-mcp-server.yaml/.yml/.toml found in
+// $HOME/.config/gitlab-mcp-server/, $HOME/.gitlab-mcp-server/ and the current directory.
+// Only these extensions are considered so that the JSON global config is never picked up.
+func findConfigFile() string {
+	var dirs []string
+	if home, err := os.UserHomeDir(); err == nil {
+		dirs = append(dirs,
+			filepath.Join(home, ".config", configFileName),
+			filepath.Join(home, ".gitlab-mcp-server"),
+		)
+	}
+	dirs = append(dirs, ".")
+
+	for _, dir := range dirs {
+		for _, ext := range []string{"yaml", "yml", "toml"} {
+			path := filepath.Join(dir, configFileName+"."+ext)
+			if info, err := os.Stat(path); err == nil && !info.IsDir() {
+				return path
+			}
+		}
+	}
+	return ""
+}
+
+// shutdownTimeout bounds how long a transport may take to stop after a shutdown signal.
+const shutdownTimeout = 5 * time.Second
+
+// serveFunc runs an MCP transport until ctx is cancelled or the transport fails.
+type serveFunc func(ctx context.Context, mcpServer *server.MCPServer, tokenStore *gitlab.TokenStore, logger *log.Logger) error
+
+// runServer performs the startup shared by every transport (secure memory, logging,
+// translations, signal handling, GitLab clients and toolsets) and then runs serve.
+func runServer(serve serveFunc) {
+	// Check if secure memory is enabled
+	useSecureMemory := viper.GetBool("use-secure-memory")
+	if useSecureMemory {
+		// Initialize memguard for secure memory handling
+		memguard.CatchInterrupt()
+		defer memguard.Purge()
+	}
+
+	// Initialize Logger
+	logLevel := viper.GetString("log.level")
+	logFile := viper.GetString("log.file")
+	logFormat := viper.GetString("log.format")
+	logger, err := initLogger(logLevel, logFile, logFormat)
+	if err != nil {
+		stdlog.Fatalf("Failed to initialize logger: %v", err)
+	}
+	logger.Info("Logger initialized")
+	if f := viper.ConfigFileUsed(); f != "" {
+		logger.Debugf("Loaded config file %s", f)
+	}
+
+	if useSecureMemory {
+		logger.Info("Secure memory (memguard) enabled - tokens will be stored in encrypted memory")
+	}
+
+	// Initialize Translations
+	t, dumpTranslations := translations.TranslationHelper(logger)
+	defer func() {
+		if viper.GetBool("export-translations") {
+			logger.Info("Exporting translations...")
+			dumpTranslations()
+		}
+	}()
+
+	// Handle export-translations flag
+	if viper.GetBool("export-translations") {
+		logger.Info("Exporting translations and exiting...")
+		dumpTranslations()
+		return
+	}
+
+	// Initialize Signal Handling
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	logger.Info("Signal handling initialized")
+
+	logger.Info("Starting main execution flow...")
+
+	mcpServer, tokenStore := newMCPServer(ctx, logger, useSecureMemory, t)
+
+	// Start the transport in a goroutine
+	errC := make(chan error, 1)
+	go func() {
+		errC <- serve(ctx, mcpServer, tokenStore, logger)
+	}()
+	logger.Info("Server running, waiting for requests or signals...")
+
+	// Wait for shutdown signal or server error
+	select {
+	case <-ctx.Done():
+		logger.Info("Shutdown signal received, context cancelled.")
+		// Give the transport a chance to close its connections
+		select {
+		case <-errC:
+		case <-time.After(shutdownTimeout):
+			logger.Warn("Transport did not stop within the shutdown timeout.")
+		}
+	case err := <-errC:
+		if err != nil && err != context.Canceled {
+			logger.Errorf("Server encountered an error: %v", err)
+		} else {
+			logger.Info("Server listener stopped gracefully.")
+		}
+	}
+
+	logger.Info("Server shutting down.")
+}
+
+// newMCPServer builds the GitLab client pool and resolver, initializes the enabled
+// toolsets and returns an MCP server with those tools registered, along with the token
+// store holding the tokens of the configured servers.
+func newMCPServer(ctx context.Context, logger *log.Logger, useSecureMemory bool, t map[string]string) (*server.MCPServer, *gitlab.TokenStore) {
+	// Build the secret backend registry.
+	registry := config.NewBackendRegistry()
+	if err := registry.Register(config.NewKeyringBackend("gitlab-mcp-server")); err != nil {
+		logger.Warnf("Failed to register keyring backend: %v", err)
+	}
+	cfgManager, err := config.NewManagerWithRegistry("", registry)
+	if err != nil {
+		logger.Warnf("Failed to create config manager: %v", err)
+	}
+
+	// If config has external-cmd templates, register those schemes too.
+	if cfgManager != nil && cfgManager.Config().Backends != nil &&
+		len(cfgManager.Config().Backends.External) > 0 {
+		ext := config.NewExternalCmdBackend(cfgManager.Config().Backends.External)
+		if err := ext.RegisterAll(registry); err != nil {
+			logger.Warnf("Failed to register external-cmd backends: %v", err)
+		} else {
+			logger.Infof("Registered external-cmd schemes: %v", ext.Schemes())
+		}
+	}
+
+	// If any server uses file:// refs, register the encrypted-file backend with its path.
+	if cfgManager != nil {
+		for _, s := range cfgManager.ListServers() {
+			if strings.HasPrefix(s.TokenRef, "file://") {
+				if path, _, refErr := parseFileRefFromRef(s.TokenRef); refErr == nil {
+					crypto, cerr := config.NewCryptoManager(true)
+					if cerr == nil {
+						fb, ferr := config.NewEncryptedFileBackend(path, crypto)
+						if ferr == nil {
+							_ = registry.Register(fb)
+						}
+					}
+					break // one file backend suffices
+				}
+			}
+		}
+	}
+
+	hasConfigServers := false
+	if cfgManager != nil && cfgManager.ServerCount() > 0 {
+		hasConfigServers = true
+		logger.Infof("Loaded global config with %d server(s)", cfgManager.ServerCount())
+	}
+
+	// Get configuration from flags/env (for backward compatibility)
+	token := viper.GetString("token")
+	host := viper.GetString("host")
+	readOnly := viper.GetBool("read-only")
+
+	// Initialize Token Store with secure memory option
+	var tokenStore *gitlab.TokenStore
+	if useSecureMemory {
+		tokenStore = gitlab.NewTokenStoreWithConfig(gitlab.TokenStoreConfig{
+			UseSecureMemory: true,
+			Logger:          logger,
+		})
+	} else {
+		tokenStore = gitlab.NewTokenStore()
+	}
+	logger.Info("Token store initialized")
+
+	// Initialize Client Pool
+	clientPool := gitlab.NewClientPool(tokenStore, logger)
+	clientPool.SetMaxRetries(viper.GetInt("max_retries"))
+	clientPool.SetRateLimitThreshold(viper.GetInt("rate_limit_threshold"))
+	proxy := gitlab.ProxyConfig{
+		HTTPProxy:  viper.GetString("http_proxy"),
+		HTTPSProxy: viper.GetString("https_proxy"),
+		NoProxy:    viper.GetString("no_proxy"),
+	}
+	if err := clientPool.SetProxy(proxy); err != nil {
+		logger.Fatalf("Invalid proxy configuration: %v", err)
+	}
+	if !proxy.IsZero() {
+		logger.Infof("Using proxy for GitLab requests (%s)", proxy)
+	}
+	if caCert := viper.GetString("ca_cert"); caCert != "" {
+		if err := clientPool.SetCACertFile(caCert); err != nil {
+			logger.Fatalf("Invalid CA certificate configuration: %v", err)
+		}
+		logger.Infof("Trusting CA certificates from %s", caCert)
+	}
+	if viper.GetBool("insecure_skip_tls_verify") {
+		logger.Warn("TLS certificate verification is disabled. This is insecure and should only be used in development environments.")
+		clientPool.SetInsecureSkipTLSVerify(true)
+	}
+	logger.Info("Client pool initialized")
+
+	// Priority 1: Use global config if available and has servers
+	defaultServer := "default"
+	if hasConfigServers {
+		servers := cfgManager.ListServers()
+		resolveToken := func(ctx context.Context, name string) (string, error) {
+			return cfgManager.ResolveServerToken(ctx, name)
+		}
+		for _, serverCfg := range servers {
+			if err := clientPool.AddServerFromConfig(ctx, serverCfg, resolveToken); err != nil {
+				logger.Warnf("Failed to initialize client '%s': %v", serverCfg.Name, err)
+			} else {
+				logger.Infof("Added client '%s' from config", serverCfg.Name)
+				if serverCfg.IsDefault {
+					defaultServer = serverCfg.Name
+				}
+			}
+		}
+	}
+
+	// Priority 2: Server profiles from the settings file ([servers.<name>] sections)
+	if profiles, err := serverProfiles(); err != nil {
+		logger.Fatalf("Invalid server profiles in settings file: %v", err)
+	} else if len(profiles) > 0 {
+		if err := clientPool.InitializeFromProfiles(ctx, profiles); err != nil {
+			logger.Warnf("Failed to initialize server profiles: %v", err)
+		}
+	}
+
+	// Priority 3: Fallback to environment variables (backward compatibility)
+	if token != "" {
+		logger.Warn("DEPRECATION: GITLAB_TOKEN env var usage is deprecated and will be removed in v3.0. " +
+			"Run 'gitlab-mcp-server config add <name> --host <url>' to migrate to the global config. " +
+			"See docs/MULTI_SERVER_SETUP.md.")
+		logger.Info("Using environment variables for client initialization")
+		if err := clientPool.InitializeFromEnv(ctx, token, host); err != nil {
+			logger.Fatalf("Failed to initialize client from environment: %v", err)
+		}
+		logger.Info("GitLab client initialized from environment")
+	}
+
+	// Check if we have any clients
+	clientList := clientPool.ListClients()
+	if len(clientList) == 0 {
+		logger.Fatal("No clients configured. Please either:")
+		logger.Fatal("  1. Run 'gitlab-mcp-server config add' to add servers")
+		logger.Fatal("  2. Set GITLAB_TOKEN environment variable")
+	}
+
+	// An explicitly selected server overrides the default from the global config
+	if selected := viper.GetString("server"); selected != "" {
+		if err := clientPool.SetDefaultClient(selected); err != nil {
+			logger.Fatalf("Invalid --server %q: %v (configured: %s)", selected, err, strings.Join(clientList, ", "))
+		}
+		defaultServer = selected
+		logger.Infof("Using server '%s' as default", selected)
+	}
+
+	// Get toolsets
+	enabledToolsets, isDefault := configuredToolsets()
+	if isDefault {
+		logger.Infof("No toolsets specified via config/env, using default: %v", enabledToolsets)
+	}
+
+	logger.Infof("Enabled toolsets: %v", enabledToolsets)
+	logger.Infof("Read-only mode: %t", readOnly)
+
+	// Validate default token on startup
+	logger.Info("Validating GitLab token...")
+	glClient, serverName, err := clientPool.GetDefaultClient()
+	if err != nil {
+		logger.Warnf("Failed to get default client: %v", err)
+	} else {
+		// Use the actual server name from the pool
+		if serverName == "" || serverName == "default" {
+			serverName = defaultServer
+		}
+
+		// Try to get token for validation
+		var tokenToValidate string
+		if hasConfigServers {
+			if serverCfg, err := cfgManager.GetServer(serverName); err == nil {
+				tokenToValidate = serverCfg.Token
+			}
+		}
+		if tokenToValidate == "" {
+			if metadata, err := tokenStore.GetToken(serverName); err == nil {
+				tokenToValidate = metadata.Token
+			}
+		}
+		if tokenToValidate == "" {
+			tokenToValidate = token
+		}
+
+		if tokenToValidate != "" {
+			tokenMetadata, err := validateTokenOnStartup(ctx, glClient, tokenToValidate, viper.GetInt("token_expiry_warning_days"))
+			if err != nil {
+				logger.Warnf("Token validation warning: %v", err)
+			} else {
+				tokenMetadata.Name = serverName
+				if host != "" {
+					tokenMetadata.GitLabHost = host
+				}
+				if addErr := tokenStore.AddToken(serverName, tokenMetadata); addErr != nil {
+					logger.Warnf("Failed to store token metadata: %v", addErr)
+				} else {
+					tokenStore.SetDefaultToken(serverName)
+					logger.Infof("Token validated successfully for user %s (ID: %d) on server '%s'",
+						tokenMetadata.Username, tokenMetadata.UserID, serverName)
+					if tokenMetadata.IsExpiringSoon() {
+						gitlab.SendNotification(logger, gitlab.Notification{
+							Level:     gitlab.NotificationWarning,
+							Title:     "Token Expiring Soon",
+							Message:   fmt.Sprintf("Token will expire in %d days. Please create a new token and update it.", tokenMetadata.DaysUntilExpiry()),
+							TokenName: serverName,
+						})
+					}
+				}
+			}
+		}
+	}
+
+	// Create Client Resolver — strict (opt-in via env) or legacy (default).
+	var resolverFn gitlab.GetClientFn
+	if os.Getenv("GITLAB_MCP_STRICT_RESOLVER") == "1" {
+		hostsByName := map[string]string{}
+		if hasConfigServers {
+			for _, s := range cfgManager.ListServers() {
+				hostsByName[s.Name] = s.Host
+			}
+		}
+		sr := gitlab.NewStrictResolver(clientPool, hostsByName, logger)
+		resolverFn = sr.GetClientFn()
+		logger.Info("Strict resolver enabled (GITLAB_MCP_STRICT_RESOLVER=1) — no fallbacks, host verified per session")
+	} else {
+		resolver := gitlab.NewClientResolver(clientPool, defaultServer, logger)
+		resolverFn = resolver.GetClientFn()
+		logger.Infof("Client resolver initialized with default server '%s' (legacy — set GITLAB_MCP_STRICT_RESOLVER=1 for strict mode)", defaultServer)
+	}
+
+	// Check if dynamic toolsets mode is enabled
+	dynamicToolsets := viper.GetBool("dynamic-toolsets")
+
+	// Initialize Toolsets
+	toolsetGroup, err := gitlab.InitToolsets(enabledToolsets, readOnly, resolverFn, logger, tokenStore, clientPool.NewClient, t, dynamicToolsets)
+	if err != nil {
+		logger.Fatalf("Failed to initialize toolsets: %v", err)
+	}
+	logger.Info("Toolsets initialized")
+
+	// Create MCP Server
+	requestTimeout := viper.GetDuration("request_timeout")
+	// Metrics come first so that the recorded duration includes the other middlewares
+	metrics := gitlab.NewMetrics()
+	mcpServer := gitlab.NewServer("gitlab-mcp-server", version, gitlab.WithMetrics(metrics), gitlab.WithRequestTimeout(requestTimeout), gitlab.WithServerArgument())
+	logger.Infof("Tool request timeout set to %s", requestTimeout)
+	logger.Info("MCP server wrapper created")
+
+	// Register toolsets with the server
+	if dynamicToolsets {
+		dynamicManager := gitlab.NewDynamicToolsetManager(toolsetGroup, mcpServer, logger)
+		dynamicManager.SetDynamicMode(true)
+		dynamicManager.RegisterDiscoveryTools()
+		logger.Info("Dynamic toolset discovery tools registered")
+	} else {
+		toolsetGroup.RegisterTools(mcpServer)
+		logger.Info("Toolsets registered with MCP server")
+	}
+
+	// Server metadata is available regardless of the toolset configuration
+	mcpServer.AddTool(gitlab.GetServerMetadata(tokenStore, toolsetGroup, gitlab.ServerInfo{
+		Version:  version,
+		Commit:   commit,
+		ReadOnly: readOnly,
+		Metrics:  metrics,
+	}, t))
+
+	return mcpServer, tokenStore
+}
+
+// serverProfiles returns the server profiles defined in the settings file under "servers",
+// sorted by name.
+func serverProfiles() ([]gitlab.ServerProfile, error) {
+	var byName map[string]gitlab.ServerProfile
+	if err := viper.UnmarshalKey("servers", &byName); err != nil {
+		return nil, err
+	}
+
+	profiles := make([]gitlab.ServerProfile, 0, len(byName))
+	for name, profile := range byName {
+		profile.Name = name
+		profiles = append(profiles, profile)
+	}
+	sort.Slice(profiles, func(i, j int) bool { return profiles[i].Name < profiles[j].Name })
+	return profiles, nil
+}
+
+// configuredToolsets returns the toolsets requested via config/env, or the defaults
+// (reported by isDefault) when none were specified.
+func configuredToolsets() (names []string, isDefault bool) {
+	if !viper.IsSet("toolsets") {
+		return gitlab.DefaultTools, true
+	}
+	// The flag yields a slice, env vars a comma-separated string, config files either
+	for _, value := range viper.GetStringSlice("toolsets") {
+		for _, name := range strings.Split(value, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+	if len(names) == 0 {
+		return gitlab.DefaultTools, true
+	}
+	return names, false
+}
+
+// toolListing is the JSON representation of a tool printed by --list-tools.
+type toolListing struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// listTools prints the tools enabled by the current toolset and read-only settings
+// without connecting to GitLab. format is "table" or "json".
+func listTools(w io.Writer, format string) error {
+	if format != "table" && format != "json" {
+		return fmt.Errorf("invalid output format '%s': must be 'table' or 'json'", format)
+	}
+
+	// Tool definitions are built without a client and no server is started, so logs are discarded
+	logger := log.New()
+	logger.SetOutput(io.Discard)
+	t, _ := translations.TranslationHelper(logger)
+
+	enabledToolsets, _ := configuredToolsets()
+	toolsetGroup, err := gitlab.InitToolsets(enabledToolsets, viper.GetBool("read-only"), nil, logger, gitlab.NewTokenStore(), nil, t, false)
+	if err != nil {
+		return fmt.Errorf("failed to initialize toolsets: %w", err)
+	}
+	tools := toolsetGroup.ListTools()
+
+	if format == "json" {
+		listing := make([]toolListing, 0, len(tools))
+		for _, tool := range tools {
+			listing = append(listing, toolListing{Name: tool.Name, Description: tool.Description})
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(listing)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tDESCRIPTION")
+	for _, tool := range tools {
+		// Keep one row per tool: only the first line of multi-line descriptions
+		description, _, _ := strings.Cut(tool.Description, "\n")
+		fmt.Fprintf(tw, "%s\t%s\n", tool.Name, description)
+	}
+	return tw.Flush()
+}
+
+// serveStdio serves MCP over standard input/output.
+func serveStdio(ctx context.Context, mcpServer *server.MCPServer, tokenStore *gitlab.TokenStore, logger *log.Logger) error {
+	// Create Stdio Server
+	stdioServer := server.NewStdioServer(mcpServer)
+	stdioLogger := stdlog.New(logger.Writer(), "[StdioServer] ", 0)
+	stdioServer.SetErrorLogger(stdioLogger)
+	logger.Info("Stdio server transport created")
+
+	logger.Info("Starting to listen on stdio...")
+	in, out := io.Reader(os.Stdin), io.Writer(os.Stdout)
+	if viper.GetBool("enable-command-logging") {
+		logger.Warn("Command logging enabled - sensitive data will be redacted but not guaranteed")
+		loggedIO := iolog.NewIOLogger(in, out, logger).WithSecrets(tokenStore.TokenValues)
+		in, out = loggedIO, loggedIO
+	}
+
+	// Announce readiness on stderr
+	fmt.Fprintf(os.Stderr, "GitLab MCP Server running on stdio (Version: %s, Commit: %s)\n", version, commit)
+
+	return stdioServer.Listen(ctx, in, out)
+}
+
+// serveHTTP serves MCP over HTTP using Server-Sent Events, with optional TLS.
+func serveHTTP(ctx context.Context, mcpServer *server.MCPServer, _ *gitlab.TokenStore, logger *log.Logger) error {
+	addr := net.JoinHostPort(viper.GetString("http.host"), strconv.Itoa(viper.GetInt("http.port")))
+	certFile := viper.GetString("http.tls-cert")
+	keyFile := viper.GetString("http.tls-key")
+
+	// Create SSE Server
+	httpServer := &http.Server{
+		Addr:              addr,
+		ReadHeaderTimeout: 10 * time.Second,
+		ErrorLog:          stdlog.New(logger.Writer(), "[HTTPServer] ", 0),
+	}
+	sseServer := server.NewSSEServer(mcpServer,
+		server.WithSSEEndpoint("/sse"),
+		server.WithMessageEndpoint("/message"),
+		server.WithHTTPServer(httpServer),
+	)
+	httpServer.Handler = sseServer
+	logger.Info("SSE server transport created")
+
+	// Close open SSE sessions and stop accepting connections on shutdown
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := sseServer.Shutdown(shutdownCtx); err != nil {
+			logger.Warnf("HTTP server shutdown: %v", err)
+		}
+	}()
+
+	scheme := "http"
+	if certFile != "" {
+		scheme = "https"
+	}
+	logger.Infof("Starting to listen on %s://%s...", scheme, addr)
+
+	// Announce readiness on stderr
+	fmt.Fprintf(os.Stderr, "GitLab MCP Server running on %s://%s/sse (Version: %s, Commit: %s)\n", scheme, addr, version, commit)
+
+	var err error
+	if certFile != "" {
+		err = httpServer.ListenAndServeTLS(certFile, keyFile)
+	} else {
+		err = httpServer.ListenAndServe()
+	}
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
 }
 
 // initLogger sets up the logrus logger based on configuration.
-func initLogger(level string, filePath string) (*log.Logger, error) {
+func initLogger(level string, filePath string, format string) (*log.Logger, error) {
 	logger := log.New()
 
+	// Validate format before touching the output
+	var formatter log.Formatter
+	switch format {
+	case "", "text":
+		formatter = &log.TextFormatter{
+			FullTimestamp: true,
+		}
+	case "json":
+		formatter = &log.JSONFormatter{}
+	default:
+		return nil, fmt.Errorf("invalid log format '%s': must be 'text' or 'json'", format)
+	}
+
 	// Set Log Level
 	lvl, err := log.ParseLevel(level)
 	if err != nil {
@@ -428,16 +777,15 @@ func initLogger(level string, filePath string) (*log.Logger, error) {
 	}
 
 	// Set Formatter
-	logger.SetFormatter(&log.TextFormatter{
-		FullTimestamp: true,
-	})
+	logger.SetFormatter(formatter)
 
 	return logger, nil
 }
 
 // validateTokenOnStartup validates the GitLab token by calling the API
-// Returns TokenMetadata with user information if successful
-func validateTokenOnStartup(ctx context.Context, client *gl.Client, tokenStr string) (*gitlab.TokenMetadata, error) {
+// Returns TokenMetadata with user information and, for personal access tokens, the expiry date.
+// warningDays is stored as the token's expiry warning threshold.
+func validateTokenOnStartup(ctx context.Context, client *gl.Client, tokenStr string, warningDays int) (*gitlab.TokenMetadata, error) {
 	user, resp, err := client.Users.CurrentUser(gl.WithContext(ctx))
 
 	if err != nil {
@@ -454,8 +802,12 @@ func validateTokenOnStartup(ctx context.Context, client *gl.Client, tokenStr str
 		UserID:        user.ID,
 		Username:      user.Username,
 		IsExpiredFlag: false,
+
+		ExpiryWarningDays: warningDays,
 	}
 
+	metadata.ExpiresAt = gitlab.FetchTokenExpiry(ctx, client)
+
 	return metadata, nil
 }
 
@@ -0,0 +1,203 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/gitlab"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestInitLogger_JSONFormat(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "server.log")
+
+	logger, err := initLogger("debug", logFile, "json")
+	require.NoError(t, err)
+
+	logger.WithField("tool", "getProject").Debug("Tool called")
+
+	data, err := os.ReadFile(logFile)
+	require.NoError(t, err)
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(data, &entry), "log line should be valid JSON: %s", data)
+	assert.Equal(t, "debug", entry["level"])
+	assert.Equal(t, "Tool called", entry["msg"])
+	assert.Equal(t, "getProject", entry["tool"])
+	assert.NotEmpty(t, entry["time"])
+}
+
+func TestInitLogger_TextFormat(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "server.log")
+
+	logger, err := initLogger("info", logFile, "text")
+	require.NoError(t, err)
+
+	logger.Info("Logger initialized")
+
+	data, err := os.ReadFile(logFile)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(data), "time="), "expected text format, got: %s", data)
+	assert.Contains(t, string(data), `msg="Logger initialized"`)
+}
+
+func TestInitLogger_InvalidFormat(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "server.log")
+
+	_, err := initLogger("info", logFile, "xml")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid log format 'xml'")
+
+	_, statErr := os.Stat(logFile)
+	assert.True(t, os.IsNotExist(statErr), "log file should not be created for an invalid format")
+}
+
+func TestConfiguredToolsets(t *testing.T) {
+	tests := []struct {
+		name            string
+		value           any
+		expectedNames   []string
+		expectedDefault bool
+	}{
+		{name: "Unset uses defaults", value: nil, expectedDefault: true},
+		{name: "Comma-separated string", value: "projects, issues", expectedNames: []string{"projects", "issues"}},
+		{name: "Slice", value: []string{"packages", "tags"}, expectedNames: []string{"packages", "tags"}},
+		{name: "Empty string uses defaults", value: "", expectedDefault: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			viper.Reset()
+			defer viper.Reset()
+			if tc.value != nil {
+				viper.Set("toolsets", tc.value)
+			}
+
+			names, isDefault := configuredToolsets()
+			assert.Equal(t, tc.expectedDefault, isDefault)
+			if !tc.expectedDefault {
+				assert.Equal(t, tc.expectedNames, names)
+			}
+		})
+	}
+}
+
+func TestListTools(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+	viper.Set("toolsets", "packages")
+	viper.Set("read-only", true)
+
+	t.Run("JSON output", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, listTools(&buf, "json"))
+
+		var tools []toolListing
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &tools))
+		names := make([]string, 0, len(tools))
+		for _, tool := range tools {
+			names = append(names, tool.Name)
+		}
+		assert.Contains(t, names, "listPackages")
+		assert.NotContains(t, names, "deletePackage", "write tools are hidden in read-only mode")
+		assert.NotContains(t, names, "listIssues", "tools of other toolsets are not listed")
+	})
+
+	t.Run("Table output", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, listTools(&buf, "table"))
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		require.NotEmpty(t, lines)
+		assert.True(t, strings.HasPrefix(lines[0], "NAME"))
+		assert.Contains(t, buf.String(), "listPackages")
+	})
+
+	t.Run("Invalid format", func(t *testing.T) {
+		err := listTools(&bytes.Buffer{}, "xml")
+		assert.ErrorContains(t, err, "invalid output format 'xml'")
+	})
+}
+
+func TestValidateTokenOnStartup(t *testing.T) {
+	tests := []struct {
+		name            string
+		patStatus       int
+		expectExpiresAt bool
+	}{
+		{name: "Personal access token with expiry", patStatus: http.StatusOK, expectExpiresAt: true},
+		{name: "Expiry unavailable", patStatus: http.StatusNotFound},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("/api/v4/user", func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"id":42,"username":"jdoe"}`))
+			})
+			mux.HandleFunc("/api/v4/personal_access_tokens/self", func(w http.ResponseWriter, _ *http.Request) {
+				if tc.patStatus != http.StatusOK {
+					w.WriteHeader(tc.patStatus)
+					return
+				}
+				_, _ = w.Write([]byte(`{"id":1,"name":"mcp","expires_at":"2030-01-15"}`))
+			})
+			srv := httptest.NewServer(mux)
+			defer srv.Close()
+
+			client, err := gl.NewClient("token", gl.WithBaseURL(srv.URL), gl.WithoutRetries())
+			require.NoError(t, err)
+
+			metadata, err := validateTokenOnStartup(context.Background(), client, "token", 14)
+			require.NoError(t, err)
+			assert.Equal(t, int64(42), metadata.UserID)
+			assert.Equal(t, "jdoe", metadata.Username)
+			assert.Equal(t, 14, metadata.ExpiryWarningDays)
+			if tc.expectExpiresAt {
+				require.NotNil(t, metadata.ExpiresAt)
+				assert.Equal(t, "2030-01-15", metadata.ExpiresAt.Format("2006-01-02"))
+			} else {
+				assert.Nil(t, metadata.ExpiresAt)
+			}
+		})
+	}
+}
+
+func TestServerProfiles(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+
+	settings := filepath.Join(t.TempDir(), "gitlab-mcp-server.toml")
+	require.NoError(t, os.WriteFile(settings, []byte(`
+[servers.work]
+token = "work-token"
+host = "https://gitlab.example.com"
+
+[servers.personal]
+token = "personal-token"
+`), 0600))
+	viper.SetConfigFile(settings)
+	require.NoError(t, viper.ReadInConfig())
+
+	profiles, err := serverProfiles()
+	require.NoError(t, err)
+	assert.Equal(t, []gitlab.ServerProfile{
+		{Name: "personal", Token: "personal-token"},
+		{Name: "work", Token: "work-token", Host: "https://gitlab.example.com"},
+	}, profiles)
+
+	viper.Reset()
+	profiles, err = serverProfiles()
+	require.NoError(t, err)
+	assert.Empty(t, profiles)
+}
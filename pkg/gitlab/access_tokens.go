@@ -0,0 +1,278 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gl "gitlab.com/gitlab-org/api/client-go"
+)
+
+// maskedAccessTokenValue replaces token secrets in list output; the secret is
+// only ever shown once, in the result of createProjectAccessToken.
+const maskedAccessTokenValue = "[MASKED]"
+
+// maskProjectAccessToken returns a copy of token with its secret value redacted.
+func maskProjectAccessToken(token *gl.ProjectAccessToken) *gl.ProjectAccessToken {
+	if token == nil || token.Token == "" {
+		return token
+	}
+	masked := *token
+	masked.Token = maskedAccessTokenValue
+	return &masked
+}
+
+// ListProjectAccessTokens defines the MCP tool for listing the access tokens of a GitLab project.
+func ListProjectAccessTokens(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listProjectAccessTokens",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_PROJECT_ACCESS_TOKENS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Project Access Tokens",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			opts := &gl.ListProjectAccessTokensOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+			}
+			tokens, resp, err := glClient.ProjectAccessTokens.ListProjectAccessTokens(projectID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, fmt.Sprintf("access tokens from project %q", projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(tokens) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Redact token secrets
+			for i, token := range tokens {
+				tokens[i] = maskProjectAccessToken(token)
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(tokens)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal project access tokens list: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// CreateProjectAccessToken defines the MCP tool for creating an access token for a GitLab project.
+func CreateProjectAccessToken(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"createProjectAccessToken",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_CREATE_PROJECT_ACCESS_TOKEN_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Create GitLab Project Access Token",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("name",
+				mcp.Description("The name of the access token."),
+				mcp.Required(),
+			),
+			mcp.WithString("scopes",
+				mcp.Description("Comma-separated list of scopes, e.g. 'read_api,read_repository'."),
+				mcp.Required(),
+			),
+			mcp.WithString("expiresAt",
+				mcp.Description("Expiration date of the token in YYYY-MM-DD format."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithNumber("accessLevel",
+				mcp.Description("Access level of the token's bot user: 10 (guest), 15 (planner), 20 (reporter), 30 (developer), 40 (maintainer), 50 (owner). GitLab defaults to 40."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			name, err := requiredParam[string](&request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			scopesStr, err := requiredParam[string](&request, "scopes")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			var scopes []string
+			for _, scope := range strings.Split(scopesStr, ",") {
+				if scope = strings.TrimSpace(scope); scope != "" {
+					scopes = append(scopes, scope)
+				}
+			}
+			if len(scopes) == 0 {
+				return mcp.NewToolResultError("Validation Error: scopes must contain at least one scope"), nil
+			}
+
+			expiresAtStr, err := requiredParam[string](&request, "expiresAt")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			expiresAt, err := time.Parse("2006-01-02", expiresAtStr)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: expiresAt must be in YYYY-MM-DD format, got %q: %v", expiresAtStr, err)), nil
+			}
+
+			// --- Parse optional parameters
+			accessLevelFloat, err := OptionalParam[float64](&request, "accessLevel")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			isoExpiresAt := gl.ISOTime(expiresAt)
+			opts := &gl.CreateProjectAccessTokenOptions{
+				Name:      &name,
+				Scopes:    &scopes,
+				ExpiresAt: &isoExpiresAt,
+			}
+
+			if accessLevelFloat != 0 {
+				accessLevel := gl.AccessLevelValue(accessLevelFloat)
+				switch accessLevel {
+				case gl.GuestPermissions, gl.PlannerPermissions, gl.ReporterPermissions,
+					gl.DeveloperPermissions, gl.MaintainerPermissions, gl.OwnerPermissions:
+					opts.AccessLevel = &accessLevel
+				default:
+					return mcp.NewToolResultError(fmt.Sprintf("Validation Error: accessLevel %v is not a valid access level", accessLevelFloat)), nil
+				}
+			}
+
+			// --- Call GitLab API
+			token, resp, err := glClient.ProjectAccessTokens.CreateProjectAccessToken(projectID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("project %q", projectID), "create project access token")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			// The token secret is deliberately left in place: it cannot be retrieved again later
+			data, err := json.Marshal(token)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal project access token data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// RevokeProjectAccessToken defines the MCP tool for revoking an access token of a GitLab project.
+func RevokeProjectAccessToken(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"revokeProjectAccessToken",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_REVOKE_PROJECT_ACCESS_TOKEN_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Revoke GitLab Project Access Token",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("tokenId",
+				mcp.Description("The ID of the access token to revoke."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			tokenIDFloat, err := requiredParam[float64](&request, "tokenId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			tokenID := int64(tokenIDFloat)
+			if float64(tokenID) != tokenIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: tokenId %v is not a valid integer", tokenIDFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			resp, err := glClient.ProjectAccessTokens.RevokeProjectAccessToken(projectID, tokenID, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("access token %d in project %q", tokenID, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf(`{"message":"Access token %d successfully revoked in project %q"}`, tokenID, projectID)), nil
+		}
+}
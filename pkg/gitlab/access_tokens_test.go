@@ -0,0 +1,260 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+func TestListProjectAccessTokensHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListProjectAccessTokens(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockTokens, ctrl := setupMockClientForProjectAccessTokens(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListProjectAccessTokens(mockGetClient, nil)
+
+	newToken := func(id int64, name, secret string) *gl.ProjectAccessToken {
+		token := &gl.ProjectAccessToken{AccessLevel: gl.MaintainerPermissions}
+		token.ID = id
+		token.Name = name
+		token.Token = secret
+		return token
+	}
+
+	tests := []struct {
+		name               string
+		inputArgs          map[string]any
+		mockSetup          func()
+		expectedContains   []string
+		expectedMissing    []string
+		expectHandlerError bool
+		expectResultError  bool
+	}{
+		{
+			name:      "Success - Token secrets are masked",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockTokens.EXPECT().
+					ListProjectAccessTokens("group/project", gomock.Any(), gomock.Any()).
+					Return([]*gl.ProjectAccessToken{
+						newToken(1, "ci-bot", "glpat-secret"),
+						newToken(2, "reader", ""),
+					}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedContains: []string{`"name":"ci-bot"`, `"token":"[MASKED]"`, `"name":"reader"`},
+			expectedMissing:  []string{"glpat-secret"},
+		},
+		{
+			name:      "Success - Empty list",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockTokens.EXPECT().
+					ListProjectAccessTokens("group/project", gomock.Any(), gomock.Any()).
+					Return([]*gl.ProjectAccessToken{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedContains: []string{"[]"},
+		},
+		{
+			name:              "Error - Missing projectId",
+			inputArgs:         map[string]any{},
+			mockSetup:         func() {},
+			expectedContains:  []string{"Validation Error: missing required parameter: projectId"},
+			expectResultError: true,
+		},
+		{
+			name:      "Error - Forbidden (403)",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockTokens.EXPECT().
+					ListProjectAccessTokens("group/project", gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 403}}, errors.New("gitlab: 403 Forbidden"))
+			},
+			expectHandlerError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.inputArgs}})
+
+			if tc.expectHandlerError {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, "failed to list access tokens from project")
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, tc.expectResultError, result.IsError)
+			text := getTextResult(t, result).Text
+			for _, s := range tc.expectedContains {
+				assert.Contains(t, text, s)
+			}
+			for _, s := range tc.expectedMissing {
+				assert.NotContains(t, text, s)
+			}
+		})
+	}
+}
+
+func TestCreateProjectAccessTokenHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := CreateProjectAccessToken(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockTokens, ctrl := setupMockClientForProjectAccessTokens(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := CreateProjectAccessToken(mockGetClient, nil)
+
+	t.Run("Success - Secret is returned on create", func(t *testing.T) {
+		mockTokens.EXPECT().
+			CreateProjectAccessToken("group/project", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.CreateProjectAccessTokenOptions, _ ...gl.RequestOptionFunc) (*gl.ProjectAccessToken, *gl.Response, error) {
+				assert.Equal(t, "ci-bot", *opts.Name)
+				assert.Equal(t, []string{"read_api", "read_repository"}, *opts.Scopes)
+				assert.Equal(t, "2030-01-31", time.Time(*opts.ExpiresAt).Format("2006-01-02"))
+				assert.Equal(t, gl.DeveloperPermissions, *opts.AccessLevel)
+				token := &gl.ProjectAccessToken{AccessLevel: gl.DeveloperPermissions}
+				token.ID = 5
+				token.Token = "glpat-secret"
+				return token, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":   "group/project",
+			"name":        "ci-bot",
+			"scopes":      "read_api, read_repository",
+			"expiresAt":   "2030-01-31",
+			"accessLevel": 30.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"token":"glpat-secret"`)
+	})
+
+	t.Run("Error - Invalid expiresAt", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"name":      "ci-bot",
+			"scopes":    "read_api",
+			"expiresAt": "31/01/2030",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: expiresAt must be in YYYY-MM-DD format")
+	})
+
+	t.Run("Error - Empty scopes", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"name":      "ci-bot",
+			"scopes":    " , ",
+			"expiresAt": "2030-01-31",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: scopes must contain at least one scope")
+	})
+
+	t.Run("Error - Invalid accessLevel", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":   "group/project",
+			"name":        "ci-bot",
+			"scopes":      "read_api",
+			"expiresAt":   "2030-01-31",
+			"accessLevel": 35.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: accessLevel 35 is not a valid access level")
+	})
+
+	t.Run("Error - Invalid scope (400)", func(t *testing.T) {
+		mockTokens.EXPECT().
+			CreateProjectAccessToken("group/project", gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 400}}, errors.New("gitlab: 400 scopes does not have a valid value"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"name":      "ci-bot",
+			"scopes":    "everything",
+			"expiresAt": "2030-01-31",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
+
+func TestRevokeProjectAccessTokenHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := RevokeProjectAccessToken(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockTokens, ctrl := setupMockClientForProjectAccessTokens(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := RevokeProjectAccessToken(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockTokens.EXPECT().
+			RevokeProjectAccessToken("group/project", int64(5), gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 204}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"tokenId":   5.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Access token 5 successfully revoked")
+	})
+
+	t.Run("Error - Token Not Found (404)", func(t *testing.T) {
+		mockTokens.EXPECT().
+			RevokeProjectAccessToken("group/project", int64(99), gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"tokenId":   99.0,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+
+	t.Run("Error - Non-integer tokenId", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"tokenId":   5.5,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: tokenId 5.5 is not a valid integer")
+	})
+}
@@ -0,0 +1,213 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gl "gitlab.com/gitlab-org/api/client-go"
+)
+
+// ListApprovalRules defines the MCP tool for listing the approval rules of a project, or of a
+// single merge request when mergeRequestIid is given. Approval rules require GitLab Premium.
+func ListApprovalRules(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listApprovalRules",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_APPROVAL_RULES_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Approval Rules",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithNumber("mergeRequestIid",
+				mcp.Description("The IID of a merge request. If given, its merge request level rules are listed instead of the project's."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			mrIid, err := OptionalIntParam(&request, "mergeRequestIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			var rules any
+			var resp *gl.Response
+			var resourceDesc string
+			if mrIid != 0 {
+				resourceDesc = fmt.Sprintf("approval rules of merge request %d in project %q", mrIid, projectID)
+				rules, resp, err = glClient.MergeRequestApprovals.GetApprovalRules(projectID, int64(mrIid), gl.WithContext(ctx))
+			} else {
+				resourceDesc = fmt.Sprintf("approval rules of project %q", projectID)
+				rules, resp, err = glClient.Projects.GetProjectApprovalRules(projectID, nil, gl.WithContext(ctx))
+			}
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, resourceDesc)
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(rules)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal approval rules: %w", err)
+			}
+			if string(data) == "null" {
+				return mcp.NewToolResultText("[]"), nil
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// CreateApprovalRule defines the MCP tool for creating an approval rule on a project, or on a
+// single merge request when mergeRequestIid is given. Approval rules require GitLab Premium.
+func CreateApprovalRule(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"createApprovalRule",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_CREATE_APPROVAL_RULE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Create GitLab Approval Rule",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("name",
+				mcp.Description("The name of the approval rule."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("approvalsRequired",
+				mcp.Description("The number of approvals required by the rule (integer, 0 or more)."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithNumber("mergeRequestIid",
+				mcp.Description("The IID of a merge request. If given, the rule is created on that merge request instead of the project."),
+			),
+			mcp.WithString("userIds",
+				mcp.Description("Comma-separated list of user IDs eligible to approve."),
+			),
+			mcp.WithString("groupIds",
+				mcp.Description("Comma-separated list of group IDs whose members are eligible to approve."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			name, err := requiredParam[string](&request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// Zero is a valid value (an optional rule), so requiredParam cannot be used here.
+			approvalsFloat, ok, err := OptionalParamOK[float64](&request, "approvalsRequired")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			if !ok {
+				return mcp.NewToolResultError("Validation Error: missing required parameter: approvalsRequired"), nil
+			}
+			approvalsRequired := int64(approvalsFloat)
+			if float64(approvalsRequired) != approvalsFloat || approvalsRequired < 0 {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: approvalsRequired %v must be a non-negative integer", approvalsFloat)), nil
+			}
+
+			// --- Parse optional parameters
+			mrIid, err := OptionalIntParam(&request, "mergeRequestIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			userIDsStr, err := OptionalParam[string](&request, "userIds")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			userIDs, err := ParseIDListString(userIDsStr, "user")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			groupIDsStr, err := OptionalParam[string](&request, "groupIds")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			groupIDs, err := ParseIDListString(groupIDsStr, "group")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			var rule any
+			var resp *gl.Response
+			var resourceDesc string
+			if mrIid != 0 {
+				resourceDesc = fmt.Sprintf("merge request %d in project %q", mrIid, projectID)
+				rule, resp, err = glClient.MergeRequestApprovals.CreateApprovalRule(projectID, int64(mrIid), &gl.CreateMergeRequestApprovalRuleOptions{
+					Name:              &name,
+					ApprovalsRequired: &approvalsRequired,
+					UserIDs:           userIDs,
+					GroupIDs:          groupIDs,
+				}, gl.WithContext(ctx))
+			} else {
+				resourceDesc = fmt.Sprintf("project %q", projectID)
+				rule, resp, err = glClient.Projects.CreateProjectApprovalRule(projectID, &gl.CreateProjectLevelRuleOptions{
+					Name:              &name,
+					ApprovalsRequired: &approvalsRequired,
+					UserIDs:           userIDs,
+					GroupIDs:          groupIDs,
+				}, gl.WithContext(ctx))
+			}
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, resourceDesc, "create approval rule")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(rule)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal approval rule: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
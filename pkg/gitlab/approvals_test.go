@@ -0,0 +1,193 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	mock_gitlab "gitlab.com/gitlab-org/api/client-go/testing"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+// setupMockClientForApprovalRules returns a client with mocked Projects and
+// MergeRequestApprovals services, which hold project and merge request level rules.
+func setupMockClientForApprovalRules(t *testing.T) (*gl.Client, *mock_gitlab.MockProjectsServiceInterface, *mock_gitlab.MockMergeRequestApprovalsServiceInterface, *gomock.Controller) {
+	client, mockProjects, ctrl := setupMockClient(t)
+	mockApprovals := mock_gitlab.NewMockMergeRequestApprovalsServiceInterface(ctrl)
+	client.MergeRequestApprovals = mockApprovals
+	return client, mockProjects, mockApprovals, ctrl
+}
+
+func TestListApprovalRulesHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListApprovalRules(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockProjects, mockApprovals, ctrl := setupMockClientForApprovalRules(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListApprovalRules(mockGetClient, nil)
+
+	t.Run("Success - Project level", func(t *testing.T) {
+		mockProjects.EXPECT().
+			GetProjectApprovalRules("group/project", gomock.Any(), gomock.Any()).
+			Return([]*gl.ProjectApprovalRule{{ID: 1, Name: "Security", ApprovalsRequired: 2}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{"projectId": "group/project"}))
+		require.NoError(t, err)
+		text := getTextResult(t, result).Text
+		assert.Contains(t, text, `"name":"Security"`)
+		assert.Contains(t, text, `"approvals_required":2`)
+	})
+
+	t.Run("Success - Merge request level", func(t *testing.T) {
+		mockApprovals.EXPECT().
+			GetApprovalRules("group/project", int64(5), gomock.Any()).
+			Return([]*gl.MergeRequestApprovalRule{{ID: 3, Name: "Backend"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{"projectId": "group/project", "mergeRequestIid": 5.0}))
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"name":"Backend"`)
+	})
+
+	t.Run("Success - No rules", func(t *testing.T) {
+		mockProjects.EXPECT().
+			GetProjectApprovalRules("group/project", gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{"projectId": "group/project"}))
+		require.NoError(t, err)
+		assert.Equal(t, "[]", getTextResult(t, result).Text)
+	})
+
+	t.Run("Error - Not available (404)", func(t *testing.T) {
+		mockProjects.EXPECT().
+			GetProjectApprovalRules("group/project", gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{"projectId": "group/project"}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, `approval rules of project "group/project" not found`)
+	})
+
+	t.Run("Error - Non-integer mergeRequestIid", func(t *testing.T) {
+		result, err := handler(ctx, *createMCPRequest(map[string]any{"projectId": "group/project", "mergeRequestIid": 1.5}))
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error:")
+	})
+}
+
+func TestCreateApprovalRuleHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := CreateApprovalRule(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockProjects, mockApprovals, ctrl := setupMockClientForApprovalRules(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := CreateApprovalRule(mockGetClient, nil)
+
+	t.Run("Success - Project level", func(t *testing.T) {
+		mockProjects.EXPECT().
+			CreateProjectApprovalRule("group/project", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.CreateProjectLevelRuleOptions, _ ...gl.RequestOptionFunc) (*gl.ProjectApprovalRule, *gl.Response, error) {
+				assert.Equal(t, "Security", *opts.Name)
+				assert.Equal(t, int64(2), *opts.ApprovalsRequired)
+				assert.Equal(t, []int64{1, 2}, *opts.UserIDs)
+				assert.Equal(t, []int64{10}, *opts.GroupIDs)
+				return &gl.ProjectApprovalRule{ID: 4, Name: "Security", ApprovalsRequired: 2}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{
+			"projectId":         "group/project",
+			"name":              "Security",
+			"approvalsRequired": 2.0,
+			"userIds":           "1, 2",
+			"groupIds":          "10",
+		}))
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"id":4`)
+	})
+
+	t.Run("Success - Merge request level", func(t *testing.T) {
+		mockApprovals.EXPECT().
+			CreateApprovalRule("group/project", int64(5), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ int64, opts *gl.CreateMergeRequestApprovalRuleOptions, _ ...gl.RequestOptionFunc) (*gl.MergeRequestApprovalRule, *gl.Response, error) {
+				assert.Equal(t, int64(0), *opts.ApprovalsRequired)
+				assert.Nil(t, opts.UserIDs)
+				assert.Nil(t, opts.GroupIDs)
+				return &gl.MergeRequestApprovalRule{ID: 6, Name: "Optional"}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{
+			"projectId":         "group/project",
+			"mergeRequestIid":   5.0,
+			"name":              "Optional",
+			"approvalsRequired": 0.0,
+		}))
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"id":6`)
+	})
+
+	t.Run("Error - Negative approvalsRequired", func(t *testing.T) {
+		result, err := handler(ctx, *createMCPRequest(map[string]any{
+			"projectId":         "group/project",
+			"name":              "Security",
+			"approvalsRequired": -1.0,
+		}))
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: approvalsRequired -1 must be a non-negative integer")
+	})
+
+	t.Run("Error - Missing approvalsRequired", func(t *testing.T) {
+		result, err := handler(ctx, *createMCPRequest(map[string]any{
+			"projectId": "group/project",
+			"name":      "Security",
+		}))
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: approvalsRequired")
+	})
+
+	t.Run("Error - Invalid user ID", func(t *testing.T) {
+		result, err := handler(ctx, *createMCPRequest(map[string]any{
+			"projectId":         "group/project",
+			"name":              "Security",
+			"approvalsRequired": 1.0,
+			"userIds":           "1,bob",
+		}))
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `Validation Error: invalid user ID "bob"`)
+	})
+
+	t.Run("Error - Rejected by GitLab (422)", func(t *testing.T) {
+		mockProjects.EXPECT().
+			CreateProjectApprovalRule("group/project", gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 422}}, errors.New("gitlab: name has already been taken"))
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{
+			"projectId":         "group/project",
+			"name":              "Security",
+			"approvalsRequired": 1.0,
+		}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "failed to create approval rule")
+	})
+}
@@ -0,0 +1,469 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gl "gitlab.com/gitlab-org/api/client-go"
+)
+
+// ListIssueAwardEmoji defines the MCP tool for listing the emoji reactions on an issue.
+func ListIssueAwardEmoji(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listIssueAwardEmoji",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_ISSUE_AWARD_EMOJI_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Issue Award Emoji",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("issueIid",
+				mcp.Description("The IID (internal ID, integer) of the issue within the project."),
+				mcp.Required(),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			issueIidFloat, err := requiredParam[float64](&request, "issueIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			issueIid := int64(issueIidFloat)
+			if float64(issueIid) != issueIidFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: issueIid %v is not a valid integer", issueIidFloat)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			opts := &gl.ListAwardEmojiOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+			}
+			awards, resp, err := glClient.AwardEmoji.ListIssueAwardEmoji(projectID, issueIid, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, fmt.Sprintf("award emoji for issue %d in project %q", issueIid, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(awards) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(awards)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal issue award emoji: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// AddIssueAwardEmoji defines the MCP tool for adding an emoji reaction to an issue.
+func AddIssueAwardEmoji(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"addIssueAwardEmoji",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_ADD_ISSUE_AWARD_EMOJI_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Add GitLab Issue Award Emoji",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("issueIid",
+				mcp.Description("The IID (internal ID, integer) of the issue within the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("name",
+				mcp.Description("The name of the emoji without colons, e.g. 'thumbsup'."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			issueIidFloat, err := requiredParam[float64](&request, "issueIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			issueIid := int64(issueIidFloat)
+			if float64(issueIid) != issueIidFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: issueIid %v is not a valid integer", issueIidFloat)), nil
+			}
+
+			name, err := requiredParam[string](&request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			// Accept the ':thumbsup:' form used in comments as well
+			name = strings.Trim(name, ":")
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			opts := &gl.CreateAwardEmojiOptions{
+				Name: name,
+			}
+			award, resp, err := glClient.AwardEmoji.CreateIssueAwardEmoji(projectID, issueIid, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("issue %d in project %q", issueIid, projectID), "add award emoji to")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(award)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal award emoji data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// DeleteIssueAwardEmoji defines the MCP tool for removing an emoji reaction from an issue.
+func DeleteIssueAwardEmoji(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"deleteIssueAwardEmoji",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_DELETE_ISSUE_AWARD_EMOJI_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Delete GitLab Issue Award Emoji",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("issueIid",
+				mcp.Description("The IID (internal ID, integer) of the issue within the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("awardId",
+				mcp.Description("The ID of the award emoji to delete."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			issueIidFloat, err := requiredParam[float64](&request, "issueIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			issueIid := int64(issueIidFloat)
+			if float64(issueIid) != issueIidFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: issueIid %v is not a valid integer", issueIidFloat)), nil
+			}
+
+			awardIDFloat, err := requiredParam[float64](&request, "awardId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			awardID := int64(awardIDFloat)
+			if float64(awardID) != awardIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: awardId %v is not a valid integer", awardIDFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			resp, err := glClient.AwardEmoji.DeleteIssueAwardEmoji(projectID, issueIid, awardID, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("award emoji %d on issue %d in project %q", awardID, issueIid, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf(`{"message":"Award emoji %d successfully deleted from issue %d in project %q"}`, awardID, issueIid, projectID)), nil
+		}
+}
+
+// ListMergeRequestAwardEmoji defines the MCP tool for listing the emoji reactions on a merge request.
+func ListMergeRequestAwardEmoji(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listMergeRequestAwardEmoji",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_MERGE_REQUEST_AWARD_EMOJI_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Merge Request Award Emoji",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("mergeRequestIid",
+				mcp.Description("The IID (internal ID, integer) of the merge request within the project."),
+				mcp.Required(),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			mrIidFloat, err := requiredParam[float64](&request, "mergeRequestIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			mrIid := int64(mrIidFloat)
+			if float64(mrIid) != mrIidFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: mergeRequestIid %v is not a valid integer", mrIidFloat)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			opts := &gl.ListAwardEmojiOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+			}
+			awards, resp, err := glClient.AwardEmoji.ListMergeRequestAwardEmoji(projectID, mrIid, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, fmt.Sprintf("award emoji for merge request %d in project %q", mrIid, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(awards) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(awards)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal merge request award emoji: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// AddMergeRequestAwardEmoji defines the MCP tool for adding an emoji reaction to a merge request.
+func AddMergeRequestAwardEmoji(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"addMergeRequestAwardEmoji",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_ADD_MERGE_REQUEST_AWARD_EMOJI_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Add GitLab Merge Request Award Emoji",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("mergeRequestIid",
+				mcp.Description("The IID (internal ID, integer) of the merge request within the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("name",
+				mcp.Description("The name of the emoji without colons, e.g. 'thumbsup'."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			mrIidFloat, err := requiredParam[float64](&request, "mergeRequestIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			mrIid := int64(mrIidFloat)
+			if float64(mrIid) != mrIidFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: mergeRequestIid %v is not a valid integer", mrIidFloat)), nil
+			}
+
+			name, err := requiredParam[string](&request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			// Accept the ':thumbsup:' form used in comments as well
+			name = strings.Trim(name, ":")
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			opts := &gl.CreateAwardEmojiOptions{
+				Name: name,
+			}
+			award, resp, err := glClient.AwardEmoji.CreateMergeRequestAwardEmoji(projectID, mrIid, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("merge request %d in project %q", mrIid, projectID), "add award emoji to")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(award)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal award emoji data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// DeleteMergeRequestAwardEmoji defines the MCP tool for removing an emoji reaction from a merge request.
+func DeleteMergeRequestAwardEmoji(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"deleteMergeRequestAwardEmoji",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_DELETE_MERGE_REQUEST_AWARD_EMOJI_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Delete GitLab Merge Request Award Emoji",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("mergeRequestIid",
+				mcp.Description("The IID (internal ID, integer) of the merge request within the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("awardId",
+				mcp.Description("The ID of the award emoji to delete."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			mrIidFloat, err := requiredParam[float64](&request, "mergeRequestIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			mrIid := int64(mrIidFloat)
+			if float64(mrIid) != mrIidFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: mergeRequestIid %v is not a valid integer", mrIidFloat)), nil
+			}
+
+			awardIDFloat, err := requiredParam[float64](&request, "awardId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			awardID := int64(awardIDFloat)
+			if float64(awardID) != awardIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: awardId %v is not a valid integer", awardIDFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			resp, err := glClient.AwardEmoji.DeleteMergeRequestAwardEmoji(projectID, mrIid, awardID, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("award emoji %d on merge request %d in project %q", awardID, mrIid, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf(`{"message":"Award emoji %d successfully deleted from merge request %d in project %q"}`, awardID, mrIid, projectID)), nil
+		}
+}
@@ -0,0 +1,316 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+func TestListIssueAwardEmojiHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListIssueAwardEmoji(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockAwardEmoji, ctrl := setupMockClientForAwardEmoji(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListIssueAwardEmoji(mockGetClient, nil)
+
+	tests := []struct {
+		name               string
+		inputArgs          map[string]any
+		mockSetup          func()
+		expectedText       string
+		expectHandlerError bool
+		expectResultError  bool
+		errorContains      string
+	}{
+		{
+			name:      "Success - List award emoji",
+			inputArgs: map[string]any{"projectId": "group/project", "issueIid": 3.0},
+			mockSetup: func() {
+				mockAwardEmoji.EXPECT().
+					ListIssueAwardEmoji("group/project", int64(3), gomock.Any(), gomock.Any()).
+					Return([]*gl.AwardEmoji{{ID: 11, Name: "thumbsup"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: `"name":"thumbsup"`,
+		},
+		{
+			name:      "Success - Empty list",
+			inputArgs: map[string]any{"projectId": "group/project", "issueIid": 3.0},
+			mockSetup: func() {
+				mockAwardEmoji.EXPECT().
+					ListIssueAwardEmoji("group/project", int64(3), gomock.Any(), gomock.Any()).
+					Return([]*gl.AwardEmoji{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: "[]",
+		},
+		{
+			name:              "Error - Missing issueIid",
+			inputArgs:         map[string]any{"projectId": "group/project"},
+			mockSetup:         func() {},
+			expectResultError: true,
+			errorContains:     "Validation Error: missing required parameter: issueIid",
+		},
+		{
+			name:      "Error - Issue Not Found (404)",
+			inputArgs: map[string]any{"projectId": "group/project", "issueIid": 99.0},
+			mockSetup: func() {
+				mockAwardEmoji.EXPECT().
+					ListIssueAwardEmoji("group/project", int64(99), gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+			},
+			expectHandlerError: true,
+			errorContains:      "failed to list award emoji for issue 99",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.inputArgs}})
+
+			if tc.expectHandlerError {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			textContent := getTextResult(t, result)
+			if tc.expectResultError {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.errorContains)
+				return
+			}
+			assert.Contains(t, textContent.Text, tc.expectedText)
+		})
+	}
+}
+
+func TestAddIssueAwardEmojiHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := AddIssueAwardEmoji(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockAwardEmoji, ctrl := setupMockClientForAwardEmoji(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := AddIssueAwardEmoji(mockGetClient, nil)
+
+	t.Run("Success - Colons are stripped", func(t *testing.T) {
+		mockAwardEmoji.EXPECT().
+			CreateIssueAwardEmoji("group/project", int64(3), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ int64, opts *gl.CreateAwardEmojiOptions, _ ...gl.RequestOptionFunc) (*gl.AwardEmoji, *gl.Response, error) {
+				assert.Equal(t, "tada", opts.Name)
+				return &gl.AwardEmoji{ID: 12, Name: "tada"}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"issueIid":  3.0,
+			"name":      ":tada:",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"id":12`)
+	})
+
+	t.Run("Error - Missing name", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"issueIid":  3.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: name")
+	})
+}
+
+func TestDeleteIssueAwardEmojiHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := DeleteIssueAwardEmoji(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockAwardEmoji, ctrl := setupMockClientForAwardEmoji(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := DeleteIssueAwardEmoji(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockAwardEmoji.EXPECT().
+			DeleteIssueAwardEmoji("group/project", int64(3), int64(11), gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 204}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"issueIid":  3.0,
+			"awardId":   11.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Award emoji 11 successfully deleted from issue 3")
+	})
+
+	t.Run("Error - Non-integer awardId", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"issueIid":  3.0,
+			"awardId":   1.5,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: awardId 1.5 is not a valid integer")
+	})
+}
+
+func TestListMergeRequestAwardEmojiHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListMergeRequestAwardEmoji(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockAwardEmoji, ctrl := setupMockClientForAwardEmoji(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListMergeRequestAwardEmoji(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockAwardEmoji.EXPECT().
+			ListMergeRequestAwardEmoji("group/project", int64(5), gomock.Any(), gomock.Any()).
+			Return([]*gl.AwardEmoji{{ID: 21, Name: "rocket"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 5.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"name":"rocket"`)
+	})
+
+	t.Run("Error - Non-integer mergeRequestIid", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 5.5,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: mergeRequestIid 5.5 is not a valid integer")
+	})
+}
+
+func TestAddMergeRequestAwardEmojiHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := AddMergeRequestAwardEmoji(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockAwardEmoji, ctrl := setupMockClientForAwardEmoji(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := AddMergeRequestAwardEmoji(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockAwardEmoji.EXPECT().
+			CreateMergeRequestAwardEmoji("group/project", int64(5), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ int64, opts *gl.CreateAwardEmojiOptions, _ ...gl.RequestOptionFunc) (*gl.AwardEmoji, *gl.Response, error) {
+				assert.Equal(t, "thumbsup", opts.Name)
+				return &gl.AwardEmoji{ID: 22, Name: "thumbsup"}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 5.0,
+			"name":            "thumbsup",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"id":22`)
+	})
+
+	t.Run("Error - Unknown emoji (400)", func(t *testing.T) {
+		mockAwardEmoji.EXPECT().
+			CreateMergeRequestAwardEmoji("group/project", int64(5), gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 400}}, errors.New("gitlab: 400 name is not a valid emoji name"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 5.0,
+			"name":            "not-an-emoji",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
+
+func TestDeleteMergeRequestAwardEmojiHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := DeleteMergeRequestAwardEmoji(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockAwardEmoji, ctrl := setupMockClientForAwardEmoji(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := DeleteMergeRequestAwardEmoji(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockAwardEmoji.EXPECT().
+			DeleteMergeRequestAwardEmoji("group/project", int64(5), int64(21), gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 204}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 5.0,
+			"awardId":         21.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Award emoji 21 successfully deleted from merge request 5")
+	})
+
+	t.Run("Error - Award Not Found (404)", func(t *testing.T) {
+		mockAwardEmoji.EXPECT().
+			DeleteMergeRequestAwardEmoji("group/project", int64(5), int64(99), gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 5.0,
+			"awardId":         99.0,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
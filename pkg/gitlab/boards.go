@@ -0,0 +1,380 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gl "gitlab.com/gitlab-org/api/client-go"
+)
+
+// ListProjectBoards defines the MCP tool for listing the issue boards of a GitLab project.
+func ListProjectBoards(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listProjectBoards",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_PROJECT_BOARDS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Project Boards",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.ListIssueBoardsOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+			}
+
+			// --- Call GitLab API
+			boards, resp, err := glClient.Boards.ListIssueBoards(projectID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, fmt.Sprintf("boards from project %q", projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(boards) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(boards)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal boards list: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// GetProjectBoard defines the MCP tool for retrieving a single issue board of a GitLab project.
+func GetProjectBoard(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"getProjectBoard",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_PROJECT_BOARD_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "Get GitLab Project Board",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("boardId",
+				mcp.Description("The ID of the board."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			boardIDFloat, err := requiredParam[float64](&request, "boardId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			boardID := int64(boardIDFloat)
+			if float64(boardID) != boardIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: boardId %v is not a valid integer", boardIDFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			board, resp, err := glClient.Boards.GetIssueBoard(projectID, boardID, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("board %d in project %q", boardID, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(board)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal board data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// ListProjectBoardLists defines the MCP tool for listing the lists (columns) of a project issue board.
+func ListProjectBoardLists(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listProjectBoardLists",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_PROJECT_BOARD_LISTS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Board Lists",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("boardId",
+				mcp.Description("The ID of the board."),
+				mcp.Required(),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			boardIDFloat, err := requiredParam[float64](&request, "boardId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			boardID := int64(boardIDFloat)
+			if float64(boardID) != boardIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: boardId %v is not a valid integer", boardIDFloat)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.GetIssueBoardListsOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+			}
+
+			// --- Call GitLab API
+			lists, resp, err := glClient.Boards.GetIssueBoardLists(projectID, boardID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("board %d in project %q", boardID, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(lists) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(lists)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal board lists: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// CreateBoardList defines the MCP tool for adding a label list (column) to a project issue board.
+func CreateBoardList(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"createBoardList",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_CREATE_BOARD_LIST_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Create GitLab Board List",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("boardId",
+				mcp.Description("The ID of the board."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("labelId",
+				mcp.Description("The ID of the label the new list shows issues for."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			boardIDFloat, err := requiredParam[float64](&request, "boardId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			boardID := int64(boardIDFloat)
+			if float64(boardID) != boardIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: boardId %v is not a valid integer", boardIDFloat)), nil
+			}
+
+			labelIDFloat, err := requiredParam[float64](&request, "labelId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			labelID := int64(labelIDFloat)
+			if float64(labelID) != labelIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: labelId %v is not a valid integer", labelIDFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.CreateIssueBoardListOptions{
+				LabelID: &labelID,
+			}
+
+			// --- Call GitLab API
+			list, resp, err := glClient.Boards.CreateIssueBoardList(projectID, boardID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("board %d in project %q", boardID, projectID), "create board list")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(list)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal board list data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// DeleteBoardList defines the MCP tool for removing a list (column) from a project issue board.
+func DeleteBoardList(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"deleteBoardList",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_DELETE_BOARD_LIST_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Delete GitLab Board List",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("boardId",
+				mcp.Description("The ID of the board."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("listId",
+				mcp.Description("The ID of the list to delete."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			boardIDFloat, err := requiredParam[float64](&request, "boardId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			boardID := int64(boardIDFloat)
+			if float64(boardID) != boardIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: boardId %v is not a valid integer", boardIDFloat)), nil
+			}
+
+			listIDFloat, err := requiredParam[float64](&request, "listId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			listID := int64(listIDFloat)
+			if float64(listID) != listIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: listId %v is not a valid integer", listIDFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			resp, err := glClient.Boards.DeleteIssueBoardList(projectID, boardID, listID, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("list %d of board %d in project %q", listID, boardID, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf(`{"message":"List %d successfully deleted from board %d"}`, listID, boardID)), nil
+		}
+}
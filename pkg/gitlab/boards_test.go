@@ -0,0 +1,293 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+func TestListProjectBoardsHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListProjectBoards(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockBoards, ctrl := setupMockClientForBoards(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListProjectBoards(mockGetClient, nil)
+
+	tests := []struct {
+		name               string
+		inputArgs          map[string]any
+		mockSetup          func()
+		expectedText       string
+		expectHandlerError bool
+		expectResultError  bool
+		errorContains      string
+	}{
+		{
+			name:      "Success - List boards",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockBoards.EXPECT().
+					ListIssueBoards("group/project", gomock.Any(), gomock.Any()).
+					Return([]*gl.IssueBoard{{ID: 1, Name: "Development"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: `"name":"Development"`,
+		},
+		{
+			name:      "Success - Empty list",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockBoards.EXPECT().
+					ListIssueBoards("group/project", gomock.Any(), gomock.Any()).
+					Return([]*gl.IssueBoard{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: "[]",
+		},
+		{
+			name:              "Error - Missing projectId",
+			inputArgs:         map[string]any{},
+			mockSetup:         func() {},
+			expectResultError: true,
+			errorContains:     "Validation Error: missing required parameter: projectId",
+		},
+		{
+			name:      "Error - Forbidden (403)",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockBoards.EXPECT().
+					ListIssueBoards("group/project", gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 403}}, errors.New("gitlab: 403 Forbidden"))
+			},
+			expectHandlerError: true,
+			errorContains:      "failed to list boards from project",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.inputArgs}})
+
+			if tc.expectHandlerError {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			textContent := getTextResult(t, result)
+			if tc.expectResultError {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.errorContains)
+				return
+			}
+			assert.Contains(t, textContent.Text, tc.expectedText)
+		})
+	}
+}
+
+func TestGetProjectBoardHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetProjectBoard(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockBoards, ctrl := setupMockClientForBoards(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := GetProjectBoard(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockBoards.EXPECT().
+			GetIssueBoard("group/project", int64(1), gomock.Any()).
+			Return(&gl.IssueBoard{ID: 1, Name: "Development"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"boardId":   1.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"name":"Development"`)
+	})
+
+	t.Run("Error - Not found (404)", func(t *testing.T) {
+		mockBoards.EXPECT().
+			GetIssueBoard("group/project", int64(99), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"boardId":   99.0,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "board 99 in project")
+	})
+}
+
+func TestListProjectBoardListsHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListProjectBoardLists(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockBoards, ctrl := setupMockClientForBoards(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListProjectBoardLists(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockBoards.EXPECT().
+			GetIssueBoardLists("group/project", int64(1), gomock.Any(), gomock.Any()).
+			Return([]*gl.BoardList{{ID: 10, Position: 0, Label: &gl.Label{ID: 5, Name: "Doing"}}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"boardId":   1.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"name":"Doing"`)
+	})
+
+	t.Run("Success - Empty list", func(t *testing.T) {
+		mockBoards.EXPECT().
+			GetIssueBoardLists("group/project", int64(2), gomock.Any(), gomock.Any()).
+			Return([]*gl.BoardList{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"boardId":   2.0,
+		}}})
+		require.NoError(t, err)
+		assert.Equal(t, "[]", getTextResult(t, result).Text)
+	})
+
+	t.Run("Error - Non-integer boardId", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"boardId":   1.5,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: boardId 1.5 is not a valid integer")
+	})
+}
+
+func TestCreateBoardListHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := CreateBoardList(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockBoards, ctrl := setupMockClientForBoards(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := CreateBoardList(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockBoards.EXPECT().
+			CreateIssueBoardList("group/project", int64(1), &gl.CreateIssueBoardListOptions{LabelID: gl.Ptr(int64(5))}, gomock.Any()).
+			Return(&gl.BoardList{ID: 11, Label: &gl.Label{ID: 5, Name: "Review"}}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"boardId":   1.0,
+			"labelId":   5.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"id":11`)
+	})
+
+	t.Run("Error - Missing labelId", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"boardId":   1.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: labelId")
+	})
+
+	t.Run("Error - Label already has a list (400)", func(t *testing.T) {
+		mockBoards.EXPECT().
+			CreateIssueBoardList("group/project", int64(1), gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 400}}, errors.New("gitlab: 400 Label already taken"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"boardId":   1.0,
+			"labelId":   5.0,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "failed to create board list")
+	})
+}
+
+func TestDeleteBoardListHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := DeleteBoardList(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockBoards, ctrl := setupMockClientForBoards(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := DeleteBoardList(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockBoards.EXPECT().
+			DeleteIssueBoardList("group/project", int64(1), int64(11), gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 204}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"boardId":   1.0,
+			"listId":    11.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "List 11 successfully deleted from board 1")
+	})
+
+	t.Run("Error - Non-integer listId", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"boardId":   1.0,
+			"listId":    11.5,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: listId 11.5 is not a valid integer")
+	})
+}
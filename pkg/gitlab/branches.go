@@ -16,6 +16,7 @@ func GetProjectBranches(getClient GetClientFn, t map[string]string) (tool mcp.To
 	return mcp.NewTool(
 			"getProjectBranches",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_PROJECT_BRANCHES_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        "List Project Branches",
 				ReadOnlyHint: boolPtr(true),
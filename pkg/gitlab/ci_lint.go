@@ -0,0 +1,102 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gl "gitlab.com/gitlab-org/api/client-go"
+)
+
+// LintCIConfig defines the MCP tool for validating .gitlab-ci.yml content in the context of a project.
+// The content is sent to the project's CI lint endpoint; nothing is committed.
+func LintCIConfig(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"lintCIConfig",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LINT_CI_CONFIG_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "Lint GitLab CI Configuration",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("content",
+				mcp.Description("The CI/CD configuration (.gitlab-ci.yml) content to validate."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithBoolean("dryRun",
+				mcp.Description("Run a pipeline creation simulation instead of only static validation."),
+			),
+			mcp.WithString("ref",
+				mcp.Description("The branch or tag used as context when dryRun is true (defaults to the project's default branch)."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			content, err := requiredParam[string](&request, "content")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional parameters
+			dryRun, err := OptionalBoolParam(&request, "dryRun")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			ref, err := OptionalParam[string](&request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			// ProjectLint only validates the config already in the repository,
+			// so raw content goes through the namespace lint endpoint instead.
+			opts := &gl.ProjectNamespaceLintOptions{
+				Content: &content,
+				DryRun:  dryRun,
+			}
+
+			if ref != "" {
+				opts.Ref = &ref
+			}
+
+			// --- Call GitLab API
+			lintResult, resp, err := glClient.Validate.ProjectNamespaceLint(projectID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("CI configuration for project %q", projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(lintResult)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal lint result: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
@@ -0,0 +1,127 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+func TestLintCIConfigHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := LintCIConfig(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockValidate, ctrl := setupMockClientForValidate(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := LintCIConfig(mockGetClient, nil)
+
+	content := "build:\n  script: make\n"
+
+	tests := []struct {
+		name               string
+		inputArgs          map[string]any
+		mockSetup          func()
+		expectedContains   []string
+		expectHandlerError bool
+		expectResultError  bool
+	}{
+		{
+			name:      "Success - Valid config",
+			inputArgs: map[string]any{"projectId": "group/project", "content": content},
+			mockSetup: func() {
+				mockValidate.EXPECT().
+					ProjectNamespaceLint("group/project", gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, opts *gl.ProjectNamespaceLintOptions, _ ...gl.RequestOptionFunc) (*gl.ProjectLintResult, *gl.Response, error) {
+						assert.Equal(t, content, *opts.Content)
+						assert.Nil(t, opts.DryRun)
+						assert.Nil(t, opts.Ref)
+						return &gl.ProjectLintResult{Valid: true, MergedYaml: content}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+					})
+			},
+			expectedContains: []string{`"valid":true`, `"merged_yaml"`},
+		},
+		{
+			name:      "Success - Invalid config with dry run",
+			inputArgs: map[string]any{"projectId": "group/project", "content": "bad: [", "dryRun": true, "ref": "main"},
+			mockSetup: func() {
+				mockValidate.EXPECT().
+					ProjectNamespaceLint("group/project", gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, opts *gl.ProjectNamespaceLintOptions, _ ...gl.RequestOptionFunc) (*gl.ProjectLintResult, *gl.Response, error) {
+						assert.True(t, *opts.DryRun)
+						assert.Equal(t, "main", *opts.Ref)
+						return &gl.ProjectLintResult{
+							Valid:    false,
+							Errors:   []string{"(<unknown>): did not find expected node content"},
+							Warnings: []string{},
+						}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+					})
+			},
+			expectedContains: []string{`"valid":false`, "did not find expected node content"},
+		},
+		{
+			name:              "Error - Missing content",
+			inputArgs:         map[string]any{"projectId": "group/project"},
+			mockSetup:         func() {},
+			expectedContains:  []string{"Validation Error: missing required parameter: content"},
+			expectResultError: true,
+		},
+		{
+			name:      "Error - Project Not Found (404)",
+			inputArgs: map[string]any{"projectId": "missing", "content": content},
+			mockSetup: func() {
+				mockValidate.EXPECT().
+					ProjectNamespaceLint("missing", gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Project Not Found"))
+			},
+			expectedContains:  []string{"not found or access denied (404)"},
+			expectResultError: true,
+		},
+		{
+			name:      "Error - GitLab API Error (500)",
+			inputArgs: map[string]any{"projectId": "group/project", "content": content},
+			mockSetup: func() {
+				mockValidate.EXPECT().
+					ProjectNamespaceLint("group/project", gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 500}}, errors.New("gitlab: 500 Internal Server Error"))
+			},
+			expectHandlerError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.inputArgs}})
+
+			if tc.expectHandlerError {
+				require.Error(t, err)
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, tc.expectResultError, result.IsError)
+			text := getTextResult(t, result).Text
+			for _, s := range tc.expectedContains {
+				assert.Contains(t, text, s)
+			}
+		})
+	}
+}
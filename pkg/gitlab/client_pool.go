@@ -2,7 +2,13 @@ package gitlab
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
 	"sync"
 	"time"
 
@@ -11,21 +17,191 @@ import (
 	gl "gitlab.com/gitlab-org/api/client-go"
 )
 
+// ServerProfile is a named GitLab server defined in the settings file,
+// e.g. a [servers.work] section with token and host keys.
+type ServerProfile struct {
+	Name  string `mapstructure:"-"`
+	Token string `mapstructure:"token"`
+	Host  string `mapstructure:"host"`
+}
+
 // ClientPool manages multiple GitLab clients for different servers
 type ClientPool struct {
-	clients map[string]*gl.Client // key: server name
-	store   *TokenStore
-	logger  *log.Logger
-	mu      sync.RWMutex
+	clients               map[string]*gl.Client // key: server name
+	defaultName           string                // client returned by GetDefaultClient, if set
+	store                 *TokenStore
+	logger                *log.Logger
+	maxRetries            int             // retries for transient errors on newly created clients
+	rateLimitThreshold    int             // remaining requests below which a warning is logged
+	transport             *http.Transport // base transport of new clients; nil uses http.DefaultTransport
+	insecureSkipTLSVerify bool            // TLS certificates of GitLab are not verified
+	mu                    sync.RWMutex
 }
 
 // NewClientPool creates a new client pool
 func NewClientPool(store *TokenStore, logger *log.Logger) *ClientPool {
-	return &ClientPool{
-		clients: make(map[string]*gl.Client),
-		store:   store,
-		logger:  logger,
+	cp := &ClientPool{
+		clients:            make(map[string]*gl.Client),
+		store:              store,
+		logger:             logger,
+		maxRetries:         DefaultMaxRetries,
+		rateLimitThreshold: DefaultRateLimitThreshold,
+	}
+	if store != nil {
+		store.OnTokenRefreshed(cp.replaceClient)
+	}
+	return cp
+}
+
+// replaceClient recreates the pooled client for a refreshed token so that subsequent
+// tool calls use the new token. Tokens without a pooled client are ignored. The check
+// and the replacement happen under one lock, so a concurrently removed client stays removed.
+func (cp *ClientPool) replaceClient(name string, metadata TokenMetadata) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	if _, ok := cp.clients[name]; !ok {
+		return
 	}
+
+	glClient, err := cp.newClient(metadata.Token, metadata.GitLabHost)
+	if err != nil {
+		cp.logger.Errorf("Failed to recreate client '%s' after token refresh: %v", name, err)
+		return
+	}
+
+	cp.clients[name] = glClient
+	cp.logger.Infof("Recreated client '%s' with refreshed token", name)
+}
+
+// SetMaxRetries sets how often clients created by the pool retry transient errors.
+// It only affects clients created afterwards.
+func (cp *ClientPool) SetMaxRetries(maxRetries int) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	cp.maxRetries = maxRetries
+}
+
+// SetRateLimitThreshold sets the remaining-requests count below which clients created
+// by the pool log a rate limit warning. It only affects clients created afterwards.
+func (cp *ClientPool) SetRateLimitThreshold(threshold int) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	cp.rateLimitThreshold = threshold
+}
+
+// SetProxy routes the requests of clients created by the pool through the configured
+// proxies. It only affects clients created afterwards and is a no-op for an empty config.
+func (cp *ClientPool) SetProxy(proxy ProxyConfig) error {
+	if proxy.IsZero() {
+		return nil
+	}
+	proxyFunc, err := proxy.proxyFunc()
+	if err != nil {
+		return err
+	}
+
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	transport := cp.baseTransport()
+	transport.Proxy = proxyFunc
+	cp.transport = transport
+	return nil
+}
+
+// baseTransport returns a copy of the transport new clients are built on, so that it
+// can be modified without affecting clients that are already in use. Callers hold cp.mu.
+func (cp *ClientPool) baseTransport() *http.Transport {
+	if cp.transport != nil {
+		return cp.transport.Clone()
+	}
+	return http.DefaultTransport.(*http.Transport).Clone()
+}
+
+// SetInsecureSkipTLSVerify disables TLS certificate verification for clients created by
+// the pool, for self-managed instances with self-signed certificates. Clients for
+// gitlab.com are refused while it is enabled. It only affects clients created afterwards.
+func (cp *ClientPool) SetInsecureSkipTLSVerify(skip bool) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	cp.insecureSkipTLSVerify = skip
+	transport := cp.baseTransport()
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	transport.TLSClientConfig.InsecureSkipVerify = skip //nolint:gosec // explicitly requested by the user
+	cp.transport = transport
+}
+
+// SetCACertFile makes clients created by the pool trust the CA certificates in the PEM
+// file at path, in addition to the system's. It only affects clients created afterwards.
+func (cp *ClientPool) SetCACertFile(path string) error {
+	pemData, err := os.ReadFile(path) //nolint:gosec // path is set by the user
+	if err != nil {
+		return fmt.Errorf("failed to read CA certificate file %q: %w", path, err)
+	}
+
+	rootCAs, err := x509.SystemCertPool()
+	if err != nil {
+		rootCAs = x509.NewCertPool()
+	}
+	if !rootCAs.AppendCertsFromPEM(pemData) {
+		return fmt.Errorf("CA certificate file %q contains no valid PEM certificates", path)
+	}
+
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	transport := cp.baseTransport()
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	transport.TLSClientConfig.RootCAs = rootCAs
+	cp.transport = transport
+	return nil
+}
+
+// isGitLabDotCom reports whether host (empty meaning the default) refers to gitlab.com.
+func isGitLabDotCom(host string) bool {
+	if host == "" {
+		return true
+	}
+	if !strings.Contains(host, "://") {
+		host = "https://" + host
+	}
+	u, err := url.Parse(host)
+	if err != nil {
+		return false
+	}
+	hostname := strings.ToLower(u.Hostname())
+	return hostname == "gitlab.com" || strings.HasSuffix(hostname, ".gitlab.com")
+}
+
+// newClient creates a GitLab client for host (empty or "https://gitlab.com" for gitlab.com)
+// with the pool's transport, retry and rate limit settings.
+func (cp *ClientPool) newClient(token, host string) (*gl.Client, error) {
+	if cp.insecureSkipTLSVerify && isGitLabDotCom(host) {
+		return nil, fmt.Errorf("TLS certificate verification cannot be disabled for gitlab.com")
+	}
+
+	opts := []gl.ClientOptionFunc{}
+	if host != "" && host != "https://gitlab.com" {
+		opts = append(opts, gl.WithBaseURL(host))
+	}
+	opts = append(opts, WithRateLimitCheck(cp.logger, cp.rateLimitThreshold))
+	var transport http.RoundTripper = http.DefaultTransport
+	if cp.transport != nil {
+		transport = cp.transport
+	}
+	return newClientWithTransport(token, transport, cp.maxRetries, opts...)
+}
+
+// NewClient creates a GitLab client for host with the pool's transport, retry and rate
+// limit settings without adding it to the pool, e.g. to validate a token before storing it.
+// It satisfies ClientFactory.
+func (cp *ClientPool) NewClient(token, host string) (*gl.Client, error) {
+	cp.mu.RLock()
+	defer cp.mu.RUnlock()
+	return cp.newClient(token, host)
 }
 
 // AddClient adds a new client to the pool
@@ -58,12 +234,31 @@ func (cp *ClientPool) GetClient(name string) (*gl.Client, error) {
 	return client, nil
 }
 
-// GetDefaultClient returns the default client ("default" or first available)
+// SetDefaultClient selects the client returned by GetDefaultClient. The client must
+// already be in the pool.
+func (cp *ClientPool) SetDefaultClient(name string) error {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	if _, ok := cp.clients[name]; !ok {
+		return fmt.Errorf("client '%s' not found in pool", name)
+	}
+	cp.defaultName = name
+	return nil
+}
+
+// GetDefaultClient returns the default client (the one selected via SetDefaultClient,
+// "default" or first available)
 func (cp *ClientPool) GetDefaultClient() (*gl.Client, string, error) {
 	cp.mu.RLock()
 	defer cp.mu.RUnlock()
 
-	// Try "default" first
+	// Use the explicitly selected client if it is still in the pool
+	if client, ok := cp.clients[cp.defaultName]; ok {
+		return client, cp.defaultName, nil
+	}
+
+	// Try "default" next
 	if client, ok := cp.clients["default"]; ok {
 		return client, "default", nil
 	}
@@ -98,6 +293,9 @@ func (cp *ClientPool) RemoveClient(name string) error {
 	}
 
 	delete(cp.clients, name)
+	if cp.defaultName == name {
+		cp.defaultName = ""
+	}
 	cp.logger.Infof("Removed client '%s' from pool", name)
 	return nil
 }
@@ -105,14 +303,8 @@ func (cp *ClientPool) RemoveClient(name string) error {
 // InitializeFromEnv initializes clients from environment variables and token store
 // This is called during server startup to set up the initial client(s)
 func (cp *ClientPool) InitializeFromEnv(ctx context.Context, token string, host string) error {
-	// Create client options
-	clientOpts := []gl.ClientOptionFunc{}
-	if host != "" && host != "https://gitlab.com" {
-		clientOpts = append(clientOpts, gl.WithBaseURL(host))
-	}
-
 	// Create GitLab client
-	glClient, err := gl.NewClient(token, clientOpts...)
+	glClient, err := cp.newClient(token, host)
 	if err != nil {
 		return fmt.Errorf("failed to create GitLab client: %w", err)
 	}
@@ -151,11 +343,7 @@ func (cp *ClientPool) AddServerFromConfig(ctx context.Context, server *config.Se
 	if err != nil {
 		return fmt.Errorf("resolve token for %q: %w", server.Name, err)
 	}
-	clientOpts := []gl.ClientOptionFunc{}
-	if server.Host != "" && server.Host != "https://gitlab.com" {
-		clientOpts = append(clientOpts, gl.WithBaseURL(server.Host))
-	}
-	glClient, err := gl.NewClient(token, clientOpts...)
+	glClient, err := cp.newClient(token, server.Host)
 	if err != nil {
 		return fmt.Errorf("failed to create GitLab client: %w", err)
 	}
@@ -217,14 +405,8 @@ func (cp *ClientPool) InitializeFromConfig(ctx context.Context, cfg *config.Conf
 
 // initializeServer initializes a single server client
 func (cp *ClientPool) initializeServer(ctx context.Context, name string, server *config.ServerConfig) error {
-	// Create client options
-	clientOpts := []gl.ClientOptionFunc{}
-	if server.Host != "" && server.Host != "https://gitlab.com" {
-		clientOpts = append(clientOpts, gl.WithBaseURL(server.Host))
-	}
-
 	// Create GitLab client
-	glClient, err := gl.NewClient(server.Token, clientOpts...)
+	glClient, err := cp.newClient(server.Token, server.Host)
 	if err != nil {
 		return fmt.Errorf("failed to create GitLab client: %w", err)
 	}
@@ -251,3 +433,44 @@ func (cp *ClientPool) initializeServer(ctx context.Context, name string, server
 
 	return nil
 }
+
+// InitializeFromProfiles initializes one client per server profile from the settings file.
+// Profiles that fail to initialize or whose name is already in the pool are logged and
+// skipped; an error is returned only if profiles were given but none could be initialized.
+func (cp *ClientPool) InitializeFromProfiles(ctx context.Context, profiles []ServerProfile) error {
+	if len(profiles) == 0 {
+		return fmt.Errorf("no server profiles configured")
+	}
+
+	initialized := 0
+	for _, profile := range profiles {
+		if profile.Name == "" {
+			cp.logger.Warn("Skipping server profile without a name")
+			continue
+		}
+		if profile.Token == "" {
+			cp.logger.Warnf("Skipping server profile '%s': no token configured", profile.Name)
+			continue
+		}
+		if _, err := cp.GetClient(profile.Name); err == nil {
+			cp.logger.Warnf("Skipping server profile '%s': a server with this name is already configured", profile.Name)
+			continue
+		}
+		server := &config.ServerConfig{
+			Name:  profile.Name,
+			Host:  profile.Host,
+			Token: profile.Token,
+		}
+		if err := cp.initializeServer(ctx, profile.Name, server); err != nil {
+			cp.logger.Warnf("Failed to initialize client '%s': %v", profile.Name, err)
+			continue
+		}
+		initialized++
+	}
+
+	if initialized == 0 {
+		return fmt.Errorf("none of the %d server profile(s) could be initialized", len(profiles))
+	}
+	cp.logger.Infof("Initialized %d client(s) from server profiles", initialized)
+	return nil
+}
@@ -2,7 +2,11 @@ package gitlab
 
 import (
 	"context"
+	"encoding/pem"
 	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -208,6 +212,49 @@ func TestClientPool_GetDefaultClient(t *testing.T) {
 	})
 }
 
+func TestClientPool_SetDefaultClient(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+	store := NewTokenStore()
+
+	mockClient1 := &gl.Client{}
+	mockClient2 := &gl.Client{}
+
+	t.Run("Success - Selected client takes precedence over default", func(t *testing.T) {
+		cp := NewClientPool(store, logger)
+		require.NoError(t, cp.AddClient("default", mockClient1))
+		require.NoError(t, cp.AddClient("work", mockClient2))
+		require.NoError(t, cp.SetDefaultClient("work"))
+
+		client, name, err := cp.GetDefaultClient()
+		require.NoError(t, err)
+		assert.Same(t, mockClient2, client)
+		assert.Equal(t, "work", name)
+	})
+
+	t.Run("Error - Unknown client", func(t *testing.T) {
+		cp := NewClientPool(store, logger)
+		require.NoError(t, cp.AddClient("default", mockClient1))
+
+		err := cp.SetDefaultClient("personal")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "client 'personal' not found in pool")
+	})
+
+	t.Run("Success - Removing the selected client resets the selection", func(t *testing.T) {
+		cp := NewClientPool(store, logger)
+		require.NoError(t, cp.AddClient("default", mockClient1))
+		require.NoError(t, cp.AddClient("work", mockClient2))
+		require.NoError(t, cp.SetDefaultClient("work"))
+		require.NoError(t, cp.RemoveClient("work"))
+
+		client, name, err := cp.GetDefaultClient()
+		require.NoError(t, err)
+		assert.Same(t, mockClient1, client)
+		assert.Equal(t, "default", name)
+	})
+}
+
 func TestClientPool_ListClients(t *testing.T) {
 	logger := log.New()
 	logger.SetLevel(log.ErrorLevel)
@@ -398,6 +445,148 @@ func TestClientPool_InitializeFromEnv(t *testing.T) {
 	})
 }
 
+func TestClientPool_SetProxy(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	t.Run("Requests go through the proxy", func(t *testing.T) {
+		var proxiedHost string
+		proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// A forward proxy receives the absolute URL of the target
+			proxiedHost = r.URL.Host
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id":1,"username":"root"}`))
+		}))
+		defer proxy.Close()
+
+		cp := NewClientPool(NewTokenStore(), logger)
+		require.NoError(t, cp.SetProxy(ProxyConfig{HTTPProxy: proxy.URL}))
+		require.NoError(t, cp.InitializeFromEnv(context.Background(), "test-token", "http://gitlab.invalid"))
+
+		client, _, err := cp.GetDefaultClient()
+		require.NoError(t, err)
+		user, _, err := client.Users.CurrentUser()
+		require.NoError(t, err)
+		assert.Equal(t, "root", user.Username)
+		assert.Equal(t, "gitlab.invalid", proxiedHost)
+	})
+
+	t.Run("Empty config keeps the default transport", func(t *testing.T) {
+		cp := NewClientPool(NewTokenStore(), logger)
+		require.NoError(t, cp.SetProxy(ProxyConfig{NoProxy: "example.com"}))
+		assert.Nil(t, cp.transport)
+	})
+
+	t.Run("Invalid proxy URL", func(t *testing.T) {
+		cp := NewClientPool(NewTokenStore(), logger)
+		err := cp.SetProxy(ProxyConfig{HTTPProxy: "http://"})
+		require.Error(t, err)
+		assert.Nil(t, cp.transport)
+	})
+}
+
+func TestClientPool_SetInsecureSkipTLSVerify(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":1,"username":"root"}`))
+	}))
+	defer srv.Close()
+
+	t.Run("Self-signed certificate is rejected by default", func(t *testing.T) {
+		cp := NewClientPool(NewTokenStore(), logger)
+		cp.SetMaxRetries(0)
+		require.NoError(t, cp.InitializeFromEnv(context.Background(), "test-token", srv.URL))
+
+		client, _, err := cp.GetDefaultClient()
+		require.NoError(t, err)
+		_, _, err = client.Users.CurrentUser()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "certificate")
+	})
+
+	t.Run("Self-signed certificate is accepted when skipped", func(t *testing.T) {
+		cp := NewClientPool(NewTokenStore(), logger)
+		cp.SetInsecureSkipTLSVerify(true)
+		require.NoError(t, cp.InitializeFromEnv(context.Background(), "test-token", srv.URL))
+
+		client, _, err := cp.GetDefaultClient()
+		require.NoError(t, err)
+		user, _, err := client.Users.CurrentUser()
+		require.NoError(t, err)
+		assert.Equal(t, "root", user.Username)
+	})
+
+	t.Run("Refused for gitlab.com", func(t *testing.T) {
+		for _, host := range []string{"", "https://gitlab.com", "gitlab.com"} {
+			cp := NewClientPool(NewTokenStore(), logger)
+			cp.SetInsecureSkipTLSVerify(true)
+			err := cp.InitializeFromEnv(context.Background(), "test-token", host)
+			require.Error(t, err, "host %q", host)
+			assert.Contains(t, err.Error(), "cannot be disabled for gitlab.com")
+		}
+	})
+}
+
+func TestClientPool_SetCACertFile(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":1,"username":"root"}`))
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+
+	t.Run("Server certificate signed by the CA is trusted", func(t *testing.T) {
+		caFile := filepath.Join(dir, "ca.pem")
+		caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+		require.NoError(t, os.WriteFile(caFile, caPEM, 0o600))
+
+		cp := NewClientPool(NewTokenStore(), logger)
+		require.NoError(t, cp.SetCACertFile(caFile))
+		require.NoError(t, cp.InitializeFromEnv(context.Background(), "test-token", srv.URL))
+
+		client, _, err := cp.GetDefaultClient()
+		require.NoError(t, err)
+		user, _, err := client.Users.CurrentUser()
+		require.NoError(t, err)
+		assert.Equal(t, "root", user.Username)
+	})
+
+	t.Run("Missing file", func(t *testing.T) {
+		cp := NewClientPool(NewTokenStore(), logger)
+		err := cp.SetCACertFile(filepath.Join(dir, "missing.pem"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to read CA certificate file")
+		assert.Nil(t, cp.transport)
+	})
+
+	t.Run("Malformed PEM", func(t *testing.T) {
+		badFile := filepath.Join(dir, "bad.pem")
+		require.NoError(t, os.WriteFile(badFile, []byte("not a certificate"), 0o600))
+
+		cp := NewClientPool(NewTokenStore(), logger)
+		err := cp.SetCACertFile(badFile)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "contains no valid PEM certificates")
+		assert.Nil(t, cp.transport)
+	})
+}
+
+func TestIsGitLabDotCom(t *testing.T) {
+	assert.True(t, isGitLabDotCom(""))
+	assert.True(t, isGitLabDotCom("https://gitlab.com"))
+	assert.True(t, isGitLabDotCom("GitLab.com"))
+	assert.True(t, isGitLabDotCom("https://www.gitlab.com/"))
+	assert.False(t, isGitLabDotCom("https://gitlab.example.com"))
+	assert.False(t, isGitLabDotCom("https://mygitlab.com"))
+}
+
 func TestClientPool_ValidateAllClients(t *testing.T) {
 	logger := log.New()
 	logger.SetLevel(log.ErrorLevel)
@@ -591,3 +780,99 @@ func findResult(results []TokenValidationResult, tokenName string) *TokenValidat
 	}
 	return nil
 }
+
+func TestClientPool_InitializeFromProfiles(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+	ctx := context.Background()
+
+	t.Run("Success - One client per profile", func(t *testing.T) {
+		store := NewTokenStore()
+		cp := NewClientPool(store, logger)
+
+		err := cp.InitializeFromProfiles(ctx, []ServerProfile{
+			{Name: "work", Token: "work-token", Host: "https://gitlab.example.com"},
+			{Name: "personal", Token: "personal-token", Host: "https://gitlab.com"},
+		})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"work", "personal"}, cp.ListClients())
+
+		work, err := cp.GetClient("work")
+		require.NoError(t, err)
+		assert.Equal(t, "https://gitlab.example.com/api/v4/", work.BaseURL().String())
+
+		metadata, err := store.GetToken("work")
+		require.NoError(t, err)
+		assert.Equal(t, "work-token", metadata.Token)
+		assert.Equal(t, "https://gitlab.example.com", metadata.GitLabHost)
+	})
+
+	t.Run("Success - Invalid profiles are skipped", func(t *testing.T) {
+		cp := NewClientPool(NewTokenStore(), logger)
+
+		existing := &gl.Client{}
+		require.NoError(t, cp.AddClient("existing", existing))
+
+		err := cp.InitializeFromProfiles(ctx, []ServerProfile{
+			{Name: "work", Token: "work-token"},
+			{Name: "no-token"},
+			{Token: "no-name"},
+			{Name: "existing", Token: "other-token"},
+		})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"work", "existing"}, cp.ListClients())
+
+		client, err := cp.GetClient("existing")
+		require.NoError(t, err)
+		assert.Same(t, existing, client, "profiles do not replace configured servers")
+	})
+
+	t.Run("Error - No profiles", func(t *testing.T) {
+		cp := NewClientPool(NewTokenStore(), logger)
+
+		err := cp.InitializeFromProfiles(ctx, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no server profiles configured")
+	})
+
+	t.Run("Error - No valid profile", func(t *testing.T) {
+		cp := NewClientPool(NewTokenStore(), logger)
+
+		err := cp.InitializeFromProfiles(ctx, []ServerProfile{{Name: "work"}})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "none of the 1 server profile(s) could be initialized")
+	})
+}
+
+func TestClientPool_ReplacesClientOnTokenRefresh(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+	store := NewTokenStore()
+	cp := NewClientPool(store, logger)
+
+	oldClient := &gl.Client{}
+	require.NoError(t, cp.AddClient("work", oldClient))
+	require.NoError(t, store.AddToken("work", &TokenMetadata{Token: "old-token"}))
+
+	testClient := gltesting.NewTestClient(t)
+	testClient.MockUsers.EXPECT().
+		CurrentUser(gomock.Any()).
+		Return(&gl.User{ID: 1, Username: "rotated"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+	testClient.MockPersonalAccessTokens.EXPECT().
+		GetSinglePersonalAccessToken(gomock.Any()).
+		Return(&gl.PersonalAccessToken{}, nil, nil)
+
+	_, err := store.RefreshToken(context.Background(), "work", "new-token", "https://gitlab.example.com", testClient.Client)
+	require.NoError(t, err)
+
+	client, err := cp.GetClient("work")
+	require.NoError(t, err)
+	assert.NotSame(t, oldClient, client)
+	assert.Equal(t, "https://gitlab.example.com/api/v4/", client.BaseURL().String())
+
+	// A removed client is not brought back by a later refresh
+	require.NoError(t, cp.RemoveClient("work"))
+	cp.replaceClient("work", TokenMetadata{Token: "newer-token"})
+	_, err = cp.GetClient("work")
+	assert.Error(t, err)
+}
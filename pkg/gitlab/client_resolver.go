@@ -2,11 +2,65 @@ package gitlab
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
 	log "github.com/sirupsen/logrus"
 	gl "gitlab.com/gitlab-org/api/client-go"
 )
 
+// gitlabServerParam is the optional tool argument that selects the GitLab server for a call.
+// It is not called "server" because setCurrentProject already uses that name for the
+// server to record in .gmcprc.
+const gitlabServerParam = "gitlabServer"
+
+// serverNameKey is the context key for the server selected by a tool call's "gitlabServer" argument
+type serverNameKey struct{}
+
+// ContextWithServerName returns a copy of ctx that makes ClientResolver use the named server
+func ContextWithServerName(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, serverNameKey{}, name)
+}
+
+// ServerNameFromContext returns the server selected for the current tool call, if any
+func ServerNameFromContext(ctx context.Context) string {
+	name, _ := ctx.Value(serverNameKey{}).(string)
+	return name
+}
+
+// WithGitLabServer adds the optional 'gitlabServer' parameter to tools that call GitLab.
+func WithGitLabServer() mcp.ToolOption {
+	return mcp.WithString(gitlabServerParam,
+		mcp.Description("Optional: Name of the configured GitLab server to use for this call. Defaults to the server from .gmcprc or the default server."),
+	)
+}
+
+// WithServerArgument makes the "gitlabServer" argument select the GitLab server used to
+// handle a call, for tools that declare it via WithGitLabServer. Tool handlers only receive
+// a context when resolving their client, so the argument is passed on through the context.
+func WithServerArgument() server.ServerOption {
+	return func(s *server.MCPServer) {
+		server.WithToolHandlerMiddleware(serverArgumentMiddleware(s.GetTool))(s)
+	}
+}
+
+// serverArgumentMiddleware stores a non-empty "gitlabServer" argument in the context of the
+// call. Tools looked up via getTool that do not declare the parameter are left alone.
+func serverArgumentMiddleware(getTool func(name string) *server.ServerTool) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			if tool := getTool(request.Params.Name); tool != nil {
+				_, declared := tool.Tool.InputSchema.Properties[gitlabServerParam]
+				if name, _ := request.GetArguments()[gitlabServerParam].(string); declared && name != "" {
+					ctx = ContextWithServerName(ctx, name)
+				}
+			}
+			return next(ctx, request)
+		}
+	}
+}
+
 // ClientResolver resolves which GitLab client to use for a given context
 // It supports:
 // 1. Project-specific token (from .gmcprc)
@@ -29,11 +83,22 @@ func NewClientResolver(pool *ClientPool, defaultServer string, logger *log.Logge
 
 // Resolve determines which client to use based on the current context
 // Resolution order:
-// 1. Read .gmcprc to get tokenName
-// 2. If tokenName exists, use that client
-// 3. If gitlabHost in .gmcprc, find matching client by host
-// 4. Fall back to defaultServer
+// 1. Server named by the tool call's "gitlabServer" argument (no fallback if unknown)
+// 2. Read .gmcprc to get tokenName
+// 3. If tokenName exists, use that client
+// 4. If gitlabHost in .gmcprc, find matching client by host
+// 5. Fall back to defaultServer
 func (cr *ClientResolver) Resolve(ctx context.Context) (*gl.Client, string, error) {
+	// An explicitly requested server must exist; silently using another one could act on the wrong instance
+	if name := ServerNameFromContext(ctx); name != "" {
+		client, err := cr.pool.GetClient(name)
+		if err != nil {
+			return nil, "", fmt.Errorf("server %q requested by the tool call: %w", name, err)
+		}
+		cr.logger.Debugf("Using client '%s' requested by the tool call", name)
+		return client, name, nil
+	}
+
 	// Try to read project config
 	config, configPath, err := FindProjectConfig()
 	if err != nil || config == nil {
@@ -6,6 +6,8 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -557,3 +559,92 @@ func TestClientResolver_MultiLevelFallback(t *testing.T) {
 	assert.Same(t, mockDefault, client)
 	assert.Equal(t, "default", name)
 }
+
+func TestClientResolver_Resolve_ServerArgument(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+	pool := NewClientPool(NewTokenStore(), logger)
+
+	defaultClient := &gl.Client{}
+	workClient := &gl.Client{}
+	require.NoError(t, pool.AddClient("default", defaultClient))
+	require.NoError(t, pool.AddClient("work", workClient))
+
+	cr := NewClientResolver(pool, "default", logger)
+
+	t.Run("Success - Requested server is used", func(t *testing.T) {
+		client, name, err := cr.Resolve(ContextWithServerName(context.Background(), "work"))
+		require.NoError(t, err)
+		assert.Same(t, workClient, client)
+		assert.Equal(t, "work", name)
+	})
+
+	t.Run("Error - Unknown requested server does not fall back", func(t *testing.T) {
+		client, _, err := cr.Resolve(ContextWithServerName(context.Background(), "personal"))
+		require.Error(t, err)
+		assert.Nil(t, client)
+		assert.Contains(t, err.Error(), `server "personal" requested by the tool call`)
+	})
+}
+
+func TestServerArgumentMiddleware(t *testing.T) {
+	routedTool, _ := ListIssues(nil, nil)
+	setProjectTool, _ := SetCurrentProject(nil, nil)
+	tools := map[string]*server.ServerTool{
+		routedTool.Name:     {Tool: routedTool},
+		setProjectTool.Name: {Tool: setProjectTool},
+	}
+	getTool := func(name string) *server.ServerTool { return tools[name] }
+
+	var got string
+	handler := serverArgumentMiddleware(getTool)(func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		got = ServerNameFromContext(ctx)
+		return mcp.NewToolResultText("ok"), nil
+	})
+
+	tests := []struct {
+		name     string
+		tool     string
+		args     map[string]any
+		expected string
+	}{
+		{name: "GitLab server argument", tool: "listIssues", args: map[string]any{"gitlabServer": "work", "projectId": "1"}, expected: "work"},
+		{name: "No GitLab server argument", tool: "listIssues", args: map[string]any{"projectId": "1"}, expected: ""},
+		{name: "Empty GitLab server argument", tool: "listIssues", args: map[string]any{"gitlabServer": ""}, expected: ""},
+		{name: "Server argument is not used for routing", tool: "listIssues", args: map[string]any{"server": "work"}, expected: ""},
+		// setCurrentProject's "server" is the server to record in .gmcprc, not the one to call
+		{name: "setCurrentProject server is not routed", tool: "setCurrentProject", args: map[string]any{"projectId": "1", "server": "work"}, expected: ""},
+		{name: "Undeclared GitLab server argument", tool: "setCurrentProject", args: map[string]any{"projectId": "1", "gitlabServer": "work"}, expected: ""},
+		{name: "Unknown tool", tool: "unknownTool", args: map[string]any{"gitlabServer": "work"}, expected: ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got = "unset"
+			req := createMCPRequest(tc.args)
+			req.Params.Name = tc.tool
+			_, err := handler(context.Background(), *req)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+// TestGitLabServerParam checks that every tool calling GitLab declares the gitlabServer
+// parameter, except the project config tools whose "server" describes .gmcprc.
+func TestGitLabServerParam(t *testing.T) {
+	tg, err := InitToolsets([]string{"all"}, false, mockGetClientFn, nil, NewTokenStore(), nil, nil, false)
+	require.NoError(t, err)
+
+	for name, ts := range tg.Toolsets {
+		for _, st := range ts.Tools() {
+			_, declared := st.Tool.InputSchema.Properties[gitlabServerParam]
+			switch name {
+			case "token_management", "project_config":
+				assert.False(t, declared, "tool %s should not declare %s", st.Tool.Name, gitlabServerParam)
+			default:
+				assert.True(t, declared, "tool %s should declare %s", st.Tool.Name, gitlabServerParam)
+			}
+		}
+	}
+}
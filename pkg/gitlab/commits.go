@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -16,6 +18,7 @@ func GetProjectCommits(getClient GetClientFn, t map[string]string) (tool mcp.Too
 	return mcp.NewTool(
 			"getProjectCommits",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_PROJECT_COMMITS_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        "List Project Commits",
 				ReadOnlyHint: boolPtr(true),
@@ -129,3 +132,244 @@ func GetProjectCommits(getClient GetClientFn, t map[string]string) (tool mcp.Too
 			return mcp.NewToolResultText(string(data)), nil
 		}
 }
+
+// commitActionPayload is a single file action accepted by createCommitWithMultipleActions.
+type commitActionPayload struct {
+	Action          string `json:"action"`
+	FilePath        string `json:"filePath"`
+	PreviousPath    string `json:"previousPath"`
+	Content         string `json:"content"`
+	Encoding        string `json:"encoding"`
+	LastCommitID    string `json:"lastCommitId"`
+	ExecuteFilemode *bool  `json:"executeFilemode"`
+}
+
+// commitActions lists the file actions GitLab accepts in a commit.
+var commitActions = []string{"create", "delete", "move", "update", "chmod"}
+
+// parseCommitActions reads the required array of file actions (or its JSON string form) and
+// converts each entry into commit action options. The whole commit is rejected if any entry is invalid.
+func parseCommitActions(r *mcp.CallToolRequest, p string) ([]*gl.CommitActionOptions, error) {
+	rawVal, ok := r.GetArguments()[p]
+	if !ok || rawVal == nil {
+		return nil, fmt.Errorf("missing required parameter: %s", p)
+	}
+
+	var raw []byte
+	if s, isStr := rawVal.(string); isStr {
+		raw = []byte(s)
+	} else {
+		var err error
+		if raw, err = json.Marshal(rawVal); err != nil {
+			return nil, fmt.Errorf("parameter '%s' must be an array: %w", p, err)
+		}
+	}
+
+	var payloads []commitActionPayload
+	if err := json.Unmarshal(raw, &payloads); err != nil {
+		return nil, fmt.Errorf("parameter '%s' must be an array of objects with action, filePath, previousPath, content, encoding, lastCommitId and executeFilemode: %w", p, err)
+	}
+	if len(payloads) == 0 {
+		return nil, fmt.Errorf("parameter '%s' must contain at least one action", p)
+	}
+
+	actions := make([]*gl.CommitActionOptions, 0, len(payloads))
+	for i, payload := range payloads {
+		if !slices.Contains(commitActions, payload.Action) {
+			return nil, fmt.Errorf("parameter '%s' entry %d has invalid action %q, must be one of: %s", p, i, payload.Action, strings.Join(commitActions, ", "))
+		}
+		if payload.FilePath == "" {
+			return nil, fmt.Errorf("parameter '%s' entry %d must set a filePath", p, i)
+		}
+		if payload.Action == "move" && payload.PreviousPath == "" {
+			return nil, fmt.Errorf("parameter '%s' entry %d must set a previousPath to move a file", p, i)
+		}
+		if payload.Action == "chmod" && payload.ExecuteFilemode == nil {
+			return nil, fmt.Errorf("parameter '%s' entry %d must set executeFilemode to chmod a file", p, i)
+		}
+		if payload.Encoding != "" && payload.Encoding != "text" && payload.Encoding != "base64" {
+			return nil, fmt.Errorf("parameter '%s' entry %d has invalid encoding %q, must be 'text' or 'base64'", p, i, payload.Encoding)
+		}
+
+		action := &gl.CommitActionOptions{
+			Action:          gl.Ptr(gl.FileActionValue(payload.Action)),
+			FilePath:        gl.Ptr(payload.FilePath),
+			ExecuteFilemode: payload.ExecuteFilemode,
+		}
+		if payload.PreviousPath != "" {
+			action.PreviousPath = gl.Ptr(payload.PreviousPath)
+		}
+		// An empty content is meaningful when creating or updating a file
+		if payload.Action == "create" || payload.Action == "update" || payload.Content != "" {
+			action.Content = gl.Ptr(payload.Content)
+		}
+		if payload.Encoding != "" {
+			action.Encoding = gl.Ptr(payload.Encoding)
+		}
+		if payload.LastCommitID != "" {
+			action.LastCommitID = gl.Ptr(payload.LastCommitID)
+		}
+		actions = append(actions, action)
+	}
+
+	return actions, nil
+}
+
+// CreateCommitWithMultipleActions defines the MCP tool for creating a commit that applies several
+// file actions atomically.
+func CreateCommitWithMultipleActions(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"createCommitWithMultipleActions",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_CREATE_COMMIT_WITH_MULTIPLE_ACTIONS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Create Commit With Multiple Actions",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("branch",
+				mcp.Description("The branch to commit to. To create a new branch, also set startBranch or startSha."),
+				mcp.Required(),
+			),
+			mcp.WithString("commitMessage",
+				mcp.Description("The commit message."),
+				mcp.Required(),
+			),
+			mcp.WithArray("actions",
+				mcp.Description("The file actions to apply in the commit, e.g. [{\"action\": \"create\", \"filePath\": \"docs/new.md\", \"content\": \"# New\"}, {\"action\": \"move\", \"previousPath\": \"old.txt\", \"filePath\": \"new.txt\"}]."),
+				mcp.Required(),
+				mcp.Items(map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"action":          map[string]any{"type": "string", "enum": commitActions, "description": "The action to perform on the file."},
+						"filePath":        map[string]any{"type": "string", "description": "The full path of the file."},
+						"previousPath":    map[string]any{"type": "string", "description": "The original full path of the file. Required for 'move'."},
+						"content":         map[string]any{"type": "string", "description": "The file content. Used by 'create' and 'update', optional for 'move'."},
+						"encoding":        map[string]any{"type": "string", "enum": []string{"text", "base64"}, "description": "The encoding of content (GitLab default: 'text')."},
+						"lastCommitId":    map[string]any{"type": "string", "description": "The last known commit ID of the file. The action fails if the file changed since."},
+						"executeFilemode": map[string]any{"type": "boolean", "description": "Whether the file is executable. Required for 'chmod'."},
+					},
+					"required": []string{"action", "filePath"},
+				}),
+			),
+			// Optional parameters
+			mcp.WithString("startBranch",
+				mcp.Description("The branch to start the new branch from."),
+			),
+			mcp.WithString("startSha",
+				mcp.Description("The commit SHA to start the new branch from."),
+			),
+			mcp.WithString("authorEmail",
+				mcp.Description("The email of the commit author."),
+			),
+			mcp.WithString("authorName",
+				mcp.Description("The name of the commit author."),
+			),
+			mcp.WithBoolean("force",
+				mcp.Description("Overwrite the target branch with a new commit based on startBranch or startSha."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			branch, err := requiredParam[string](&request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			commitMessage, err := requiredParam[string](&request, "commitMessage")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			actions, err := parseCommitActions(&request, "actions")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional parameters
+			startBranch, err := OptionalParam[string](&request, "startBranch")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			startSHA, err := OptionalParam[string](&request, "startSha")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			if startBranch != "" && startSHA != "" {
+				return mcp.NewToolResultError("Validation Error: startBranch and startSha cannot be used together"), nil
+			}
+
+			authorEmail, err := OptionalParam[string](&request, "authorEmail")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			authorName, err := OptionalParam[string](&request, "authorName")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			force, err := OptionalBoolParam(&request, "force")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.CreateCommitOptions{
+				Branch:        &branch,
+				CommitMessage: &commitMessage,
+				Actions:       actions,
+				Force:         force,
+			}
+
+			if startBranch != "" {
+				opts.StartBranch = &startBranch
+			}
+
+			if startSHA != "" {
+				opts.StartSHA = &startSHA
+			}
+
+			if authorEmail != "" {
+				opts.AuthorEmail = &authorEmail
+			}
+
+			if authorName != "" {
+				opts.AuthorName = &authorName
+			}
+
+			// --- Call GitLab API
+			commit, resp, err := glClient.Commits.CreateCommit(projectID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("project %q", projectID), "create commit")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(commit)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal commit data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
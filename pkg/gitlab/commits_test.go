@@ -327,3 +327,185 @@ func TestGetProjectCommitsHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateCommitWithMultipleActionsHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := CreateCommitWithMultipleActions(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockCommits, ctrl := setupMockClientForCommits(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := CreateCommitWithMultipleActions(mockGetClient, nil)
+
+	t.Run("Success - All actions are sent", func(t *testing.T) {
+		mockCommits.EXPECT().
+			CreateCommit("group/project", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.CreateCommitOptions, _ ...gl.RequestOptionFunc) (*gl.Commit, *gl.Response, error) {
+				assert.Equal(t, "feature", *opts.Branch)
+				assert.Equal(t, "Restructure docs", *opts.CommitMessage)
+				assert.Equal(t, "main", *opts.StartBranch)
+				assert.Equal(t, "Jane Doe", *opts.AuthorName)
+				assert.Nil(t, opts.StartSHA)
+				require.Len(t, opts.Actions, 5)
+
+				assert.Equal(t, gl.FileCreate, *opts.Actions[0].Action)
+				assert.Equal(t, "docs/new.md", *opts.Actions[0].FilePath)
+				assert.Equal(t, "# New", *opts.Actions[0].Content)
+
+				assert.Equal(t, gl.FileUpdate, *opts.Actions[1].Action)
+				assert.Equal(t, "aGVsbG8=", *opts.Actions[1].Content)
+				assert.Equal(t, "base64", *opts.Actions[1].Encoding)
+				assert.Equal(t, "abc123", *opts.Actions[1].LastCommitID)
+
+				assert.Equal(t, gl.FileMove, *opts.Actions[2].Action)
+				assert.Equal(t, "old.txt", *opts.Actions[2].PreviousPath)
+				assert.Nil(t, opts.Actions[2].Content)
+
+				assert.Equal(t, gl.FileDelete, *opts.Actions[3].Action)
+
+				assert.Equal(t, gl.FileChmod, *opts.Actions[4].Action)
+				assert.True(t, *opts.Actions[4].ExecuteFilemode)
+				return &gl.Commit{ID: "def456", Title: "Restructure docs"}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":     "group/project",
+			"branch":        "feature",
+			"commitMessage": "Restructure docs",
+			"startBranch":   "main",
+			"authorName":    "Jane Doe",
+			"actions": []any{
+				map[string]any{"action": "create", "filePath": "docs/new.md", "content": "# New"},
+				map[string]any{"action": "update", "filePath": "data.bin", "content": "aGVsbG8=", "encoding": "base64", "lastCommitId": "abc123"},
+				map[string]any{"action": "move", "filePath": "new.txt", "previousPath": "old.txt"},
+				map[string]any{"action": "delete", "filePath": "obsolete.txt"},
+				map[string]any{"action": "chmod", "filePath": "run.sh", "executeFilemode": true},
+			},
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"id":"def456"`)
+	})
+
+	t.Run("Success - Actions as JSON string and empty content", func(t *testing.T) {
+		mockCommits.EXPECT().
+			CreateCommit("group/project", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.CreateCommitOptions, _ ...gl.RequestOptionFunc) (*gl.Commit, *gl.Response, error) {
+				require.Len(t, opts.Actions, 1)
+				require.NotNil(t, opts.Actions[0].Content)
+				assert.Equal(t, "", *opts.Actions[0].Content)
+				return &gl.Commit{ID: "def789"}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":     "group/project",
+			"branch":        "main",
+			"commitMessage": "Add placeholder",
+			"actions":       `[{"action": "create", "filePath": ".gitkeep"}]`,
+		}}})
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	validationTests := []struct {
+		name        string
+		args        map[string]any
+		expectedErr string
+	}{
+		{
+			name:        "Missing commitMessage",
+			args:        map[string]any{"projectId": "group/project", "branch": "main", "actions": []any{map[string]any{"action": "delete", "filePath": "a.txt"}}},
+			expectedErr: "Validation Error: missing required parameter: commitMessage",
+		},
+		{
+			name:        "Missing actions",
+			args:        map[string]any{"projectId": "group/project", "branch": "main", "commitMessage": "msg"},
+			expectedErr: "Validation Error: missing required parameter: actions",
+		},
+		{
+			name:        "Empty actions",
+			args:        map[string]any{"projectId": "group/project", "branch": "main", "commitMessage": "msg", "actions": []any{}},
+			expectedErr: "parameter 'actions' must contain at least one action",
+		},
+		{
+			name:        "Invalid action",
+			args:        map[string]any{"projectId": "group/project", "branch": "main", "commitMessage": "msg", "actions": []any{map[string]any{"action": "rename", "filePath": "a.txt"}}},
+			expectedErr: `parameter 'actions' entry 0 has invalid action "rename"`,
+		},
+		{
+			name:        "Missing filePath",
+			args:        map[string]any{"projectId": "group/project", "branch": "main", "commitMessage": "msg", "actions": []any{map[string]any{"action": "delete"}}},
+			expectedErr: "parameter 'actions' entry 0 must set a filePath",
+		},
+		{
+			name:        "Move without previousPath",
+			args:        map[string]any{"projectId": "group/project", "branch": "main", "commitMessage": "msg", "actions": []any{map[string]any{"action": "delete", "filePath": "a.txt"}, map[string]any{"action": "move", "filePath": "b.txt"}}},
+			expectedErr: "parameter 'actions' entry 1 must set a previousPath to move a file",
+		},
+		{
+			name:        "Chmod without executeFilemode",
+			args:        map[string]any{"projectId": "group/project", "branch": "main", "commitMessage": "msg", "actions": []any{map[string]any{"action": "chmod", "filePath": "run.sh"}}},
+			expectedErr: "parameter 'actions' entry 0 must set executeFilemode to chmod a file",
+		},
+		{
+			name:        "Invalid encoding",
+			args:        map[string]any{"projectId": "group/project", "branch": "main", "commitMessage": "msg", "actions": []any{map[string]any{"action": "create", "filePath": "a.txt", "encoding": "utf8"}}},
+			expectedErr: `parameter 'actions' entry 0 has invalid encoding "utf8"`,
+		},
+		{
+			name:        "Malformed actions JSON",
+			args:        map[string]any{"projectId": "group/project", "branch": "main", "commitMessage": "msg", "actions": `[{"action": `},
+			expectedErr: "parameter 'actions' must be an array of objects",
+		},
+		{
+			name:        "Both startBranch and startSha",
+			args:        map[string]any{"projectId": "group/project", "branch": "feature", "commitMessage": "msg", "startBranch": "main", "startSha": "abc123", "actions": []any{map[string]any{"action": "delete", "filePath": "a.txt"}}},
+			expectedErr: "Validation Error: startBranch and startSha cannot be used together",
+		},
+	}
+
+	for _, tc := range validationTests {
+		t.Run("Error - "+tc.name, func(t *testing.T) {
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}})
+			require.NoError(t, err)
+			assert.True(t, result.IsError)
+			assert.Contains(t, getTextResult(t, result).Text, tc.expectedErr)
+		})
+	}
+
+	t.Run("Error - Stale lastCommitId (400)", func(t *testing.T) {
+		mockCommits.EXPECT().
+			CreateCommit("group/project", gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 400}}, errors.New("gitlab: 400 A file with this name doesn't exist"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":     "group/project",
+			"branch":        "main",
+			"commitMessage": "msg",
+			"actions":       []any{map[string]any{"action": "update", "filePath": "missing.txt", "content": "x"}},
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "failed to create commit")
+	})
+
+	t.Run("Error - Project not found (404)", func(t *testing.T) {
+		mockCommits.EXPECT().
+			CreateCommit("group/missing", gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Project Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":     "group/missing",
+			"branch":        "main",
+			"commitMessage": "msg",
+			"actions":       []any{map[string]any{"action": "delete", "filePath": "a.txt"}},
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `project "group/missing" not found or access denied (404)`)
+	})
+}
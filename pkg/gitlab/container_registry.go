@@ -0,0 +1,232 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gl "gitlab.com/gitlab-org/api/client-go"
+)
+
+// ListContainerRegistryRepositories defines the MCP tool for listing the container registry repositories of a GitLab project.
+func ListContainerRegistryRepositories(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listContainerRegistryRepositories",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_CONTAINER_REGISTRY_REPOSITORIES_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Container Registry Repositories",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.ListProjectRegistryRepositoriesOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+				TagsCount: gl.Ptr(true),
+			}
+
+			// --- Call GitLab API
+			repositories, resp, err := glClient.ContainerRegistry.ListProjectRegistryRepositories(projectID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, fmt.Sprintf("container registry repositories from project %q", projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(repositories) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(repositories)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal container registry repositories list: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// ListContainerRegistryTags defines the MCP tool for listing the tags of a container registry repository.
+func ListContainerRegistryTags(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listContainerRegistryTags",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_CONTAINER_REGISTRY_TAGS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Container Registry Tags",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("repositoryId",
+				mcp.Description("The ID of the container registry repository."),
+				mcp.Required(),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			repositoryIDFloat, err := requiredParam[float64](&request, "repositoryId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			repositoryID := int64(repositoryIDFloat)
+			if float64(repositoryID) != repositoryIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: repositoryId %v is not a valid integer", repositoryIDFloat)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			opts := &gl.ListRegistryRepositoryTagsOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+			}
+			tags, resp, err := glClient.ContainerRegistry.ListRegistryRepositoryTags(projectID, repositoryID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, fmt.Sprintf("tags of container registry repository %d in project %q", repositoryID, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(tags) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(tags)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal container registry tags list: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// DeleteContainerRegistryTag defines the MCP tool for deleting a single tag from a container registry repository.
+func DeleteContainerRegistryTag(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"deleteContainerRegistryTag",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_DELETE_CONTAINER_REGISTRY_TAG_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Delete GitLab Container Registry Tag",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("repositoryId",
+				mcp.Description("The ID of the container registry repository."),
+				mcp.Required(),
+			),
+			mcp.WithString("tagName",
+				mcp.Description("The name of the image tag to delete, e.g. \"v1.2.0\"."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			repositoryIDFloat, err := requiredParam[float64](&request, "repositoryId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			repositoryID := int64(repositoryIDFloat)
+			if float64(repositoryID) != repositoryIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: repositoryId %v is not a valid integer", repositoryIDFloat)), nil
+			}
+
+			tagName, err := requiredParam[string](&request, "tagName")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			resp, err := glClient.ContainerRegistry.DeleteRegistryRepositoryTag(projectID, repositoryID, tagName, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("tag %q of container registry repository %d in project %q", tagName, repositoryID, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf(`{"message":"Tag %q successfully deleted from container registry repository %d"}`, tagName, repositoryID)), nil
+		}
+}
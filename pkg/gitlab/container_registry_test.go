@@ -0,0 +1,250 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+func TestListContainerRegistryRepositoriesHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListContainerRegistryRepositories(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockRegistry, ctrl := setupMockClientForContainerRegistry(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListContainerRegistryRepositories(mockGetClient, nil)
+
+	tests := []struct {
+		name               string
+		inputArgs          map[string]any
+		mockSetup          func()
+		expectedText       string
+		expectHandlerError bool
+		expectResultError  bool
+		errorContains      string
+	}{
+		{
+			name:      "Success - List repositories",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockRegistry.EXPECT().
+					ListProjectRegistryRepositories("group/project", gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, opts *gl.ListProjectRegistryRepositoriesOptions, _ ...gl.RequestOptionFunc) ([]*gl.RegistryRepository, *gl.Response, error) {
+						assert.True(t, *opts.TagsCount)
+						return []*gl.RegistryRepository{{ID: 3, Path: "group/project/app", TagsCount: 12}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+					})
+			},
+			expectedText: `"tags_count":12`,
+		},
+		{
+			name:      "Success - Empty list",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockRegistry.EXPECT().
+					ListProjectRegistryRepositories("group/project", gomock.Any(), gomock.Any()).
+					Return([]*gl.RegistryRepository{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: "[]",
+		},
+		{
+			name:              "Error - Missing projectId",
+			inputArgs:         map[string]any{},
+			mockSetup:         func() {},
+			expectResultError: true,
+			errorContains:     "Validation Error: missing required parameter: projectId",
+		},
+		{
+			name:      "Error - Forbidden (403)",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockRegistry.EXPECT().
+					ListProjectRegistryRepositories("group/project", gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 403}}, errors.New("gitlab: 403 Forbidden"))
+			},
+			expectHandlerError: true,
+			errorContains:      "failed to list container registry repositories from project",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.inputArgs}})
+
+			if tc.expectHandlerError {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			textContent := getTextResult(t, result)
+			if tc.expectResultError {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.errorContains)
+				return
+			}
+			assert.Contains(t, textContent.Text, tc.expectedText)
+		})
+	}
+}
+
+func TestListContainerRegistryTagsHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListContainerRegistryTags(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockRegistry, ctrl := setupMockClientForContainerRegistry(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListContainerRegistryTags(mockGetClient, nil)
+
+	tests := []struct {
+		name               string
+		inputArgs          map[string]any
+		mockSetup          func()
+		expectedText       string
+		expectHandlerError bool
+		expectResultError  bool
+		errorContains      string
+	}{
+		{
+			name:      "Success - List tags",
+			inputArgs: map[string]any{"projectId": "group/project", "repositoryId": 3.0, "page": 2},
+			mockSetup: func() {
+				mockRegistry.EXPECT().
+					ListRegistryRepositoryTags("group/project", int64(3), &gl.ListRegistryRepositoryTagsOptions{ListOptions: gl.ListOptions{Page: 2, PerPage: 20}}, gomock.Any()).
+					Return([]*gl.RegistryRepositoryTag{{Name: "v1.0.0", Location: "registry.example.com/group/project/app:v1.0.0"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: `"name":"v1.0.0"`,
+		},
+		{
+			name:      "Success - Empty list",
+			inputArgs: map[string]any{"projectId": "group/project", "repositoryId": 3.0},
+			mockSetup: func() {
+				mockRegistry.EXPECT().
+					ListRegistryRepositoryTags("group/project", int64(3), gomock.Any(), gomock.Any()).
+					Return([]*gl.RegistryRepositoryTag{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: "[]",
+		},
+		{
+			name:              "Error - Non-integer repositoryId",
+			inputArgs:         map[string]any{"projectId": "group/project", "repositoryId": 3.5},
+			mockSetup:         func() {},
+			expectResultError: true,
+			errorContains:     "Validation Error: repositoryId 3.5 is not a valid integer",
+		},
+		{
+			name:      "Error - Not found (404)",
+			inputArgs: map[string]any{"projectId": "group/project", "repositoryId": 99.0},
+			mockSetup: func() {
+				mockRegistry.EXPECT().
+					ListRegistryRepositoryTags("group/project", int64(99), gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+			},
+			expectHandlerError: true,
+			errorContains:      "failed to list tags of container registry repository 99",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.inputArgs}})
+
+			if tc.expectHandlerError {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			textContent := getTextResult(t, result)
+			if tc.expectResultError {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.errorContains)
+				return
+			}
+			assert.Contains(t, textContent.Text, tc.expectedText)
+		})
+	}
+}
+
+func TestDeleteContainerRegistryTagHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := DeleteContainerRegistryTag(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockRegistry, ctrl := setupMockClientForContainerRegistry(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := DeleteContainerRegistryTag(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockRegistry.EXPECT().
+			DeleteRegistryRepositoryTag("group/project", int64(3), "v1.0.0", gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":    "group/project",
+			"repositoryId": 3.0,
+			"tagName":      "v1.0.0",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `Tag "v1.0.0" successfully deleted from container registry repository 3`)
+	})
+
+	t.Run("Error - Missing tagName", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":    "group/project",
+			"repositoryId": 3.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: tagName")
+	})
+
+	t.Run("Error - Not found (404)", func(t *testing.T) {
+		mockRegistry.EXPECT().
+			DeleteRegistryRepositoryTag("group/project", int64(3), "gone", gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":    "group/project",
+			"repositoryId": 3.0,
+			"tagName":      "gone",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
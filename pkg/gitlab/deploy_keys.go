@@ -0,0 +1,222 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gl "gitlab.com/gitlab-org/api/client-go"
+)
+
+// ListProjectDeployKeys defines the MCP tool for listing the deploy keys enabled on a GitLab project.
+func ListProjectDeployKeys(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listProjectDeployKeys",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_PROJECT_DEPLOY_KEYS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Project Deploy Keys",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			opts := &gl.ListProjectDeployKeysOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+			}
+			keys, resp, err := glClient.DeployKeys.ListProjectDeployKeys(projectID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, fmt.Sprintf("deploy keys from project %q", projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(keys) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(keys)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal deploy keys list: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// AddProjectDeployKey defines the MCP tool for adding a deploy key to a GitLab project.
+func AddProjectDeployKey(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"addProjectDeployKey",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_ADD_PROJECT_DEPLOY_KEY_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Add GitLab Project Deploy Key",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("title",
+				mcp.Description("The title of the deploy key."),
+				mcp.Required(),
+			),
+			mcp.WithString("key",
+				mcp.Description("The public SSH key, e.g. \"ssh-ed25519 AAAA... ci@example.com\"."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithBoolean("canPush",
+				mcp.Description("Allow the key to push to the repository. GitLab default: false (read-only)."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			title, err := requiredParam[string](&request, "title")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			key, err := requiredParam[string](&request, "key")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional parameters
+			canPush, err := OptionalBoolParam(&request, "canPush")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.AddDeployKeyOptions{
+				Title:   &title,
+				Key:     &key,
+				CanPush: canPush,
+			}
+
+			// --- Call GitLab API
+			deployKey, resp, err := glClient.DeployKeys.AddDeployKey(projectID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("project %q", projectID), "add deploy key")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(deployKey)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal deploy key data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// DeleteProjectDeployKey defines the MCP tool for removing a deploy key from a GitLab project.
+func DeleteProjectDeployKey(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"deleteProjectDeployKey",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_DELETE_PROJECT_DEPLOY_KEY_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Delete GitLab Project Deploy Key",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("deployKeyId",
+				mcp.Description("The ID of the deploy key to remove."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			deployKeyIDFloat, err := requiredParam[float64](&request, "deployKeyId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			deployKeyID := int64(deployKeyIDFloat)
+			if float64(deployKeyID) != deployKeyIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: deployKeyId %v is not a valid integer", deployKeyIDFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			resp, err := glClient.DeployKeys.DeleteDeployKey(projectID, deployKeyID, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("deploy key %d in project %q", deployKeyID, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf(`{"message":"Deploy key %d successfully deleted from project %q"}`, deployKeyID, projectID)), nil
+		}
+}
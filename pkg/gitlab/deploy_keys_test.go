@@ -0,0 +1,202 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+func TestListProjectDeployKeysHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListProjectDeployKeys(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockDeployKeys, ctrl := setupMockClientForDeployKeys(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListProjectDeployKeys(mockGetClient, nil)
+
+	tests := []struct {
+		name               string
+		inputArgs          map[string]any
+		mockSetup          func()
+		expectedText       string
+		expectHandlerError bool
+		expectResultError  bool
+		errorContains      string
+	}{
+		{
+			name:      "Success - List deploy keys",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockDeployKeys.EXPECT().
+					ListProjectDeployKeys("group/project", gomock.Any(), gomock.Any()).
+					Return([]*gl.ProjectDeployKey{{ID: 1, Title: "CI", CanPush: false}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: `"title":"CI"`,
+		},
+		{
+			name:      "Success - Empty list",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockDeployKeys.EXPECT().
+					ListProjectDeployKeys("group/project", gomock.Any(), gomock.Any()).
+					Return([]*gl.ProjectDeployKey{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: "[]",
+		},
+		{
+			name:              "Error - Missing projectId",
+			inputArgs:         map[string]any{},
+			mockSetup:         func() {},
+			expectResultError: true,
+			errorContains:     "Validation Error: missing required parameter: projectId",
+		},
+		{
+			name:      "Error - Forbidden (403)",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockDeployKeys.EXPECT().
+					ListProjectDeployKeys("group/project", gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 403}}, errors.New("gitlab: 403 Forbidden"))
+			},
+			expectHandlerError: true,
+			errorContains:      "failed to list deploy keys from project",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.inputArgs}})
+
+			if tc.expectHandlerError {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			textContent := getTextResult(t, result)
+			if tc.expectResultError {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.errorContains)
+				return
+			}
+			assert.Contains(t, textContent.Text, tc.expectedText)
+		})
+	}
+}
+
+func TestAddProjectDeployKeyHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := AddProjectDeployKey(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockDeployKeys, ctrl := setupMockClientForDeployKeys(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := AddProjectDeployKey(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockDeployKeys.EXPECT().
+			AddDeployKey("group/project", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.AddDeployKeyOptions, _ ...gl.RequestOptionFunc) (*gl.ProjectDeployKey, *gl.Response, error) {
+				assert.Equal(t, "CI", *opts.Title)
+				assert.Equal(t, "ssh-ed25519 AAAA ci@example.com", *opts.Key)
+				assert.True(t, *opts.CanPush)
+				return &gl.ProjectDeployKey{ID: 4, Title: "CI", CanPush: true}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"title":     "CI",
+			"key":       "ssh-ed25519 AAAA ci@example.com",
+			"canPush":   true,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"id":4`)
+	})
+
+	t.Run("Error - Missing key", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"title":     "CI",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: key")
+	})
+
+	t.Run("Error - Invalid key (400)", func(t *testing.T) {
+		mockDeployKeys.EXPECT().
+			AddDeployKey("group/project", gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 400}}, errors.New("gitlab: 400 key is invalid"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"title":     "CI",
+			"key":       "not-a-key",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
+
+func TestDeleteProjectDeployKeyHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := DeleteProjectDeployKey(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockDeployKeys, ctrl := setupMockClientForDeployKeys(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := DeleteProjectDeployKey(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockDeployKeys.EXPECT().
+			DeleteDeployKey("group/project", int64(4), gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 204}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":   "group/project",
+			"deployKeyId": 4.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Deploy key 4 successfully deleted")
+	})
+
+	t.Run("Error - Non-integer deployKeyId", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":   "group/project",
+			"deployKeyId": 4.5,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: deployKeyId 4.5 is not a valid integer")
+	})
+}
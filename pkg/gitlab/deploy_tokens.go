@@ -0,0 +1,335 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gl "gitlab.com/gitlab-org/api/client-go"
+)
+
+// deployTokenScopes lists the scopes GitLab accepts for deploy tokens
+var deployTokenScopes = []string{
+	"read_repository",
+	"read_registry",
+	"write_registry",
+	"read_package_registry",
+	"write_package_registry",
+	"read_virtual_registry",
+	"write_virtual_registry",
+}
+
+// withDeployTokenOwner adds the projectId and groupId parameters shared by the deploy token tools.
+func withDeployTokenOwner() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("projectId",
+			mcp.Description("The ID (integer) or URL-encoded path (string) of the project. Provide either projectId or groupId."),
+		)(tool)
+		mcp.WithString("groupId",
+			mcp.Description("The ID (integer) or URL-encoded path (string) of the group. Provide either projectId or groupId."),
+		)(tool)
+	}
+}
+
+// deployTokenOwner reads the projectId and groupId parameters, exactly one of which must be set.
+func deployTokenOwner(r *mcp.CallToolRequest) (projectID, groupID string, err error) {
+	projectID, err = OptionalParam[string](r, "projectId")
+	if err != nil {
+		return "", "", err
+	}
+	groupID, err = OptionalParam[string](r, "groupId")
+	if err != nil {
+		return "", "", err
+	}
+	if (projectID == "") == (groupID == "") {
+		return "", "", fmt.Errorf("exactly one of projectId or groupId must be provided")
+	}
+	return projectID, groupID, nil
+}
+
+// maskDeployToken returns a copy of token with its secret value redacted.
+func maskDeployToken(token *gl.DeployToken) *gl.DeployToken {
+	if token == nil || token.Token == "" {
+		return token
+	}
+	masked := *token
+	masked.Token = maskedAccessTokenValue
+	return &masked
+}
+
+// ListDeployTokens defines the MCP tool for listing the deploy tokens of a GitLab project or group.
+func ListDeployTokens(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listDeployTokens",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_DEPLOY_TOKENS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Deploy Tokens",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			withDeployTokenOwner(),
+			// Optional filtering parameters
+			mcp.WithBoolean("active",
+				mcp.Description("Limit by active status: true returns only tokens that are neither revoked nor expired."),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, groupID, err := deployTokenOwner(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional filtering parameters
+			active, err := OptionalBoolParam(&request, "active")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			listOpts := gl.ListOptions{
+				Page:    int64(page),
+				PerPage: int64(perPage),
+			}
+
+			// The client library's options do not expose the active filter
+			requestOpts := []gl.RequestOptionFunc{gl.WithContext(ctx)}
+			if active != nil {
+				requestOpts = append(requestOpts, withQueryParam("active", strconv.FormatBool(*active)))
+			}
+
+			// --- Call GitLab API
+			var tokens []*gl.DeployToken
+			var resp *gl.Response
+			var ownerDesc string
+			if projectID != "" {
+				ownerDesc = fmt.Sprintf("project %q", projectID)
+				tokens, resp, err = glClient.DeployTokens.ListProjectDeployTokens(projectID, &gl.ListProjectDeployTokensOptions{ListOptions: listOpts}, requestOpts...)
+			} else {
+				ownerDesc = fmt.Sprintf("group %q", groupID)
+				tokens, resp, err = glClient.DeployTokens.ListGroupDeployTokens(groupID, &gl.ListGroupDeployTokensOptions{ListOptions: listOpts}, requestOpts...)
+			}
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, "deploy tokens from "+ownerDesc)
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(tokens) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Redact token secrets
+			for i, token := range tokens {
+				tokens[i] = maskDeployToken(token)
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(tokens)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal deploy tokens list: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// CreateDeployToken defines the MCP tool for creating a deploy token for a GitLab project or group.
+func CreateDeployToken(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"createDeployToken",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_CREATE_DEPLOY_TOKEN_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Create GitLab Deploy Token",
+			}),
+			withDeployTokenOwner(),
+			// Required parameters
+			mcp.WithString("name",
+				mcp.Description("The name of the deploy token."),
+				mcp.Required(),
+			),
+			mcp.WithString("scopes",
+				mcp.Description(fmt.Sprintf("Comma-separated list of scopes: %s.", strings.Join(deployTokenScopes, ", "))),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithString("expiresAt",
+				mcp.Description("Expiration time of the token in ISO 8601 format (e.g. 2025-12-31T00:00:00Z). The token does not expire if omitted."),
+			),
+			mcp.WithString("username",
+				mcp.Description("Username for the token. GitLab default: gitlab+deploy-token-{n}."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, groupID, err := deployTokenOwner(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			name, err := requiredParam[string](&request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			scopesStr, err := requiredParam[string](&request, "scopes")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			scopes := ParseCommaSeparatedList(scopesStr)
+			if len(scopes) == 0 {
+				return mcp.NewToolResultError("Validation Error: scopes must contain at least one scope"), nil
+			}
+			for _, scope := range scopes {
+				if !slices.Contains(deployTokenScopes, scope) {
+					return mcp.NewToolResultError(fmt.Sprintf("Validation Error: scope %q is not a valid deploy token scope", scope)), nil
+				}
+			}
+
+			// --- Parse optional parameters
+			expiresAt, err := OptionalTimeParam(&request, "expiresAt")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			username, err := OptionalParam[string](&request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			var usernamePtr *string
+			if username != "" {
+				usernamePtr = &username
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			var token *gl.DeployToken
+			var resp *gl.Response
+			var ownerDesc string
+			if projectID != "" {
+				ownerDesc = fmt.Sprintf("project %q", projectID)
+				token, resp, err = glClient.DeployTokens.CreateProjectDeployToken(projectID, &gl.CreateProjectDeployTokenOptions{
+					Name:      &name,
+					Scopes:    &scopes,
+					ExpiresAt: expiresAt,
+					Username:  usernamePtr,
+				}, gl.WithContext(ctx))
+			} else {
+				ownerDesc = fmt.Sprintf("group %q", groupID)
+				token, resp, err = glClient.DeployTokens.CreateGroupDeployToken(groupID, &gl.CreateGroupDeployTokenOptions{
+					Name:      &name,
+					Scopes:    &scopes,
+					ExpiresAt: expiresAt,
+					Username:  usernamePtr,
+				}, gl.WithContext(ctx))
+			}
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, ownerDesc, "create deploy token")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success (the only time the token secret is shown)
+			data, err := json.Marshal(token)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal deploy token data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// RevokeDeployToken defines the MCP tool for revoking a deploy token of a GitLab project or group.
+func RevokeDeployToken(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"revokeDeployToken",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_REVOKE_DEPLOY_TOKEN_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Revoke GitLab Deploy Token",
+			}),
+			withDeployTokenOwner(),
+			// Required parameters
+			mcp.WithNumber("tokenId",
+				mcp.Description("The ID of the deploy token to revoke."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, groupID, err := deployTokenOwner(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			tokenIDFloat, err := requiredParam[float64](&request, "tokenId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			tokenID := int64(tokenIDFloat)
+			if float64(tokenID) != tokenIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: tokenId %v is not a valid integer", tokenIDFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			var resp *gl.Response
+			var ownerDesc string
+			if projectID != "" {
+				ownerDesc = fmt.Sprintf("project %q", projectID)
+				resp, err = glClient.DeployTokens.DeleteProjectDeployToken(projectID, tokenID, gl.WithContext(ctx))
+			} else {
+				ownerDesc = fmt.Sprintf("group %q", groupID)
+				resp, err = glClient.DeployTokens.DeleteGroupDeployToken(groupID, tokenID, gl.WithContext(ctx))
+			}
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("deploy token %d in %s", tokenID, ownerDesc))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf(`{"message":"Deploy token %d successfully revoked in %s"}`, tokenID, ownerDesc)), nil
+		}
+}
@@ -0,0 +1,252 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+func TestListDeployTokensHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListDeployTokens(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockDeployTokens, ctrl := setupMockClientForDeployTokens(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListDeployTokens(mockGetClient, nil)
+
+	tests := []struct {
+		name               string
+		inputArgs          map[string]any
+		mockSetup          func()
+		expectedText       string
+		expectHandlerError bool
+		expectResultError  bool
+		errorContains      string
+	}{
+		{
+			name:      "Success - Project tokens are masked",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockDeployTokens.EXPECT().
+					ListProjectDeployTokens("group/project", gomock.Any(), gomock.Any()).
+					Return([]*gl.DeployToken{{ID: 1, Name: "registry", Token: "s3cret"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: `"token":"[MASKED]"`,
+		},
+		{
+			name:      "Success - Group tokens filtered by active",
+			inputArgs: map[string]any{"groupId": "my-group", "active": true},
+			mockSetup: func() {
+				mockDeployTokens.EXPECT().
+					ListGroupDeployTokens("my-group", gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, _ *gl.ListGroupDeployTokensOptions, options ...gl.RequestOptionFunc) ([]*gl.DeployToken, *gl.Response, error) {
+						req, err := retryablehttp.NewRequest(http.MethodGet, "https://gitlab.example.com/api/v4/groups/1/deploy_tokens", nil)
+						require.NoError(t, err)
+						for _, fn := range options {
+							require.NoError(t, fn(req))
+						}
+						assert.Equal(t, "true", req.URL.Query().Get("active"))
+						return []*gl.DeployToken{{ID: 2, Name: "group-token"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+					})
+			},
+			expectedText: `"name":"group-token"`,
+		},
+		{
+			name:      "Success - Empty list",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockDeployTokens.EXPECT().
+					ListProjectDeployTokens("group/project", gomock.Any(), gomock.Any()).
+					Return([]*gl.DeployToken{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: "[]",
+		},
+		{
+			name:              "Error - Neither projectId nor groupId",
+			inputArgs:         map[string]any{},
+			mockSetup:         func() {},
+			expectResultError: true,
+			errorContains:     "Validation Error: exactly one of projectId or groupId must be provided",
+		},
+		{
+			name:              "Error - Both projectId and groupId",
+			inputArgs:         map[string]any{"projectId": "group/project", "groupId": "my-group"},
+			mockSetup:         func() {},
+			expectResultError: true,
+			errorContains:     "Validation Error: exactly one of projectId or groupId must be provided",
+		},
+		{
+			name:      "Error - Forbidden (403)",
+			inputArgs: map[string]any{"groupId": "my-group"},
+			mockSetup: func() {
+				mockDeployTokens.EXPECT().
+					ListGroupDeployTokens("my-group", gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 403}}, errors.New("gitlab: 403 Forbidden"))
+			},
+			expectHandlerError: true,
+			errorContains:      "failed to list deploy tokens from group",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.inputArgs}})
+
+			if tc.expectHandlerError {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			textContent := getTextResult(t, result)
+			if tc.expectResultError {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.errorContains)
+				return
+			}
+			assert.Contains(t, textContent.Text, tc.expectedText)
+		})
+	}
+}
+
+func TestCreateDeployTokenHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := CreateDeployToken(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockDeployTokens, ctrl := setupMockClientForDeployTokens(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := CreateDeployToken(mockGetClient, nil)
+
+	t.Run("Success - Project token returns its secret", func(t *testing.T) {
+		mockDeployTokens.EXPECT().
+			CreateProjectDeployToken("group/project", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.CreateProjectDeployTokenOptions, _ ...gl.RequestOptionFunc) (*gl.DeployToken, *gl.Response, error) {
+				assert.Equal(t, "registry", *opts.Name)
+				assert.Equal(t, []string{"read_registry", "read_repository"}, *opts.Scopes)
+				assert.Equal(t, time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), opts.ExpiresAt.UTC())
+				assert.Nil(t, opts.Username)
+				return &gl.DeployToken{ID: 3, Name: "registry", Token: "s3cret"}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"name":      "registry",
+			"scopes":    "read_registry, read_repository",
+			"expiresAt": "2030-01-01T00:00:00Z",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"token":"s3cret"`)
+	})
+
+	t.Run("Success - Group token", func(t *testing.T) {
+		mockDeployTokens.EXPECT().
+			CreateGroupDeployToken("my-group", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.CreateGroupDeployTokenOptions, _ ...gl.RequestOptionFunc) (*gl.DeployToken, *gl.Response, error) {
+				assert.Equal(t, "ci-bot", *opts.Username)
+				assert.Nil(t, opts.ExpiresAt)
+				return &gl.DeployToken{ID: 4, Name: "ci", Username: "ci-bot"}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId":  "my-group",
+			"name":     "ci",
+			"scopes":   "read_package_registry",
+			"username": "ci-bot",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"username":"ci-bot"`)
+	})
+
+	t.Run("Error - Invalid scope", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"name":      "registry",
+			"scopes":    "api",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `Validation Error: scope "api" is not a valid deploy token scope`)
+	})
+
+	t.Run("Error - Invalid expiresAt", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"name":      "registry",
+			"scopes":    "read_registry",
+			"expiresAt": "next week",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: parameter 'expiresAt' must be a valid ISO 8601 timestamp")
+	})
+}
+
+func TestRevokeDeployTokenHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := RevokeDeployToken(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockDeployTokens, ctrl := setupMockClientForDeployTokens(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := RevokeDeployToken(mockGetClient, nil)
+
+	t.Run("Success - Project token", func(t *testing.T) {
+		mockDeployTokens.EXPECT().
+			DeleteProjectDeployToken("group/project", int64(3), gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 204}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"tokenId":   3.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Deploy token 3 successfully revoked")
+	})
+
+	t.Run("Error - Group token not found (404)", func(t *testing.T) {
+		mockDeployTokens.EXPECT().
+			DeleteGroupDeployToken("my-group", int64(99), gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+			"tokenId": 99.0,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "deploy token 99 in group")
+	})
+}
@@ -0,0 +1,218 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gl "gitlab.com/gitlab-org/api/client-go"
+)
+
+// ListDeployments defines the MCP tool for listing the deployment history of a GitLab project.
+func ListDeployments(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listDeployments",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_DEPLOYMENTS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Deployments",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			// Optional filtering parameters
+			mcp.WithString("environment",
+				mcp.Description("Return deployments to the environment with this name."),
+			),
+			mcp.WithString("status",
+				mcp.Description("Return deployments with the given status."),
+				mcp.Enum("created", "running", "success", "failed", "canceled"),
+			),
+			mcp.WithString("orderBy",
+				mcp.Description("Return deployments ordered by this field."),
+				mcp.Enum("id", "iid", "created_at", "updated_at", "ref"),
+			),
+			mcp.WithString("sort",
+				mcp.Description("Return deployments sorted in asc or desc order."),
+				mcp.Enum("asc", "desc"),
+			),
+			mcp.WithString("updatedAfter",
+				mcp.Description("Return deployments updated on or after the given time (ISO 8601 format: YYYY-MM-DDTHH:MM:SSZ)."),
+			),
+			mcp.WithString("updatedBefore",
+				mcp.Description("Return deployments updated on or before the given time (ISO 8601 format: YYYY-MM-DDTHH:MM:SSZ)."),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional filtering parameters
+			environment, err := OptionalParam[string](&request, "environment")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			status, err := OptionalParam[string](&request, "status")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			orderBy, err := OptionalParam[string](&request, "orderBy")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			sort, err := OptionalParam[string](&request, "sort")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// Date parameters are passed as strings in ISO 8601 format
+			updatedAfter, err := OptionalTimeParam(&request, "updatedAfter")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			updatedBefore, err := OptionalTimeParam(&request, "updatedBefore")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.ListProjectDeploymentsOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+				UpdatedAfter:  updatedAfter,
+				UpdatedBefore: updatedBefore,
+			}
+
+			if environment != "" {
+				opts.Environment = &environment
+			}
+
+			if status != "" {
+				opts.Status = &status
+			}
+
+			if orderBy != "" {
+				opts.OrderBy = &orderBy
+			}
+
+			if sort != "" {
+				opts.Sort = &sort
+			}
+
+			// --- Call GitLab API
+			deployments, resp, err := glClient.Deployments.ListProjectDeployments(projectID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, fmt.Sprintf("deployments from project %q", projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(deployments) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(deployments)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal deployments list: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// GetDeployment defines the MCP tool for retrieving a single deployment of a GitLab project.
+func GetDeployment(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"getDeployment",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_DEPLOYMENT_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "Get GitLab Deployment",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("deploymentId",
+				mcp.Description("The ID of the deployment."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			deploymentIDFloat, err := requiredParam[float64](&request, "deploymentId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			deploymentID := int64(deploymentIDFloat)
+			if float64(deploymentID) != deploymentIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: deploymentId %v is not a valid integer", deploymentIDFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			deployment, resp, err := glClient.Deployments.GetProjectDeployment(projectID, deploymentID, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("deployment %d in project %q", deploymentID, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(deployment)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal deployment data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
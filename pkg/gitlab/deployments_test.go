@@ -0,0 +1,165 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+func TestListDeploymentsHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListDeployments(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockDeployments, ctrl := setupMockClientForDeployments(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListDeployments(mockGetClient, nil)
+
+	tests := []struct {
+		name               string
+		inputArgs          map[string]any
+		mockSetup          func()
+		expectedText       string
+		expectHandlerError bool
+		expectResultError  bool
+	}{
+		{
+			name: "Success - Filters are forwarded",
+			inputArgs: map[string]any{
+				"projectId":    "group/project",
+				"environment":  "production",
+				"status":       "success",
+				"orderBy":      "updated_at",
+				"sort":         "desc",
+				"updatedAfter": "2026-01-01T00:00:00Z",
+			},
+			mockSetup: func() {
+				mockDeployments.EXPECT().
+					ListProjectDeployments("group/project", gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, opts *gl.ListProjectDeploymentsOptions, _ ...gl.RequestOptionFunc) ([]*gl.Deployment, *gl.Response, error) {
+						assert.Equal(t, "production", *opts.Environment)
+						assert.Equal(t, "success", *opts.Status)
+						assert.Equal(t, "updated_at", *opts.OrderBy)
+						assert.Equal(t, "desc", *opts.Sort)
+						assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), *opts.UpdatedAfter)
+						assert.Nil(t, opts.UpdatedBefore)
+						return []*gl.Deployment{{ID: 42, Ref: "main", Status: "success"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+					})
+			},
+			expectedText: `"id":42`,
+		},
+		{
+			name:      "Success - Empty list",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockDeployments.EXPECT().
+					ListProjectDeployments("group/project", gomock.Any(), gomock.Any()).
+					Return([]*gl.Deployment{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: "[]",
+		},
+		{
+			name:              "Error - Invalid updatedBefore",
+			inputArgs:         map[string]any{"projectId": "group/project", "updatedBefore": "yesterday"},
+			mockSetup:         func() {},
+			expectedText:      "Validation Error",
+			expectResultError: true,
+		},
+		{
+			name:      "Error - GitLab API Error (500)",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockDeployments.EXPECT().
+					ListProjectDeployments("group/project", gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 500}}, errors.New("gitlab: 500 Internal Server Error"))
+			},
+			expectHandlerError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.inputArgs}})
+
+			if tc.expectHandlerError {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, "failed to list deployments from project")
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, tc.expectResultError, result.IsError)
+			assert.Contains(t, getTextResult(t, result).Text, tc.expectedText)
+		})
+	}
+}
+
+func TestGetDeploymentHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetDeployment(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockDeployments, ctrl := setupMockClientForDeployments(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := GetDeployment(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockDeployments.EXPECT().
+			GetProjectDeployment("group/project", int64(42), gomock.Any()).
+			Return(&gl.Deployment{ID: 42, Ref: "main", Status: "success"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":    "group/project",
+			"deploymentId": 42.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"ref":"main"`)
+	})
+
+	t.Run("Error - Deployment Not Found (404)", func(t *testing.T) {
+		mockDeployments.EXPECT().
+			GetProjectDeployment("group/project", int64(99), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":    "group/project",
+			"deploymentId": 99.0,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, `deployment 99 in project "group/project" not found`)
+	})
+
+	t.Run("Error - Missing deploymentId", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: deploymentId")
+	})
+}
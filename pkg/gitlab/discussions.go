@@ -0,0 +1,489 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gl "gitlab.com/gitlab-org/api/client-go"
+)
+
+// ListIssueDiscussions defines the MCP tool for listing the threaded discussions of an issue.
+func ListIssueDiscussions(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listIssueDiscussions",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_ISSUE_DISCUSSIONS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Issue Discussions",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("issueIid",
+				mcp.Description("The IID (internal ID, integer) of the issue within the project."),
+				mcp.Required(),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			issueIidFloat, err := requiredParam[float64](&request, "issueIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			issueIid := int64(issueIidFloat)
+			if float64(issueIid) != issueIidFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: issueIid %v is not a valid integer", issueIidFloat)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			opts := &gl.ListIssueDiscussionsOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+			}
+			discussions, resp, err := glClient.Discussions.ListIssueDiscussions(projectID, issueIid, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, fmt.Sprintf("discussions for issue %d in project %q", issueIid, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(discussions) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(discussions)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal issue discussions: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// CreateIssueDiscussion defines the MCP tool for starting a new discussion thread on an issue.
+func CreateIssueDiscussion(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"createIssueDiscussion",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_CREATE_ISSUE_DISCUSSION_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Create GitLab Issue Discussion",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("issueIid",
+				mcp.Description("The IID (internal ID, integer) of the issue within the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("body",
+				mcp.Description("The content of the first note of the discussion."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			issueIidFloat, err := requiredParam[float64](&request, "issueIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			issueIid := int64(issueIidFloat)
+			if float64(issueIid) != issueIidFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: issueIid %v is not a valid integer", issueIidFloat)), nil
+			}
+
+			body, err := requiredParam[string](&request, "body")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			opts := &gl.CreateIssueDiscussionOptions{
+				Body: &body,
+			}
+			discussion, resp, err := glClient.Discussions.CreateIssueDiscussion(projectID, issueIid, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("issue %d in project %q", issueIid, projectID), "create discussion")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(discussion)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal discussion data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// ListMergeRequestDiscussions defines the MCP tool for listing the threaded discussions of a merge request.
+func ListMergeRequestDiscussions(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listMergeRequestDiscussions",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_MERGE_REQUEST_DISCUSSIONS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Merge Request Discussions",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("mergeRequestIid",
+				mcp.Description("The IID (internal ID, integer) of the merge request within the project."),
+				mcp.Required(),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			mrIidFloat, err := requiredParam[float64](&request, "mergeRequestIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			mrIid := int64(mrIidFloat)
+			if float64(mrIid) != mrIidFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: mergeRequestIid %v is not a valid integer", mrIidFloat)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			opts := &gl.ListMergeRequestDiscussionsOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+			}
+			discussions, resp, err := glClient.Discussions.ListMergeRequestDiscussions(projectID, mrIid, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, fmt.Sprintf("discussions for merge request %d in project %q", mrIid, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(discussions) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(discussions)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal merge request discussions: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// CreateMergeRequestDiscussion defines the MCP tool for starting a new discussion thread on a merge request.
+func CreateMergeRequestDiscussion(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"createMergeRequestDiscussion",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_CREATE_MERGE_REQUEST_DISCUSSION_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Create GitLab Merge Request Discussion",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("mergeRequestIid",
+				mcp.Description("The IID (internal ID, integer) of the merge request within the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("body",
+				mcp.Description("The content of the first note of the discussion."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithObject("position",
+				mcp.Description("Anchor the discussion to a line of the diff. base_sha, start_sha and head_sha are required and can be taken from getMergeRequestDiff; set new_line for added or unchanged lines and old_line for removed or unchanged lines."),
+				mcp.Properties(map[string]any{
+					"base_sha":  map[string]any{"type": "string", "description": "Base commit SHA in the source branch."},
+					"start_sha": map[string]any{"type": "string", "description": "SHA referencing the commit in the target branch."},
+					"head_sha":  map[string]any{"type": "string", "description": "SHA referencing the HEAD of this merge request."},
+					"old_path":  map[string]any{"type": "string", "description": "File path before the change."},
+					"new_path":  map[string]any{"type": "string", "description": "File path after the change."},
+					"old_line":  map[string]any{"type": "integer", "description": "Line number before the change."},
+					"new_line":  map[string]any{"type": "integer", "description": "Line number after the change."},
+				}),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			mrIidFloat, err := requiredParam[float64](&request, "mergeRequestIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			mrIid := int64(mrIidFloat)
+			if float64(mrIid) != mrIidFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: mergeRequestIid %v is not a valid integer", mrIidFloat)), nil
+			}
+
+			body, err := requiredParam[string](&request, "body")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional parameters
+			position, err := parseDiffPosition(&request, "position")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			opts := &gl.CreateMergeRequestDiscussionOptions{
+				Body:     &body,
+				Position: position,
+			}
+			discussion, resp, err := glClient.Discussions.CreateMergeRequestDiscussion(projectID, mrIid, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("merge request %d in project %q", mrIid, projectID), "create discussion")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(discussion)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal discussion data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// ResolveMergeRequestDiscussion defines the MCP tool for resolving or reopening a merge request discussion thread.
+func ResolveMergeRequestDiscussion(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"resolveMergeRequestDiscussion",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_RESOLVE_MERGE_REQUEST_DISCUSSION_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Resolve GitLab Merge Request Discussion",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("mergeRequestIid",
+				mcp.Description("The IID (internal ID, integer) of the merge request within the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("discussionId",
+				mcp.Description("The ID of the discussion thread."),
+				mcp.Required(),
+			),
+			mcp.WithBoolean("resolved",
+				mcp.Description("true to resolve the discussion, false to reopen it."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			mrIidFloat, err := requiredParam[float64](&request, "mergeRequestIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			mrIid := int64(mrIidFloat)
+			if float64(mrIid) != mrIidFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: mergeRequestIid %v is not a valid integer", mrIidFloat)), nil
+			}
+
+			discussionID, err := requiredParam[string](&request, "discussionId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// requiredParam rejects zero values, which would make resolved=false impossible
+			resolved, err := OptionalBoolParam(&request, "resolved")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			if resolved == nil {
+				return mcp.NewToolResultError("Validation Error: missing required parameter: resolved"), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			opts := &gl.ResolveMergeRequestDiscussionOptions{
+				Resolved: resolved,
+			}
+			discussion, resp, err := glClient.Discussions.ResolveMergeRequestDiscussion(projectID, mrIid, discussionID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("discussion %q of merge request %d in project %q", discussionID, mrIid, projectID), "resolve discussion")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(discussion)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal discussion data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// diffPosition mirrors the position object accepted by createMergeRequestDiscussion
+type diffPosition struct {
+	BaseSHA  string `json:"base_sha"`
+	StartSHA string `json:"start_sha"`
+	HeadSHA  string `json:"head_sha"`
+	OldPath  string `json:"old_path"`
+	NewPath  string `json:"new_path"`
+	OldLine  *int64 `json:"old_line"`
+	NewLine  *int64 `json:"new_line"`
+}
+
+// parseDiffPosition reads an optional diff position object (or its JSON string form)
+// and converts it to GitLab position options. Returns nil if the parameter is absent.
+func parseDiffPosition(r *mcp.CallToolRequest, p string) (*gl.PositionOptions, error) {
+	rawVal, ok := r.GetArguments()[p]
+	if !ok || rawVal == nil {
+		return nil, nil
+	}
+
+	var raw []byte
+	if s, isStr := rawVal.(string); isStr {
+		raw = []byte(s)
+	} else {
+		var err error
+		if raw, err = json.Marshal(rawVal); err != nil {
+			return nil, fmt.Errorf("parameter '%s' must be an object: %w", p, err)
+		}
+	}
+
+	var pos diffPosition
+	if err := json.Unmarshal(raw, &pos); err != nil {
+		return nil, fmt.Errorf("parameter '%s' must be an object with base_sha, start_sha, head_sha and line fields: %w", p, err)
+	}
+
+	if pos.BaseSHA == "" || pos.StartSHA == "" || pos.HeadSHA == "" {
+		return nil, fmt.Errorf("parameter '%s' requires base_sha, start_sha and head_sha", p)
+	}
+
+	opts := &gl.PositionOptions{
+		BaseSHA:      &pos.BaseSHA,
+		StartSHA:     &pos.StartSHA,
+		HeadSHA:      &pos.HeadSHA,
+		PositionType: gl.Ptr("text"),
+		OldLine:      pos.OldLine,
+		NewLine:      pos.NewLine,
+	}
+
+	if pos.OldPath != "" {
+		opts.OldPath = &pos.OldPath
+	}
+
+	if pos.NewPath != "" {
+		opts.NewPath = &pos.NewPath
+	}
+
+	return opts, nil
+}
@@ -0,0 +1,371 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+func TestListIssueDiscussionsHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListIssueDiscussions(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockDiscussions, ctrl := setupMockClientForDiscussions(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListIssueDiscussions(mockGetClient, nil)
+
+	tests := []struct {
+		name               string
+		inputArgs          map[string]any
+		mockSetup          func()
+		expectedText       string
+		expectHandlerError bool
+		expectResultError  bool
+	}{
+		{
+			name:      "Success - Threads with notes",
+			inputArgs: map[string]any{"projectId": "group/project", "issueIid": 5.0, "page": 2.0},
+			mockSetup: func() {
+				mockDiscussions.EXPECT().
+					ListIssueDiscussions("group/project", int64(5), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, _ int64, opts *gl.ListIssueDiscussionsOptions, _ ...gl.RequestOptionFunc) ([]*gl.Discussion, *gl.Response, error) {
+						assert.Equal(t, int64(2), opts.Page)
+						return []*gl.Discussion{{ID: "abc", Notes: []*gl.Note{{ID: 1, Body: "First"}, {ID: 2, Body: "Reply"}}}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+					})
+			},
+			expectedText: `"body":"Reply"`,
+		},
+		{
+			name:      "Success - Empty list",
+			inputArgs: map[string]any{"projectId": "group/project", "issueIid": 5.0},
+			mockSetup: func() {
+				mockDiscussions.EXPECT().
+					ListIssueDiscussions("group/project", int64(5), gomock.Any(), gomock.Any()).
+					Return([]*gl.Discussion{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: "[]",
+		},
+		{
+			name:              "Error - Non-integer issueIid",
+			inputArgs:         map[string]any{"projectId": "group/project", "issueIid": 5.5},
+			mockSetup:         func() {},
+			expectedText:      "Validation Error: issueIid 5.5 is not a valid integer",
+			expectResultError: true,
+		},
+		{
+			name:      "Error - GitLab API Error (500)",
+			inputArgs: map[string]any{"projectId": "group/project", "issueIid": 5.0},
+			mockSetup: func() {
+				mockDiscussions.EXPECT().
+					ListIssueDiscussions("group/project", int64(5), gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 500}}, errors.New("gitlab: 500 Internal Server Error"))
+			},
+			expectHandlerError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.inputArgs}})
+
+			if tc.expectHandlerError {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, "failed to list discussions for issue 5")
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, tc.expectResultError, result.IsError)
+			assert.Contains(t, getTextResult(t, result).Text, tc.expectedText)
+		})
+	}
+}
+
+func TestCreateIssueDiscussionHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := CreateIssueDiscussion(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockDiscussions, ctrl := setupMockClientForDiscussions(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := CreateIssueDiscussion(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockDiscussions.EXPECT().
+			CreateIssueDiscussion("group/project", int64(5), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ int64, opts *gl.CreateIssueDiscussionOptions, _ ...gl.RequestOptionFunc) (*gl.Discussion, *gl.Response, error) {
+				assert.Equal(t, "Let's discuss", *opts.Body)
+				return &gl.Discussion{ID: "def", Notes: []*gl.Note{{ID: 3, Body: "Let's discuss"}}}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"issueIid":  5.0,
+			"body":      "Let's discuss",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"id":"def"`)
+	})
+
+	t.Run("Error - Missing body", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"issueIid":  5.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: body")
+	})
+
+	t.Run("Error - Issue Not Found (404)", func(t *testing.T) {
+		mockDiscussions.EXPECT().
+			CreateIssueDiscussion("group/project", int64(99), gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"issueIid":  99.0,
+			"body":      "Hello",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, `issue 99 in project "group/project" not found`)
+	})
+}
+
+func TestListMergeRequestDiscussionsHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListMergeRequestDiscussions(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockDiscussions, ctrl := setupMockClientForDiscussions(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListMergeRequestDiscussions(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockDiscussions.EXPECT().
+			ListMergeRequestDiscussions("group/project", int64(3), gomock.Any(), gomock.Any()).
+			Return([]*gl.Discussion{{ID: "abc", Notes: []*gl.Note{{ID: 1, Body: "Nit", Resolvable: true}}}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 3.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"body":"Nit"`)
+	})
+
+	t.Run("Success - Empty list", func(t *testing.T) {
+		mockDiscussions.EXPECT().
+			ListMergeRequestDiscussions("group/project", int64(4), gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 4.0,
+		}}})
+		require.NoError(t, err)
+		assert.Equal(t, "[]", getTextResult(t, result).Text)
+	})
+}
+
+func TestCreateMergeRequestDiscussionHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := CreateMergeRequestDiscussion(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockDiscussions, ctrl := setupMockClientForDiscussions(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := CreateMergeRequestDiscussion(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockDiscussions.EXPECT().
+			CreateMergeRequestDiscussion("group/project", int64(3), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ int64, opts *gl.CreateMergeRequestDiscussionOptions, _ ...gl.RequestOptionFunc) (*gl.Discussion, *gl.Response, error) {
+				assert.Equal(t, "Please add tests", *opts.Body)
+				assert.Nil(t, opts.Position)
+				return &gl.Discussion{ID: "def"}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 3.0,
+			"body":            "Please add tests",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"id":"def"`)
+	})
+
+	t.Run("Success - Inline comment with position", func(t *testing.T) {
+		mockDiscussions.EXPECT().
+			CreateMergeRequestDiscussion("group/project", int64(3), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ int64, opts *gl.CreateMergeRequestDiscussionOptions, _ ...gl.RequestOptionFunc) (*gl.Discussion, *gl.Response, error) {
+				require.NotNil(t, opts.Position)
+				assert.Equal(t, "base", *opts.Position.BaseSHA)
+				assert.Equal(t, "start", *opts.Position.StartSHA)
+				assert.Equal(t, "head", *opts.Position.HeadSHA)
+				assert.Equal(t, "text", *opts.Position.PositionType)
+				assert.Equal(t, "main.go", *opts.Position.NewPath)
+				assert.Nil(t, opts.Position.OldPath)
+				assert.Equal(t, int64(42), *opts.Position.NewLine)
+				assert.Nil(t, opts.Position.OldLine)
+				return &gl.Discussion{ID: "inline"}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 3.0,
+			"body":            "Off by one?",
+			"position": map[string]any{
+				"base_sha":  "base",
+				"start_sha": "start",
+				"head_sha":  "head",
+				"new_path":  "main.go",
+				"new_line":  42.0,
+			},
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"id":"inline"`)
+	})
+
+	t.Run("Success - Position as JSON string", func(t *testing.T) {
+		mockDiscussions.EXPECT().
+			CreateMergeRequestDiscussion("group/project", int64(3), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ int64, opts *gl.CreateMergeRequestDiscussionOptions, _ ...gl.RequestOptionFunc) (*gl.Discussion, *gl.Response, error) {
+				require.NotNil(t, opts.Position)
+				assert.Equal(t, int64(7), *opts.Position.OldLine)
+				return &gl.Discussion{ID: "inline"}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 3.0,
+			"body":            "Why removed?",
+			"position":        `{"base_sha":"base","start_sha":"start","head_sha":"head","old_path":"main.go","old_line":7}`,
+		}}})
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	t.Run("Error - Position missing SHAs", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 3.0,
+			"body":            "Off by one?",
+			"position": map[string]any{
+				"base_sha": "base",
+				"new_path": "main.go",
+				"new_line": 42.0,
+			},
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: parameter 'position' requires base_sha, start_sha and head_sha")
+	})
+
+	t.Run("Error - Missing body", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 3.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: body")
+	})
+}
+
+func TestResolveMergeRequestDiscussionHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ResolveMergeRequestDiscussion(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockDiscussions, ctrl := setupMockClientForDiscussions(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ResolveMergeRequestDiscussion(mockGetClient, nil)
+
+	t.Run("Success - Reopen with resolved=false", func(t *testing.T) {
+		mockDiscussions.EXPECT().
+			ResolveMergeRequestDiscussion("group/project", int64(3), "abc", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ int64, _ string, opts *gl.ResolveMergeRequestDiscussionOptions, _ ...gl.RequestOptionFunc) (*gl.Discussion, *gl.Response, error) {
+				assert.False(t, *opts.Resolved)
+				return &gl.Discussion{ID: "abc"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 3.0,
+			"discussionId":    "abc",
+			"resolved":        false,
+		}}})
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, `"id":"abc"`)
+	})
+
+	t.Run("Error - Missing resolved", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 3.0,
+			"discussionId":    "abc",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: resolved")
+	})
+
+	t.Run("Error - Discussion Not Found (404)", func(t *testing.T) {
+		mockDiscussions.EXPECT().
+			ResolveMergeRequestDiscussion("group/project", int64(3), "missing", gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 3.0,
+			"discussionId":    "missing",
+			"resolved":        true,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, `discussion "missing" of merge request 3`)
+	})
+}
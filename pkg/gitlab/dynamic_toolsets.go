@@ -2,7 +2,10 @@ package gitlab
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
+	"sync"
 
 	"github.com/InkyQuill/gitlab-mcp-server/pkg/toolsets"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -16,6 +19,7 @@ type DynamicToolsetManager struct {
 	mcpServer    *server.MCPServer
 	logger       *log.Logger
 	dynamicMode  bool
+	mu           sync.Mutex // Serializes enabling toolsets
 }
 
 // NewDynamicToolsetManager creates a new DynamicToolsetManager instance.
@@ -38,19 +42,27 @@ func (dtm *DynamicToolsetManager) SetDynamicMode(enabled bool) {
 
 // RegisterDiscoveryTools registers MCP tools for listing and enabling toolsets dynamically.
 func (dtm *DynamicToolsetManager) RegisterDiscoveryTools() {
-	// Tool 1: List available toolsets
-	listToolsets := mcp.NewTool("list_available_toolsets",
-		mcp.WithDescription("Lists all available GitLab MCP toolsets that can be enabled."),
+	dtm.mcpServer.AddTool(listAvailableToolsetsTool(), dtm.handleListToolsets)
+	dtm.mcpServer.AddTool(enableToolsetTool(), dtm.handleEnableToolset)
+
+	dtm.logger.Info("Dynamic toolset discovery tools registered")
+}
+
+// listAvailableToolsetsTool defines the MCP tool for listing the available toolsets.
+func listAvailableToolsetsTool() mcp.Tool {
+	return mcp.NewTool("listAvailableToolsets",
+		mcp.WithDescription("Lists all available GitLab MCP toolsets as a JSON array of {name, description, enabled, toolCount}. Use enableToolset to enable one."),
 		mcp.WithToolAnnotation(mcp.ToolAnnotation{
 			Title:        "List Available Toolsets",
 			ReadOnlyHint: boolPtr(true),
 		}),
 	)
-	dtm.mcpServer.AddTool(listToolsets, dtm.handleListToolsets)
+}
 
-	// Tool 2: Enable a toolset
-	enableToolset := mcp.NewTool("enable_toolset",
-		mcp.WithDescription("Enables a specific GitLab MCP toolset, making its tools available."),
+// enableToolsetTool defines the MCP tool for enabling a toolset.
+func enableToolsetTool() mcp.Tool {
+	return mcp.NewTool("enableToolset",
+		mcp.WithDescription("Enables a specific GitLab MCP toolset, and the toolsets it depends on, making their tools available. Returns the names of the newly registered tools."),
 		mcp.WithToolAnnotation(mcp.ToolAnnotation{
 			Title: "Enable Toolset",
 		}),
@@ -59,25 +71,17 @@ func (dtm *DynamicToolsetManager) RegisterDiscoveryTools() {
 			mcp.Description("Name of the toolset to enable (e.g., 'projects', 'issues', 'merge_requests')"),
 		),
 	)
-	dtm.mcpServer.AddTool(enableToolset, dtm.handleEnableToolset)
-
-	dtm.logger.Info("Dynamic toolset discovery tools registered")
 }
 
 // handleListToolsets returns all available toolsets with their status.
 func (dtm *DynamicToolsetManager) handleListToolsets(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	infos := dtm.toolsetGroup.ListToolsets()
 
-	result := fmt.Sprintf("Available Toolsets (%d):\n", len(infos))
-	for _, info := range infos {
-		status := "disabled"
-		if info.Enabled {
-			status = "enabled"
-		}
-		result += fmt.Sprintf("- %s: %s [%d tools] (%s)\n", info.Name, info.Description, info.ToolCount, status)
+	data, err := json.Marshal(infos)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal toolsets: %w", err)
 	}
-
-	return mcp.NewToolResultText(result), nil
+	return mcp.NewToolResultText(string(data)), nil
 }
 
 // handleEnableToolset enables a specific toolset and registers its tools.
@@ -94,42 +98,75 @@ func (dtm *DynamicToolsetManager) handleEnableToolset(ctx context.Context, reque
 		return mcp.NewToolResultError("Parameter 'toolset' must be a string"), nil
 	}
 
-	dtm.logger.Infof("Enabling toolset: %s", toolsetName)
-
-	// Enable the toolset
-	if err := dtm.toolsetGroup.EnableToolset(toolsetName); err != nil {
+	toolNames, err := dtm.enableToolsetByName(toolsetName)
+	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("Failed to enable toolset '%s': %v", toolsetName, err)), nil
 	}
 
-	// Re-register tools with MCP server
-	if err := dtm.registerToolsetTools(toolsetName); err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("Failed to register tools for '%s': %v", toolsetName, err)), nil
+	result := fmt.Sprintf("Successfully enabled toolset '%s'. Tools are now available.", toolsetName)
+	if len(toolNames) > 0 {
+		result += fmt.Sprintf("\nRegistered tools (%d): %s", len(toolNames), strings.Join(toolNames, ", "))
 	}
-
-	return mcp.NewToolResultText(fmt.Sprintf("Successfully enabled toolset '%s'. Tools are now available.", toolsetName)), nil
+	return mcp.NewToolResultText(result), nil
 }
 
-// registerToolsetTools registers all tools from a specific toolset with the MCP server.
-func (dtm *DynamicToolsetManager) registerToolsetTools(toolsetName string) error {
-	// Get toolset info to verify it exists
-	infos := dtm.toolsetGroup.ListToolsets()
-	var found bool
-	for _, info := range infos {
-		if info.Name == toolsetName {
-			found = true
-			dtm.logger.Infof("Registering %d tools from toolset '%s'", info.ToolCount, toolsetName)
-			break
+// enableToolsetByName enables the named toolset along with the toolsets it depends on that
+// are not enabled yet, and registers their tools with the MCP server. It returns the names
+// of the newly registered tools. The enabled state only lives in memory.
+func (dtm *DynamicToolsetManager) enableToolsetByName(toolsetName string) ([]string, error) {
+	dtm.mu.Lock()
+	defer dtm.mu.Unlock()
+
+	if _, ok := dtm.toolsetGroup.Toolsets[toolsetName]; !ok {
+		return nil, fmt.Errorf("toolset '%s' not found", toolsetName)
+	}
+	if dtm.toolsetGroup.Toolsets[toolsetName].IsEnabled() {
+		return nil, fmt.Errorf("toolset '%s' already enabled", toolsetName)
+	}
+
+	resolved, err := dtm.toolsetGroup.ResolveDependencies([]string{toolsetName})
+	if err != nil {
+		return nil, err
+	}
+
+	var toolNames []string
+	for _, name := range resolved {
+		if dtm.toolsetGroup.Toolsets[name].IsEnabled() {
+			continue
+		}
+		if name != toolsetName {
+			dtm.logger.Infof("Enabling toolset '%s' as a dependency of '%s'", name, toolsetName)
+		} else {
+			dtm.logger.Infof("Enabling toolset: %s", name)
+		}
+		if err := dtm.toolsetGroup.EnableToolset(name); err != nil {
+			return toolNames, err
+		}
+
+		registered, err := dtm.registerToolsetTools(name)
+		toolNames = append(toolNames, registered...)
+		if err != nil {
+			return toolNames, err
 		}
 	}
+	return toolNames, nil
+}
 
-	if !found {
-		return fmt.Errorf("toolset '%s' not found", toolsetName)
+// registerToolsetTools registers the active tools of an enabled toolset with the MCP server
+// and returns their names.
+func (dtm *DynamicToolsetManager) registerToolsetTools(toolsetName string) ([]string, error) {
+	ts, ok := dtm.toolsetGroup.Toolsets[toolsetName]
+	if !ok {
+		return nil, fmt.Errorf("toolset '%s' not found", toolsetName)
 	}
 
-	// Note: The actual tool registration happens through the toolsetGroup
-	// We need to re-register all enabled toolsets to include the newly enabled one
-	dtm.toolsetGroup.RegisterTools(dtm.mcpServer)
+	tools := ts.GetActiveTools()
+	toolNames := make([]string, 0, len(tools))
+	for _, tool := range tools {
+		toolNames = append(toolNames, tool.Tool.Name)
+	}
+	dtm.mcpServer.AddTools(tools...)
 
-	dtm.logger.Infof("Successfully registered tools for toolset '%s'", toolsetName)
-	return nil
+	dtm.logger.Infof("Registered %d tools from toolset '%s'", len(tools), toolsetName)
+	return toolNames, nil
 }
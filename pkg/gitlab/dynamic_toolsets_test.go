@@ -2,8 +2,10 @@ package gitlab
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
 	"github.com/InkyQuill/gitlab-mcp-server/pkg/toolsets"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -66,9 +68,10 @@ func TestDynamicToolsetManager_RegisterDiscoveryTools(t *testing.T) {
 	dtm.RegisterDiscoveryTools()
 
 	// Verify tools were registered
-	// Note: MCPServer doesn't expose a way to list registered tools,
-	// but we can verify the function doesn't panic
-	assert.True(t, true)
+	for _, tool := range []mcp.Tool{listAvailableToolsetsTool(), enableToolsetTool()} {
+		require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+		assert.NotNil(t, mcpServer.GetTool(tool.Name))
+	}
 }
 
 func TestDynamicToolsetManager_handleListToolsets(t *testing.T) {
@@ -76,18 +79,16 @@ func TestDynamicToolsetManager_handleListToolsets(t *testing.T) {
 	logger.SetLevel(log.ErrorLevel)
 
 	tests := []struct {
-		name                string
-		setupToolsets       func(*toolsets.ToolsetGroup)
-		expectedInResult    []string
-		notExpectedInResult []string
+		name          string
+		setupToolsets func(*toolsets.ToolsetGroup)
+		expected      string
 	}{
 		{
 			name: "Empty toolset group",
 			setupToolsets: func(tg *toolsets.ToolsetGroup) {
 				// No toolsets added
 			},
-			expectedInResult:    []string{"Available Toolsets (0):"},
-			notExpectedInResult: []string{},
+			expected: `[]`,
 		},
 		{
 			name: "Multiple toolsets, all disabled",
@@ -97,13 +98,16 @@ func TestDynamicToolsetManager_handleListToolsets(t *testing.T) {
 				tg.AddToolset(ts1)
 				tg.AddToolset(ts2)
 			},
-			expectedInResult:    []string{"Available Toolsets (2):", "projects: Project tools", "issues: Issue tools", "(disabled)"},
-			notExpectedInResult: []string{"(enabled)"},
+			expected: `[
+				{"name":"issues","description":"Issue tools","enabled":false,"toolCount":0},
+				{"name":"projects","description":"Project tools","enabled":false,"toolCount":0}
+			]`,
 		},
 		{
 			name: "Mixed enabled/disabled toolsets",
 			setupToolsets: func(tg *toolsets.ToolsetGroup) {
 				ts1 := toolsets.NewToolset("projects", "Project tools")
+				ts1.AddReadTools(toolsets.NewServerTool(mcp.NewTool("getProject"), nil))
 				ts2 := toolsets.NewToolset("issues", "Issue tools")
 				ts3 := toolsets.NewToolset("merge_requests", "MR tools")
 
@@ -114,8 +118,11 @@ func TestDynamicToolsetManager_handleListToolsets(t *testing.T) {
 				// Enable issues toolset
 				tg.EnableToolset("issues")
 			},
-			expectedInResult:    []string{"Available Toolsets (3):", "projects: Project tools", "issues: Issue tools", "merge_requests: MR tools", "(disabled)", "(enabled)"},
-			notExpectedInResult: []string{},
+			expected: `[
+				{"name":"issues","description":"Issue tools","enabled":true,"toolCount":0},
+				{"name":"merge_requests","description":"MR tools","enabled":false,"toolCount":0},
+				{"name":"projects","description":"Project tools","enabled":false,"toolCount":1}
+			]`,
 		},
 	}
 
@@ -134,21 +141,7 @@ func TestDynamicToolsetManager_handleListToolsets(t *testing.T) {
 
 			require.NoError(t, err)
 			require.NotNil(t, result)
-			require.Len(t, result.Content, 1, "Should return one content block")
-
-			// Extract text content
-			textContent, ok := result.Content[0].(mcp.TextContent)
-			require.True(t, ok, "Result should be TextContent")
-
-			// Verify expected strings are in result
-			for _, expected := range tc.expectedInResult {
-				assert.Contains(t, textContent.Text, expected)
-			}
-
-			// Verify unexpected strings are NOT in result
-			for _, notExpected := range tc.notExpectedInResult {
-				assert.NotContains(t, textContent.Text, notExpected)
-			}
+			assert.JSONEq(t, tc.expected, getTextResult(t, result).Text)
 		})
 	}
 }
@@ -209,6 +202,33 @@ func TestDynamicToolsetManager_handleEnableToolset(t *testing.T) {
 			expectedInResult:    "Successfully enabled toolset 'projects'. Tools are now available.",
 			notExpectedInResult: "Failed",
 		},
+		{
+			name: "Enable toolset with dependencies",
+			setupToolsets: func(tg *toolsets.ToolsetGroup) {
+				tg.AddToolset(toolsets.NewToolset("projects", "Project tools"))
+				ts := toolsets.NewToolset("pipeline_jobs", "Pipeline tools")
+				ts.DependsOn = []string{"projects"}
+				tg.AddToolset(ts)
+			},
+			requestArgs:         map[string]interface{}{"toolset": "pipeline_jobs"},
+			expectError:         false,
+			expectedInResult:    "Successfully enabled toolset 'pipeline_jobs'",
+			notExpectedInResult: "Failed",
+		},
+		{
+			name: "Enable toolset whose dependency is already enabled",
+			setupToolsets: func(tg *toolsets.ToolsetGroup) {
+				tg.AddToolset(toolsets.NewToolset("projects", "Project tools"))
+				ts := toolsets.NewToolset("pipeline_jobs", "Pipeline tools")
+				ts.DependsOn = []string{"projects"}
+				tg.AddToolset(ts)
+				tg.EnableToolset("projects")
+			},
+			requestArgs:         map[string]interface{}{"toolset": "pipeline_jobs"},
+			expectError:         false,
+			expectedInResult:    "Successfully enabled toolset 'pipeline_jobs'",
+			notExpectedInResult: "Failed",
+		},
 		{
 			name: "Enable already enabled toolset",
 			setupToolsets: func(tg *toolsets.ToolsetGroup) {
@@ -236,7 +256,7 @@ func TestDynamicToolsetManager_handleEnableToolset(t *testing.T) {
 			// Create request
 			request := mcp.CallToolRequest{
 				Params: mcp.CallToolParams{
-					Name:      "enable_toolset",
+					Name:      "enableToolset",
 					Arguments: tc.requestArgs,
 				},
 			}
@@ -319,7 +339,7 @@ func TestDynamicToolsetManager_registerToolsetTools(t *testing.T) {
 			dtm := NewDynamicToolsetManager(tg, mcpServer, logger)
 
 			// Call registerToolsetTools
-			err := dtm.registerToolsetTools(tc.toolsetName)
+			_, err := dtm.registerToolsetTools(tc.toolsetName)
 
 			if tc.expectError {
 				require.Error(t, err)
@@ -331,6 +351,57 @@ func TestDynamicToolsetManager_registerToolsetTools(t *testing.T) {
 	}
 }
 
+func TestDynamicToolsetManager_enableToolsetByName(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	newTool := func(name string, readOnly bool) server.ServerTool {
+		return toolsets.NewServerTool(mcp.NewTool(name, mcp.WithToolAnnotation(mcp.ToolAnnotation{ReadOnlyHint: boolPtr(readOnly)})), nil)
+	}
+
+	tg := toolsets.NewToolsetGroup(false)
+	projects := toolsets.NewToolset("projects", "Project tools")
+	projects.AddReadTools(newTool("getProject", true))
+	pipelines := toolsets.NewToolset("pipeline_jobs", "Pipeline tools")
+	pipelines.DependsOn = []string{"projects"}
+	pipelines.AddReadTools(newTool("pipelineJob", true))
+	pipelines.AddWriteTools(newTool("pipeline", false))
+	issues := toolsets.NewToolset("issues", "Issue tools")
+	issues.AddReadTools(newTool("getIssue", true))
+	tg.AddToolset(projects)
+	tg.AddToolset(pipelines)
+	tg.AddToolset(issues)
+
+	mcpServer := server.NewMCPServer("test-server", "1.0.0", server.WithToolCapabilities(true))
+	dtm := NewDynamicToolsetManager(tg, mcpServer, logger)
+
+	toolNames, err := dtm.enableToolsetByName("pipeline_jobs")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"getProject", "pipelineJob", "pipeline"}, toolNames, "dependency tools are registered first")
+	assert.True(t, projects.IsEnabled())
+	assert.True(t, pipelines.IsEnabled())
+	assert.False(t, issues.IsEnabled())
+	assert.NotNil(t, mcpServer.GetTool("getProject"))
+	assert.NotNil(t, mcpServer.GetTool("pipeline"))
+	assert.Nil(t, mcpServer.GetTool("getIssue"))
+
+	_, err = dtm.enableToolsetByName("pipeline_jobs")
+	assert.EqualError(t, err, "toolset 'pipeline_jobs' already enabled")
+
+	_, err = dtm.enableToolsetByName("nonexistent")
+	assert.EqualError(t, err, "toolset 'nonexistent' not found")
+
+	request := mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Name:      "enableToolset",
+			Arguments: map[string]interface{}{"toolset": "issues"},
+		},
+	}
+	result, err := dtm.handleEnableToolset(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, "Successfully enabled toolset 'issues'. Tools are now available.\nRegistered tools (1): getIssue", getTextResult(t, result).Text)
+}
+
 func TestDynamicToolsetManager_Integration_EnableThenList(t *testing.T) {
 	logger := log.New()
 	logger.SetLevel(log.ErrorLevel)
@@ -349,14 +420,17 @@ func TestDynamicToolsetManager_Integration_EnableThenList(t *testing.T) {
 	// List toolsets - should show both as disabled
 	listResult1, err := dtm.handleListToolsets(context.Background(), mcp.CallToolRequest{})
 	require.NoError(t, err)
-	textContent1 := listResult1.Content[0].(mcp.TextContent)
-	assert.Contains(t, textContent1.Text, "projects: Project tools")
-	assert.Contains(t, textContent1.Text, "(disabled)")
+	var infos1 []toolsets.ToolsetInfo
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, listResult1).Text), &infos1))
+	assert.Equal(t, []toolsets.ToolsetInfo{
+		{Name: "issues", Description: "Issue tools"},
+		{Name: "projects", Description: "Project tools"},
+	}, infos1)
 
 	// Enable projects toolset
 	enableRequest := mcp.CallToolRequest{
 		Params: mcp.CallToolParams{
-			Name:      "enable_toolset",
+			Name:      "enableToolset",
 			Arguments: map[string]interface{}{"toolset": "projects"},
 		},
 	}
@@ -368,9 +442,10 @@ func TestDynamicToolsetManager_Integration_EnableThenList(t *testing.T) {
 	// List toolsets again - projects should be enabled
 	listResult2, err := dtm.handleListToolsets(context.Background(), mcp.CallToolRequest{})
 	require.NoError(t, err)
-	textContent3 := listResult2.Content[0].(mcp.TextContent)
-	assert.Contains(t, textContent3.Text, "projects: Project tools")
-	assert.Contains(t, textContent3.Text, "(enabled)")
-	assert.Contains(t, textContent3.Text, "issues: Issue tools")
-	assert.Contains(t, textContent3.Text, "(disabled)")
+	var infos2 []toolsets.ToolsetInfo
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, listResult2).Text), &infos2))
+	assert.Equal(t, []toolsets.ToolsetInfo{
+		{Name: "issues", Description: "Issue tools"},
+		{Name: "projects", Description: "Project tools", Enabled: true},
+	}, infos2)
 }
@@ -0,0 +1,261 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gl "gitlab.com/gitlab-org/api/client-go"
+)
+
+// ListEnvironments defines the MCP tool for listing deployment environments of a GitLab project.
+func ListEnvironments(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listEnvironments",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_ENVIRONMENTS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Environments",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			// Optional filtering parameters
+			mcp.WithString("name",
+				mcp.Description("Return the environment with this exact name."),
+			),
+			mcp.WithString("search",
+				mcp.Description("Return environments whose name matches the search term (minimum 3 characters)."),
+			),
+			mcp.WithString("states",
+				mcp.Description("Return environments in the given state."),
+				mcp.Enum("available", "stopping", "stopped"),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional filtering parameters
+			name, err := OptionalParam[string](&request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			search, err := OptionalParam[string](&request, "search")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			states, err := OptionalParam[string](&request, "states")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.ListEnvironmentsOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+			}
+
+			if name != "" {
+				opts.Name = &name
+			}
+
+			if search != "" {
+				opts.Search = &search
+			}
+
+			if states != "" {
+				opts.States = &states
+			}
+
+			// --- Call GitLab API
+			environments, resp, err := glClient.Environments.ListEnvironments(projectID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, fmt.Sprintf("environments from project %q", projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(environments) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(environments)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal environments list: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// CreateEnvironment defines the MCP tool for creating a deployment environment in a GitLab project.
+func CreateEnvironment(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"createEnvironment",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_CREATE_ENVIRONMENT_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Create GitLab Environment",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("name",
+				mcp.Description("The name of the environment."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithString("externalUrl",
+				mcp.Description("Link to the deployed application for this environment."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			name, err := requiredParam[string](&request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional parameters
+			externalURL, err := OptionalParam[string](&request, "externalUrl")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.CreateEnvironmentOptions{
+				Name: &name,
+			}
+
+			if externalURL != "" {
+				opts.ExternalURL = &externalURL
+			}
+
+			// --- Call GitLab API
+			environment, resp, err := glClient.Environments.CreateEnvironment(projectID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("project %q", projectID), "create environment")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(environment)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal environment data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// StopEnvironment defines the MCP tool for stopping a deployment environment.
+func StopEnvironment(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"stopEnvironment",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_STOP_ENVIRONMENT_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Stop GitLab Environment",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("environmentId",
+				mcp.Description("The ID of the environment to stop."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			environmentIDFloat, err := requiredParam[float64](&request, "environmentId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			environmentID := int64(environmentIDFloat)
+			if float64(environmentID) != environmentIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: environmentId %v is not a valid integer", environmentIDFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			environment, resp, err := glClient.Environments.StopEnvironment(projectID, environmentID, nil, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("environment %d in project %q", environmentID, projectID), "stop environment")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(environment)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal environment data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
@@ -0,0 +1,203 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+func TestListEnvironmentsHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListEnvironments(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockEnvironments, ctrl := setupMockClientForEnvironments(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListEnvironments(mockGetClient, nil)
+
+	tests := []struct {
+		name               string
+		inputArgs          map[string]any
+		mockSetup          func()
+		expectedText       string
+		expectHandlerError bool
+		expectResultError  bool
+	}{
+		{
+			name: "Success - Filters are forwarded",
+			inputArgs: map[string]any{
+				"projectId": "group/project",
+				"search":    "prod",
+				"states":    "available",
+				"per_page":  50.0,
+			},
+			mockSetup: func() {
+				mockEnvironments.EXPECT().
+					ListEnvironments("group/project", gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, opts *gl.ListEnvironmentsOptions, _ ...gl.RequestOptionFunc) ([]*gl.Environment, *gl.Response, error) {
+						assert.Equal(t, "prod", *opts.Search)
+						assert.Equal(t, "available", *opts.States)
+						assert.Nil(t, opts.Name)
+						assert.Equal(t, int64(50), opts.PerPage)
+						return []*gl.Environment{{ID: 1, Name: "production", State: "available"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+					})
+			},
+			expectedText: `"name":"production"`,
+		},
+		{
+			name:      "Success - Empty list",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockEnvironments.EXPECT().
+					ListEnvironments("group/project", gomock.Any(), gomock.Any()).
+					Return([]*gl.Environment{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: "[]",
+		},
+		{
+			name:              "Error - Missing projectId",
+			inputArgs:         map[string]any{},
+			mockSetup:         func() {},
+			expectedText:      "Validation Error: missing required parameter: projectId",
+			expectResultError: true,
+		},
+		{
+			name:      "Error - GitLab API Error (500)",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockEnvironments.EXPECT().
+					ListEnvironments("group/project", gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 500}}, errors.New("gitlab: 500 Internal Server Error"))
+			},
+			expectHandlerError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.inputArgs}})
+
+			if tc.expectHandlerError {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, "failed to list environments from project")
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, tc.expectResultError, result.IsError)
+			assert.Contains(t, getTextResult(t, result).Text, tc.expectedText)
+		})
+	}
+}
+
+func TestCreateEnvironmentHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := CreateEnvironment(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockEnvironments, ctrl := setupMockClientForEnvironments(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := CreateEnvironment(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockEnvironments.EXPECT().
+			CreateEnvironment("group/project", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.CreateEnvironmentOptions, _ ...gl.RequestOptionFunc) (*gl.Environment, *gl.Response, error) {
+				assert.Equal(t, "review/feature-x", *opts.Name)
+				assert.Equal(t, "https://feature-x.example.com", *opts.ExternalURL)
+				return &gl.Environment{ID: 3, Name: "review/feature-x"}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":   "group/project",
+			"name":        "review/feature-x",
+			"externalUrl": "https://feature-x.example.com",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"id":3`)
+	})
+
+	t.Run("Error - Missing name", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: name")
+	})
+}
+
+func TestStopEnvironmentHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := StopEnvironment(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockEnvironments, ctrl := setupMockClientForEnvironments(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := StopEnvironment(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockEnvironments.EXPECT().
+			StopEnvironment("group/project", int64(3), gomock.Any(), gomock.Any()).
+			Return(&gl.Environment{ID: 3, State: "stopping"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":     "group/project",
+			"environmentId": 3.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"state":"stopping"`)
+	})
+
+	t.Run("Error - Environment Not Found (404)", func(t *testing.T) {
+		mockEnvironments.EXPECT().
+			StopEnvironment("group/project", int64(99), gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":     "group/project",
+			"environmentId": 99.0,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, `environment 99 in project "group/project" not found`)
+	})
+
+	t.Run("Error - Non-integer environmentId", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":     "group/project",
+			"environmentId": 3.3,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: environmentId 3.3 is not a valid integer")
+	})
+}
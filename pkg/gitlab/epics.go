@@ -0,0 +1,993 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gl "gitlab.com/gitlab-org/api/client-go"
+)
+
+// parseEpicDates reads the optional startDate and dueDate parameters (YYYY-MM-DD).
+// Epics only honour explicit dates when the matching "is fixed" flag is set, so callers
+// set both the date and the flag.
+func parseEpicDates(request *mcp.CallToolRequest) (startDate, dueDate *gl.ISOTime, err error) {
+	startDateStr, err := OptionalParam[string](request, "startDate")
+	if err != nil {
+		return nil, nil, err
+	}
+	if startDateStr != "" {
+		parsed, err := time.Parse("2006-01-02", startDateStr)
+		if err != nil {
+			return nil, nil, fmt.Errorf("startDate must be in YYYY-MM-DD format, got %q: %w", startDateStr, err)
+		}
+		startDate = gl.Ptr(gl.ISOTime(parsed))
+	}
+
+	dueDateStr, err := OptionalParam[string](request, "dueDate")
+	if err != nil {
+		return nil, nil, err
+	}
+	dueDate, err = ParseDueDate(dueDateStr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return startDate, dueDate, nil
+}
+
+// ListEpics defines the MCP tool for listing the epics of a GitLab group.
+func ListEpics(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listEpics",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_EPICS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Epics",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("groupId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the group."),
+				mcp.Required(),
+			),
+			// Optional filtering parameters
+			mcp.WithNumber("authorId",
+				mcp.Description("Return epics created by the given user ID (integer)."),
+			),
+			mcp.WithString("labels",
+				mcp.Description("Comma-separated list of label names to filter by."),
+			),
+			mcp.WithBoolean("withLabelsDetails",
+				mcp.Description("Return label objects (name, color, description) instead of label names."),
+			),
+			mcp.WithString("state",
+				mcp.Description("Return epics with the specified state."),
+				mcp.Enum("opened", "closed"),
+			),
+			mcp.WithString("search",
+				mcp.Description("Search epics against their title and description."),
+			),
+			mcp.WithString("createdAfter",
+				mcp.Description("Return epics created on or after the given time (ISO 8601 format: YYYY-MM-DDTHH:MM:SSZ)."),
+			),
+			mcp.WithString("createdBefore",
+				mcp.Description("Return epics created on or before the given time (ISO 8601 format: YYYY-MM-DDTHH:MM:SSZ)."),
+			),
+			mcp.WithString("updatedAfter",
+				mcp.Description("Return epics updated on or after the given time (ISO 8601 format: YYYY-MM-DDTHH:MM:SSZ)."),
+			),
+			mcp.WithString("updatedBefore",
+				mcp.Description("Return epics updated on or before the given time (ISO 8601 format: YYYY-MM-DDTHH:MM:SSZ)."),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			groupID, err := requiredParam[string](&request, "groupId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional filtering parameters
+			authorIDFloat, err := OptionalParam[float64](&request, "authorId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			labels, err := OptionalParam[string](&request, "labels")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			withLabelsDetails, err := OptionalBoolParam(&request, "withLabelsDetails")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			state, err := OptionalParam[string](&request, "state")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			search, err := OptionalParam[string](&request, "search")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			createdAfter, err := OptionalTimeParam(&request, "createdAfter")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			createdBefore, err := OptionalTimeParam(&request, "createdBefore")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			updatedAfter, err := OptionalTimeParam(&request, "updatedAfter")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			updatedBefore, err := OptionalTimeParam(&request, "updatedBefore")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.ListGroupEpicsOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+				WithLabelDetails: withLabelsDetails,
+				CreatedAfter:     createdAfter,
+				CreatedBefore:    createdBefore,
+				UpdatedAfter:     updatedAfter,
+				UpdatedBefore:    updatedBefore,
+			}
+
+			if authorIDFloat != 0 {
+				authorID := int64(authorIDFloat)
+				opts.AuthorID = &authorID
+			}
+
+			if labels != "" {
+				labelOpts, err := ParseLabelString(labels)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+				}
+				opts.Labels = labelOpts
+			}
+
+			if state != "" {
+				opts.State = &state
+			}
+
+			if search != "" {
+				opts.Search = &search
+			}
+
+			// --- Call GitLab API
+			epics, resp, err := glClient.Epics.ListGroupEpics(groupID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, fmt.Sprintf("epics from group %q", groupID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(epics) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(epics)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal epics list: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// GetEpic defines the MCP tool for retrieving a single epic of a GitLab group.
+func GetEpic(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"getEpic",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_EPIC_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "Get GitLab Epic",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("groupId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the group."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("epicIid",
+				mcp.Description("The internal ID of the epic within the group."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			groupID, err := requiredParam[string](&request, "groupId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			epicIIDFloat, err := requiredParam[float64](&request, "epicIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			epicIID := int64(epicIIDFloat)
+			if float64(epicIID) != epicIIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: epicIid %v is not a valid integer", epicIIDFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			epic, resp, err := glClient.Epics.GetEpic(groupID, epicIID, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("epic %d in group %q", epicIID, groupID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(epic)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal epic data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// CreateEpic defines the MCP tool for creating an epic in a GitLab group.
+func CreateEpic(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"createEpic",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_CREATE_EPIC_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Create GitLab Epic",
+			}),
+			// Required parameters
+			mcp.WithString("groupId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the group."),
+				mcp.Required(),
+			),
+			mcp.WithString("title",
+				mcp.Description("The title of the epic."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithString("description",
+				mcp.Description("The description of the epic."),
+			),
+			mcp.WithString("labels",
+				mcp.Description("Comma-separated list of label names."),
+			),
+			mcp.WithString("startDate",
+				mcp.Description("The fixed start date of the epic (YYYY-MM-DD)."),
+			),
+			mcp.WithString("dueDate",
+				mcp.Description("The fixed due date of the epic (YYYY-MM-DD)."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			groupID, err := requiredParam[string](&request, "groupId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			title, err := requiredParam[string](&request, "title")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional parameters
+			description, err := OptionalParam[string](&request, "description")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			labels, err := OptionalParam[string](&request, "labels")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			startDate, dueDate, err := parseEpicDates(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.CreateEpicOptions{
+				Title: &title,
+			}
+
+			if description != "" {
+				opts.Description = &description
+			}
+
+			if labels != "" {
+				labelOpts, err := ParseLabelString(labels)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+				}
+				opts.Labels = labelOpts
+			}
+
+			if startDate != nil {
+				opts.StartDateFixed = startDate
+				opts.StartDateIsFixed = gl.Ptr(true)
+			}
+
+			if dueDate != nil {
+				opts.DueDateFixed = dueDate
+				opts.DueDateIsFixed = gl.Ptr(true)
+			}
+
+			// --- Call GitLab API
+			epic, resp, err := glClient.Epics.CreateEpic(groupID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("group %q", groupID), "create epic")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(epic)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal epic data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// UpdateEpic defines the MCP tool for updating an epic of a GitLab group.
+func UpdateEpic(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"updateEpic",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_UPDATE_EPIC_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Update GitLab Epic",
+			}),
+			// Required parameters
+			mcp.WithString("groupId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the group."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("epicIid",
+				mcp.Description("The internal ID of the epic within the group."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithString("title",
+				mcp.Description("The new title of the epic."),
+			),
+			mcp.WithString("description",
+				mcp.Description("The new description of the epic."),
+			),
+			mcp.WithString("labels",
+				mcp.Description("Comma-separated list of label names; replaces the existing labels."),
+			),
+			mcp.WithString("startDate",
+				mcp.Description("The new fixed start date of the epic (YYYY-MM-DD)."),
+			),
+			mcp.WithString("dueDate",
+				mcp.Description("The new fixed due date of the epic (YYYY-MM-DD)."),
+			),
+			mcp.WithString("stateEvent",
+				mcp.Description("Close or reopen the epic."),
+				mcp.Enum("close", "reopen"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			groupID, err := requiredParam[string](&request, "groupId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			epicIIDFloat, err := requiredParam[float64](&request, "epicIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			epicIID := int64(epicIIDFloat)
+			if float64(epicIID) != epicIIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: epicIid %v is not a valid integer", epicIIDFloat)), nil
+			}
+
+			// --- Parse optional parameters
+			title, err := OptionalParam[string](&request, "title")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			description, err := OptionalParam[string](&request, "description")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			labels, err := OptionalParam[string](&request, "labels")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			startDate, dueDate, err := parseEpicDates(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			stateEvent, err := OptionalParam[string](&request, "stateEvent")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			if title == "" && description == "" && labels == "" && startDate == nil && dueDate == nil && stateEvent == "" {
+				return mcp.NewToolResultError("Validation Error: at least one of title, description, labels, startDate, dueDate or stateEvent must be provided"), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.UpdateEpicOptions{}
+
+			if title != "" {
+				opts.Title = &title
+			}
+
+			if description != "" {
+				opts.Description = &description
+			}
+
+			if labels != "" {
+				labelOpts, err := ParseLabelString(labels)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+				}
+				opts.Labels = labelOpts
+			}
+
+			if startDate != nil {
+				opts.StartDateFixed = startDate
+				opts.StartDateIsFixed = gl.Ptr(true)
+			}
+
+			if dueDate != nil {
+				opts.DueDateFixed = dueDate
+				opts.DueDateIsFixed = gl.Ptr(true)
+			}
+
+			if stateEvent != "" {
+				opts.StateEvent = &stateEvent
+			}
+
+			// --- Call GitLab API
+			epic, resp, err := glClient.Epics.UpdateEpic(groupID, epicIID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("epic %d in group %q", epicIID, groupID), "update epic")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(epic)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal epic data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// ListEpicIssues defines the MCP tool for listing the issues assigned to an epic.
+func ListEpicIssues(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listEpicIssues",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_EPIC_ISSUES_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Epic Issues",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("groupId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the group."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("epicIid",
+				mcp.Description("The internal ID of the epic within the group."),
+				mcp.Required(),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			groupID, err := requiredParam[string](&request, "groupId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			epicIIDFloat, err := requiredParam[float64](&request, "epicIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			epicIID := int64(epicIIDFloat)
+			if float64(epicIID) != epicIIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: epicIid %v is not a valid integer", epicIIDFloat)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.ListOptions{
+				Page:    int64(page),
+				PerPage: int64(perPage),
+			}
+
+			// --- Call GitLab API
+			issues, resp, err := glClient.EpicIssues.ListEpicIssues(groupID, epicIID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("epic %d in group %q", epicIID, groupID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(issues) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(issues)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal epic issues list: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// AddEpicIssue defines the MCP tool for assigning an issue to an epic.
+func AddEpicIssue(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"addEpicIssue",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_ADD_EPIC_ISSUE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Add Issue to GitLab Epic",
+			}),
+			// Required parameters
+			mcp.WithString("groupId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the group."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("epicIid",
+				mcp.Description("The internal ID of the epic within the group."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("issueId",
+				mcp.Description("The global ID of the issue (not its project-level IID)."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			groupID, err := requiredParam[string](&request, "groupId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			epicIIDFloat, err := requiredParam[float64](&request, "epicIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			epicIID := int64(epicIIDFloat)
+			if float64(epicIID) != epicIIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: epicIid %v is not a valid integer", epicIIDFloat)), nil
+			}
+
+			issueIDFloat, err := requiredParam[float64](&request, "issueId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			issueID := int64(issueIDFloat)
+			if float64(issueID) != issueIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: issueId %v is not a valid integer", issueIDFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			assignment, resp, err := glClient.EpicIssues.AssignEpicIssue(groupID, epicIID, issueID, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("epic %d in group %q or issue %d", epicIID, groupID, issueID), "add issue to epic")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(assignment)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal epic issue assignment: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// RemoveEpicIssue defines the MCP tool for removing an issue from an epic.
+func RemoveEpicIssue(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"removeEpicIssue",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_REMOVE_EPIC_ISSUE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Remove Issue from GitLab Epic",
+			}),
+			// Required parameters
+			mcp.WithString("groupId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the group."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("epicIid",
+				mcp.Description("The internal ID of the epic within the group."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("epicIssueId",
+				mcp.Description("The ID of the epic-issue association (epic_issue_id in listEpicIssues results)."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			groupID, err := requiredParam[string](&request, "groupId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			epicIIDFloat, err := requiredParam[float64](&request, "epicIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			epicIID := int64(epicIIDFloat)
+			if float64(epicIID) != epicIIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: epicIid %v is not a valid integer", epicIIDFloat)), nil
+			}
+
+			epicIssueIDFloat, err := requiredParam[float64](&request, "epicIssueId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			epicIssueID := int64(epicIssueIDFloat)
+			if float64(epicIssueID) != epicIssueIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: epicIssueId %v is not a valid integer", epicIssueIDFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			assignment, resp, err := glClient.EpicIssues.RemoveEpicIssue(groupID, epicIID, epicIssueID, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("epic issue %d of epic %d in group %q", epicIssueID, epicIID, groupID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(assignment)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal epic issue assignment: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// ListGroupEpicBoards defines the MCP tool for listing the epic boards of a GitLab group.
+func ListGroupEpicBoards(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listGroupEpicBoards",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_GROUP_EPIC_BOARDS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Group Epic Boards",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("groupId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the group."),
+				mcp.Required(),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			groupID, err := requiredParam[string](&request, "groupId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.ListGroupEpicBoardsOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+			}
+
+			// --- Call GitLab API
+			boards, resp, err := glClient.GroupEpicBoards.ListGroupEpicBoards(groupID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, fmt.Sprintf("epic boards from group %q", groupID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(boards) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(boards)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal epic boards list: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// GetGroupEpicBoard defines the MCP tool for retrieving a single epic board of a GitLab group.
+func GetGroupEpicBoard(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"getGroupEpicBoard",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_GROUP_EPIC_BOARD_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "Get GitLab Group Epic Board",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("groupId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the group."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("boardId",
+				mcp.Description("The ID of the epic board."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			groupID, err := requiredParam[string](&request, "groupId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			boardIDFloat, err := requiredParam[float64](&request, "boardId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			boardID := int64(boardIDFloat)
+			if float64(boardID) != boardIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: boardId %v is not a valid integer", boardIDFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			board, resp, err := glClient.GroupEpicBoards.GetGroupEpicBoard(groupID, boardID, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("epic board %d in group %q", boardID, groupID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(board)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal epic board data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// ListEpicBoardLists defines the MCP tool for listing the lists (columns) of a group epic board.
+func ListEpicBoardLists(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listEpicBoardLists",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_EPIC_BOARD_LISTS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Epic Board Lists",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("groupId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the group."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("boardId",
+				mcp.Description("The ID of the epic board."),
+				mcp.Required(),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			groupID, err := requiredParam[string](&request, "groupId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			boardIDFloat, err := requiredParam[float64](&request, "boardId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			boardID := int64(boardIDFloat)
+			if float64(boardID) != boardIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: boardId %v is not a valid integer", boardIDFloat)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.ListOptions{
+				Page:    int64(page),
+				PerPage: int64(perPage),
+			}
+
+			// --- Call GitLab API
+			// The client library has no wrapper for the epic board lists endpoint,
+			// so the request is built directly against the REST path.
+			u := fmt.Sprintf("groups/%s/epic_boards/%d/lists", gl.PathEscape(groupID), boardID)
+			req, err := glClient.NewRequest(http.MethodGet, u, opts, []gl.RequestOptionFunc{gl.WithContext(ctx)})
+			if err != nil {
+				return nil, fmt.Errorf("failed to build epic board lists request: %w", err)
+			}
+
+			var lists []*gl.BoardList
+			resp, err := glClient.Do(req, &lists)
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("epic board %d in group %q", boardID, groupID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(lists) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(lists)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal epic board lists: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
@@ -0,0 +1,700 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+func TestListEpicsHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListEpics(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockEpics, ctrl := setupMockClientForEpics(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListEpics(mockGetClient, nil)
+
+	tests := []struct {
+		name               string
+		inputArgs          map[string]any
+		mockSetup          func()
+		expectedText       string
+		expectHandlerError bool
+		expectResultError  bool
+		errorContains      string
+	}{
+		{
+			name: "Success - Filters are forwarded",
+			inputArgs: map[string]any{
+				"groupId":           "my-group",
+				"authorId":          7.0,
+				"labels":            "roadmap,q3",
+				"withLabelsDetails": true,
+				"state":             "opened",
+				"search":            "billing",
+				"createdAfter":      "2026-01-01T00:00:00Z",
+			},
+			mockSetup: func() {
+				mockEpics.EXPECT().
+					ListGroupEpics("my-group", gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, opts *gl.ListGroupEpicsOptions, _ ...gl.RequestOptionFunc) ([]*gl.Epic, *gl.Response, error) {
+						assert.Equal(t, int64(7), *opts.AuthorID)
+						assert.Equal(t, gl.LabelOptions{"roadmap", "q3"}, *opts.Labels)
+						assert.True(t, *opts.WithLabelDetails)
+						assert.Equal(t, "opened", *opts.State)
+						assert.Equal(t, "billing", *opts.Search)
+						assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), *opts.CreatedAfter)
+						assert.Nil(t, opts.UpdatedBefore)
+						return []*gl.Epic{{ID: 30, IID: 3, Title: "Billing revamp"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+					})
+			},
+			expectedText: `"title":"Billing revamp"`,
+		},
+		{
+			name:      "Success - Empty list",
+			inputArgs: map[string]any{"groupId": "my-group"},
+			mockSetup: func() {
+				mockEpics.EXPECT().
+					ListGroupEpics("my-group", gomock.Any(), gomock.Any()).
+					Return([]*gl.Epic{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: "[]",
+		},
+		{
+			name:              "Error - Missing groupId",
+			inputArgs:         map[string]any{},
+			mockSetup:         func() {},
+			expectResultError: true,
+			errorContains:     "Validation Error: missing required parameter: groupId",
+		},
+		{
+			name:              "Error - Invalid createdAfter",
+			inputArgs:         map[string]any{"groupId": "my-group", "createdAfter": "yesterday"},
+			mockSetup:         func() {},
+			expectResultError: true,
+			errorContains:     "Validation Error",
+		},
+		{
+			name:      "Error - Forbidden (403)",
+			inputArgs: map[string]any{"groupId": "my-group"},
+			mockSetup: func() {
+				mockEpics.EXPECT().
+					ListGroupEpics("my-group", gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 403}}, errors.New("gitlab: 403 Forbidden"))
+			},
+			expectHandlerError: true,
+			errorContains:      "failed to list epics from group",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.inputArgs}})
+
+			if tc.expectHandlerError {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			textContent := getTextResult(t, result)
+			if tc.expectResultError {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.errorContains)
+				return
+			}
+			assert.Contains(t, textContent.Text, tc.expectedText)
+		})
+	}
+}
+
+func TestGetEpicHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetEpic(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockEpics, ctrl := setupMockClientForEpics(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := GetEpic(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockEpics.EXPECT().
+			GetEpic("my-group", int64(3), gomock.Any()).
+			Return(&gl.Epic{ID: 30, IID: 3, Title: "Billing revamp"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+			"epicIid": 3.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"iid":3`)
+	})
+
+	t.Run("Error - Non-integer epicIid", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+			"epicIid": 3.5,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: epicIid 3.5 is not a valid integer")
+	})
+
+	t.Run("Error - Not found (404)", func(t *testing.T) {
+		mockEpics.EXPECT().
+			GetEpic("my-group", int64(99), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+			"epicIid": 99.0,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "epic 99 in group")
+	})
+}
+
+func TestCreateEpicHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := CreateEpic(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockEpics, ctrl := setupMockClientForEpics(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := CreateEpic(mockGetClient, nil)
+
+	t.Run("Success - Dates are sent as fixed dates", func(t *testing.T) {
+		mockEpics.EXPECT().
+			CreateEpic("my-group", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.CreateEpicOptions, _ ...gl.RequestOptionFunc) (*gl.Epic, *gl.Response, error) {
+				assert.Equal(t, "Billing revamp", *opts.Title)
+				assert.Equal(t, "Rework invoices", *opts.Description)
+				assert.Equal(t, gl.LabelOptions{"roadmap"}, *opts.Labels)
+				assert.Equal(t, gl.ISOTime(time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)), *opts.StartDateFixed)
+				assert.True(t, *opts.StartDateIsFixed)
+				assert.Equal(t, gl.ISOTime(time.Date(2026, 9, 30, 0, 0, 0, 0, time.UTC)), *opts.DueDateFixed)
+				assert.True(t, *opts.DueDateIsFixed)
+				return &gl.Epic{ID: 30, IID: 3, Title: "Billing revamp"}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId":     "my-group",
+			"title":       "Billing revamp",
+			"description": "Rework invoices",
+			"labels":      "roadmap",
+			"startDate":   "2026-07-01",
+			"dueDate":     "2026-09-30",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"iid":3`)
+	})
+
+	t.Run("Success - Title only", func(t *testing.T) {
+		mockEpics.EXPECT().
+			CreateEpic("my-group", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.CreateEpicOptions, _ ...gl.RequestOptionFunc) (*gl.Epic, *gl.Response, error) {
+				assert.Nil(t, opts.Description)
+				assert.Nil(t, opts.StartDateIsFixed)
+				assert.Nil(t, opts.DueDateIsFixed)
+				return &gl.Epic{ID: 31, IID: 4, Title: "Small epic"}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+			"title":   "Small epic",
+		}}})
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	t.Run("Error - Invalid startDate", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId":   "my-group",
+			"title":     "Billing revamp",
+			"startDate": "07/01/2026",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: startDate must be in YYYY-MM-DD format")
+	})
+
+	t.Run("Error - Missing title", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: title")
+	})
+}
+
+func TestUpdateEpicHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := UpdateEpic(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockEpics, ctrl := setupMockClientForEpics(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := UpdateEpic(mockGetClient, nil)
+
+	t.Run("Success - Close epic", func(t *testing.T) {
+		mockEpics.EXPECT().
+			UpdateEpic("my-group", int64(3), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ int64, opts *gl.UpdateEpicOptions, _ ...gl.RequestOptionFunc) (*gl.Epic, *gl.Response, error) {
+				assert.Equal(t, "close", *opts.StateEvent)
+				assert.Nil(t, opts.Title)
+				assert.Nil(t, opts.Labels)
+				return &gl.Epic{ID: 30, IID: 3, State: "closed"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId":    "my-group",
+			"epicIid":    3.0,
+			"stateEvent": "close",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"state":"closed"`)
+	})
+
+	t.Run("Error - No fields to update", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+			"epicIid": 3.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: at least one of title, description, labels, startDate, dueDate or stateEvent must be provided")
+	})
+
+	t.Run("Error - Epic not found (404)", func(t *testing.T) {
+		mockEpics.EXPECT().
+			UpdateEpic("my-group", int64(99), gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+			"epicIid": 99.0,
+			"title":   "Renamed",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
+
+func TestListEpicIssuesHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListEpicIssues(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockEpicIssues, ctrl := setupMockClientForEpicIssues(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListEpicIssues(mockGetClient, nil)
+
+	tests := []struct {
+		name               string
+		inputArgs          map[string]any
+		mockSetup          func()
+		expectedText       string
+		expectHandlerError bool
+		expectResultError  bool
+		errorContains      string
+	}{
+		{
+			name:      "Success - List epic issues",
+			inputArgs: map[string]any{"groupId": "my-group", "epicIid": 3.0, "page": 2.0},
+			mockSetup: func() {
+				mockEpicIssues.EXPECT().
+					ListEpicIssues("my-group", int64(3), &gl.ListOptions{Page: 2, PerPage: 20}, gomock.Any()).
+					Return([]*gl.Issue{{ID: 100, IID: 5, Title: "Linked issue", EpicIssueID: 12}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: `"epic_issue_id":12`,
+		},
+		{
+			name:      "Success - Empty list",
+			inputArgs: map[string]any{"groupId": "my-group", "epicIid": 3.0},
+			mockSetup: func() {
+				mockEpicIssues.EXPECT().
+					ListEpicIssues("my-group", int64(3), gomock.Any(), gomock.Any()).
+					Return([]*gl.Issue{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: "[]",
+		},
+		{
+			name:              "Error - Missing epicIid",
+			inputArgs:         map[string]any{"groupId": "my-group"},
+			mockSetup:         func() {},
+			expectResultError: true,
+			errorContains:     "Validation Error: missing required parameter: epicIid",
+		},
+		{
+			name:      "Error - Epic not found (404)",
+			inputArgs: map[string]any{"groupId": "my-group", "epicIid": 99.0},
+			mockSetup: func() {
+				mockEpicIssues.EXPECT().
+					ListEpicIssues("my-group", int64(99), gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+			},
+			expectResultError: true,
+			errorContains:     "not found or access denied",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.inputArgs}})
+
+			if tc.expectHandlerError {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			textContent := getTextResult(t, result)
+			if tc.expectResultError {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.errorContains)
+				return
+			}
+			assert.Contains(t, textContent.Text, tc.expectedText)
+		})
+	}
+}
+
+func TestAddEpicIssueHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := AddEpicIssue(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockEpicIssues, ctrl := setupMockClientForEpicIssues(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := AddEpicIssue(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockEpicIssues.EXPECT().
+			AssignEpicIssue("my-group", int64(3), int64(100), gomock.Any()).
+			Return(&gl.EpicIssueAssignment{ID: 12, Epic: &gl.Epic{IID: 3}, Issue: &gl.Issue{ID: 100}}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+			"epicIid": 3.0,
+			"issueId": 100.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"id":12`)
+	})
+
+	t.Run("Error - Non-integer issueId", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+			"epicIid": 3.0,
+			"issueId": 1.5,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: issueId 1.5 is not a valid integer")
+	})
+
+	t.Run("Error - Issue already assigned (409)", func(t *testing.T) {
+		mockEpicIssues.EXPECT().
+			AssignEpicIssue("my-group", int64(3), int64(100), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 409}}, errors.New("gitlab: 409 Conflict"))
+
+		_, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+			"epicIid": 3.0,
+			"issueId": 100.0,
+		}}})
+		require.Error(t, err)
+	})
+}
+
+func TestRemoveEpicIssueHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := RemoveEpicIssue(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockEpicIssues, ctrl := setupMockClientForEpicIssues(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := RemoveEpicIssue(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockEpicIssues.EXPECT().
+			RemoveEpicIssue("my-group", int64(3), int64(12), gomock.Any()).
+			Return(&gl.EpicIssueAssignment{ID: 12, Issue: &gl.Issue{ID: 100}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId":     "my-group",
+			"epicIid":     3.0,
+			"epicIssueId": 12.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"id":12`)
+	})
+
+	t.Run("Error - Association not found (404)", func(t *testing.T) {
+		mockEpicIssues.EXPECT().
+			RemoveEpicIssue("my-group", int64(3), int64(99), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId":     "my-group",
+			"epicIid":     3.0,
+			"epicIssueId": 99.0,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "epic issue 99")
+	})
+}
+
+func TestListGroupEpicBoardsHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListGroupEpicBoards(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockBoards, ctrl := setupMockClientForGroupEpicBoards(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListGroupEpicBoards(mockGetClient, nil)
+
+	t.Run("Success - Pagination is forwarded", func(t *testing.T) {
+		mockBoards.EXPECT().
+			ListGroupEpicBoards("my-group", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.ListGroupEpicBoardsOptions, _ ...gl.RequestOptionFunc) ([]*gl.GroupEpicBoard, *gl.Response, error) {
+				assert.Equal(t, int64(2), opts.Page)
+				assert.Equal(t, int64(5), opts.PerPage)
+				return []*gl.GroupEpicBoard{{ID: 1, Name: "Roadmap"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId":  "my-group",
+			"page":     2.0,
+			"per_page": 5.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"name":"Roadmap"`)
+	})
+
+	t.Run("Success - Empty list", func(t *testing.T) {
+		mockBoards.EXPECT().
+			ListGroupEpicBoards("my-group", gomock.Any(), gomock.Any()).
+			Return([]*gl.GroupEpicBoard{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+		}}})
+		require.NoError(t, err)
+		assert.Equal(t, "[]", getTextResult(t, result).Text)
+	})
+
+	t.Run("Error - Missing groupId", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: groupId")
+	})
+
+	t.Run("Error - API failure", func(t *testing.T) {
+		mockBoards.EXPECT().
+			ListGroupEpicBoards("my-group", gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 403}}, errors.New("gitlab: 403 Forbidden"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+		}}})
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "failed to list epic boards from group")
+	})
+}
+
+func TestGetGroupEpicBoardHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetGroupEpicBoard(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockBoards, ctrl := setupMockClientForGroupEpicBoards(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := GetGroupEpicBoard(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockBoards.EXPECT().
+			GetGroupEpicBoard("my-group", int64(4), gomock.Any()).
+			Return(&gl.GroupEpicBoard{ID: 4, Name: "Roadmap", Lists: []*gl.BoardList{{ID: 10, Position: 0}}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+			"boardId": 4.0,
+		}}})
+		require.NoError(t, err)
+		text := getTextResult(t, result).Text
+		assert.Contains(t, text, `"id":4`)
+		assert.Contains(t, text, `"lists":[{"id":10`)
+	})
+
+	t.Run("Error - Non-integer boardId", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+			"boardId": 4.5,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: boardId 4.5 is not a valid integer")
+	})
+
+	t.Run("Error - Not found (404)", func(t *testing.T) {
+		mockBoards.EXPECT().
+			GetGroupEpicBoard("my-group", int64(99), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+			"boardId": 99.0,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, `epic board 99 in group "my-group" not found`)
+	})
+}
+
+func TestListEpicBoardListsHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListEpicBoardLists(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+
+	// The epic board lists endpoint is called directly, so serve it from a fake GitLab.
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/groups/my-group/epic_boards/4/lists", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "2", r.URL.Query().Get("page"))
+		assert.Equal(t, "5", r.URL.Query().Get("per_page"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"id":10,"label":{"id":1,"name":"Doing"},"position":0}]`))
+	})
+	mux.HandleFunc("/api/v4/groups/my-group/epic_boards/5/lists", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[]`))
+	})
+	mux.HandleFunc("/api/v4/groups/my-group/epic_boards/99/lists", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"404 Not found"}`))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	client, err := gl.NewClient("x", gl.WithBaseURL(srv.URL))
+	require.NoError(t, err)
+
+	_, handler := ListEpicBoardLists(func(_ context.Context) (*gl.Client, error) {
+		return client, nil
+	}, nil)
+
+	t.Run("Success - Pagination is forwarded", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId":  "my-group",
+			"boardId":  4.0,
+			"page":     2.0,
+			"per_page": 5.0,
+		}}})
+		require.NoError(t, err)
+		text := getTextResult(t, result).Text
+		assert.Contains(t, text, `"id":10`)
+		assert.Contains(t, text, `"name":"Doing"`)
+	})
+
+	t.Run("Success - Empty list", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+			"boardId": 5.0,
+		}}})
+		require.NoError(t, err)
+		assert.Equal(t, "[]", getTextResult(t, result).Text)
+	})
+
+	t.Run("Error - Missing boardId", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: boardId")
+	})
+
+	t.Run("Error - Not found (404)", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+			"boardId": 99.0,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, `epic board 99 in group "my-group" not found`)
+	})
+}
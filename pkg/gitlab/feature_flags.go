@@ -0,0 +1,407 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gl "gitlab.com/gitlab-org/api/client-go"
+)
+
+// withFeatureFlagStrategies adds the strategies array parameter shared by the feature flag write tools
+func withFeatureFlagStrategies() mcp.ToolOption {
+	return mcp.WithArray("strategies",
+		mcp.Description("Rollout strategies of the flag, e.g. [{\"name\": \"flexibleRollout\", \"parameters\": {\"rollout\": \"50\", \"groupId\": \"default\", \"stickiness\": \"default\"}, \"scopes\": [{\"environment_scope\": \"production\"}]}]. "+
+			"Strategy names: default, gradualRolloutUserId, userWithId, gitlabUserList, flexibleRollout. Parameter values are strings."),
+		mcp.Items(map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"id":         map[string]any{"type": "number", "description": "ID of an existing strategy to update (update only)."},
+				"name":       map[string]any{"type": "string", "description": "The strategy name."},
+				"parameters": map[string]any{"type": "object", "description": "Strategy parameters: groupId, userIds, percentage, rollout, stickiness."},
+				"scopes": map[string]any{
+					"type":        "array",
+					"description": "Environment scopes the strategy applies to, e.g. [{\"environment_scope\": \"*\"}].",
+					"items":       map[string]any{"type": "object"},
+				},
+			},
+			"required": []string{"name"},
+		}),
+	)
+}
+
+// parseFeatureFlagStrategies reads an optional array of feature flag strategies (or its JSON string form).
+// Returns nil if the parameter is absent.
+func parseFeatureFlagStrategies(r *mcp.CallToolRequest, p string) (*[]*gl.FeatureFlagStrategyOptions, error) {
+	rawVal, ok := r.GetArguments()[p]
+	if !ok || rawVal == nil {
+		return nil, nil
+	}
+
+	var raw []byte
+	if s, isStr := rawVal.(string); isStr {
+		raw = []byte(s)
+	} else {
+		var err error
+		if raw, err = json.Marshal(rawVal); err != nil {
+			return nil, fmt.Errorf("parameter '%s' must be an array: %w", p, err)
+		}
+	}
+
+	var strategies []*gl.FeatureFlagStrategyOptions
+	if err := json.Unmarshal(raw, &strategies); err != nil {
+		return nil, fmt.Errorf("parameter '%s' must be an array of objects with name, parameters and scopes (parameter values are strings): %w", p, err)
+	}
+
+	for i, strategy := range strategies {
+		if strategy == nil || (strategy.ID == nil && (strategy.Name == nil || *strategy.Name == "")) {
+			return nil, fmt.Errorf("parameter '%s' entry %d must set a name", p, i)
+		}
+	}
+
+	return &strategies, nil
+}
+
+// ListFeatureFlags defines the MCP tool for listing the feature flags of a GitLab project.
+func ListFeatureFlags(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listFeatureFlags",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_FEATURE_FLAGS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Feature Flags",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			// Optional filtering parameters
+			mcp.WithString("scope",
+				mcp.Description("Return only enabled or disabled feature flags."),
+				mcp.Enum("enabled", "disabled"),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional filtering parameters
+			scope, err := OptionalParam[string](&request, "scope")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.ListProjectFeatureFlagOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+			}
+
+			if scope != "" {
+				opts.Scope = &scope
+			}
+
+			// --- Call GitLab API
+			flags, resp, err := glClient.ProjectFeatureFlags.ListProjectFeatureFlags(projectID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, fmt.Sprintf("feature flags from project %q", projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(flags) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(flags)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal feature flags list: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// CreateFeatureFlag defines the MCP tool for creating a feature flag in a GitLab project.
+func CreateFeatureFlag(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"createFeatureFlag",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_CREATE_FEATURE_FLAG_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Create GitLab Feature Flag",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("name",
+				mcp.Description("The name of the feature flag."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithString("description",
+				mcp.Description("The description of the feature flag."),
+			),
+			mcp.WithBoolean("active",
+				mcp.Description("Whether the flag is active. GitLab default: true."),
+			),
+			mcp.WithString("version",
+				mcp.Description("The feature flag version (GitLab default: new_version_flag). legacy_flag is only accepted by old GitLab versions."),
+				mcp.Enum("legacy_flag", "new_version_flag"),
+			),
+			withFeatureFlagStrategies(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			name, err := requiredParam[string](&request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional parameters
+			description, err := OptionalParam[string](&request, "description")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			active, err := OptionalBoolParam(&request, "active")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			version, err := OptionalParam[string](&request, "version")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			strategies, err := parseFeatureFlagStrategies(&request, "strategies")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.CreateProjectFeatureFlagOptions{
+				Name:       &name,
+				Active:     active,
+				Strategies: strategies,
+			}
+
+			if description != "" {
+				opts.Description = &description
+			}
+
+			if version != "" {
+				opts.Version = &version
+			}
+
+			// --- Call GitLab API
+			flag, resp, err := glClient.ProjectFeatureFlags.CreateProjectFeatureFlag(projectID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("project %q", projectID), "create feature flag")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(flag)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal feature flag data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// UpdateFeatureFlag defines the MCP tool for updating a feature flag in a GitLab project.
+func UpdateFeatureFlag(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"updateFeatureFlag",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_UPDATE_FEATURE_FLAG_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Update GitLab Feature Flag",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("name",
+				mcp.Description("The name of the feature flag to update."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithBoolean("active",
+				mcp.Description("Activate or deactivate the flag."),
+			),
+			mcp.WithString("description",
+				mcp.Description("The new description of the feature flag."),
+			),
+			withFeatureFlagStrategies(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			name, err := requiredParam[string](&request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional parameters
+			active, err := OptionalBoolParam(&request, "active")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			description, err := OptionalParam[string](&request, "description")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			strategies, err := parseFeatureFlagStrategies(&request, "strategies")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			if active == nil && description == "" && strategies == nil {
+				return mcp.NewToolResultError("Validation Error: at least one of active, description or strategies must be provided"), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.UpdateProjectFeatureFlagOptions{
+				Active:     active,
+				Strategies: strategies,
+			}
+
+			if description != "" {
+				opts.Description = &description
+			}
+
+			// --- Call GitLab API
+			flag, resp, err := glClient.ProjectFeatureFlags.UpdateProjectFeatureFlag(projectID, name, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("feature flag %q in project %q", name, projectID), "update feature flag")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(flag)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal feature flag data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// DeleteFeatureFlag defines the MCP tool for deleting a feature flag from a GitLab project.
+func DeleteFeatureFlag(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"deleteFeatureFlag",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_DELETE_FEATURE_FLAG_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Delete GitLab Feature Flag",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("name",
+				mcp.Description("The name of the feature flag to delete."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			name, err := requiredParam[string](&request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			resp, err := glClient.ProjectFeatureFlags.DeleteProjectFeatureFlag(projectID, name, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("feature flag %q in project %q", name, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf(`{"message":"Feature flag %q successfully deleted from project %q"}`, name, projectID)), nil
+		}
+}
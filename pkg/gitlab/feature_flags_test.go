@@ -0,0 +1,319 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+func TestListFeatureFlagsHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListFeatureFlags(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockFeatureFlags, ctrl := setupMockClientForFeatureFlags(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListFeatureFlags(mockGetClient, nil)
+
+	tests := []struct {
+		name               string
+		inputArgs          map[string]any
+		mockSetup          func()
+		expectedText       string
+		expectHandlerError bool
+		expectResultError  bool
+		errorContains      string
+	}{
+		{
+			name:      "Success - List enabled flags",
+			inputArgs: map[string]any{"projectId": "group/project", "scope": "enabled"},
+			mockSetup: func() {
+				mockFeatureFlags.EXPECT().
+					ListProjectFeatureFlags("group/project", gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, opts *gl.ListProjectFeatureFlagOptions, _ ...gl.RequestOptionFunc) ([]*gl.ProjectFeatureFlag, *gl.Response, error) {
+						assert.Equal(t, "enabled", *opts.Scope)
+						return []*gl.ProjectFeatureFlag{{Name: "new_checkout", Active: true}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+					})
+			},
+			expectedText: `"name":"new_checkout"`,
+		},
+		{
+			name:      "Success - Empty list",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockFeatureFlags.EXPECT().
+					ListProjectFeatureFlags("group/project", gomock.Any(), gomock.Any()).
+					Return([]*gl.ProjectFeatureFlag{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: "[]",
+		},
+		{
+			name:              "Error - Missing projectId",
+			inputArgs:         map[string]any{},
+			mockSetup:         func() {},
+			expectResultError: true,
+			errorContains:     "Validation Error: missing required parameter: projectId",
+		},
+		{
+			name:      "Error - Forbidden (403)",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockFeatureFlags.EXPECT().
+					ListProjectFeatureFlags("group/project", gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 403}}, errors.New("gitlab: 403 Forbidden"))
+			},
+			expectHandlerError: true,
+			errorContains:      "failed to list feature flags from project",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.inputArgs}})
+
+			if tc.expectHandlerError {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			textContent := getTextResult(t, result)
+			if tc.expectResultError {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.errorContains)
+				return
+			}
+			assert.Contains(t, textContent.Text, tc.expectedText)
+		})
+	}
+}
+
+func TestCreateFeatureFlagHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := CreateFeatureFlag(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockFeatureFlags, ctrl := setupMockClientForFeatureFlags(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := CreateFeatureFlag(mockGetClient, nil)
+
+	t.Run("Success - With strategies", func(t *testing.T) {
+		mockFeatureFlags.EXPECT().
+			CreateProjectFeatureFlag("group/project", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.CreateProjectFeatureFlagOptions, _ ...gl.RequestOptionFunc) (*gl.ProjectFeatureFlag, *gl.Response, error) {
+				assert.Equal(t, "new_checkout", *opts.Name)
+				assert.False(t, *opts.Active)
+				assert.Equal(t, "new_version_flag", *opts.Version)
+				require.NotNil(t, opts.Strategies)
+				require.Len(t, *opts.Strategies, 1)
+				strategy := (*opts.Strategies)[0]
+				assert.Equal(t, "flexibleRollout", *strategy.Name)
+				assert.Equal(t, "25", strategy.Parameters.Rollout)
+				assert.Equal(t, "production", (*strategy.Scopes)[0].EnvironmentScope)
+				return &gl.ProjectFeatureFlag{Name: "new_checkout", Active: false, Version: "new_version_flag"}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"name":      "new_checkout",
+			"active":    false,
+			"version":   "new_version_flag",
+			"strategies": []any{
+				map[string]any{
+					"name":       "flexibleRollout",
+					"parameters": map[string]any{"rollout": "25", "groupId": "default", "stickiness": "default"},
+					"scopes":     []any{map[string]any{"environment_scope": "production"}},
+				},
+			},
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"name":"new_checkout"`)
+	})
+
+	t.Run("Success - Strategies as JSON string", func(t *testing.T) {
+		mockFeatureFlags.EXPECT().
+			CreateProjectFeatureFlag("group/project", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.CreateProjectFeatureFlagOptions, _ ...gl.RequestOptionFunc) (*gl.ProjectFeatureFlag, *gl.Response, error) {
+				assert.Nil(t, opts.Active)
+				assert.Equal(t, "default", *(*opts.Strategies)[0].Name)
+				return &gl.ProjectFeatureFlag{Name: "dark_mode", Active: true}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":  "group/project",
+			"name":       "dark_mode",
+			"strategies": `[{"name":"default","scopes":[{"environment_scope":"*"}]}]`,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"name":"dark_mode"`)
+	})
+
+	t.Run("Error - Strategy without name", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":  "group/project",
+			"name":       "dark_mode",
+			"strategies": []any{map[string]any{"parameters": map[string]any{}}},
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: parameter 'strategies' entry 0 must set a name")
+	})
+
+	t.Run("Error - Numeric strategy parameter", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"name":      "dark_mode",
+			"strategies": []any{map[string]any{
+				"name":       "flexibleRollout",
+				"parameters": map[string]any{"rollout": 25},
+			}},
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "parameter values are strings")
+	})
+
+	t.Run("Error - Missing name", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: name")
+	})
+
+	t.Run("Error - Duplicate name (400)", func(t *testing.T) {
+		mockFeatureFlags.EXPECT().
+			CreateProjectFeatureFlag("group/project", gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 400}}, errors.New("gitlab: 400 Name has already been taken"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"name":      "dark_mode",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
+
+func TestUpdateFeatureFlagHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := UpdateFeatureFlag(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockFeatureFlags, ctrl := setupMockClientForFeatureFlags(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := UpdateFeatureFlag(mockGetClient, nil)
+
+	t.Run("Success - Deactivate", func(t *testing.T) {
+		mockFeatureFlags.EXPECT().
+			UpdateProjectFeatureFlag("group/project", "dark_mode", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ string, opts *gl.UpdateProjectFeatureFlagOptions, _ ...gl.RequestOptionFunc) (*gl.ProjectFeatureFlag, *gl.Response, error) {
+				assert.False(t, *opts.Active)
+				assert.Nil(t, opts.Description)
+				assert.Nil(t, opts.Strategies)
+				return &gl.ProjectFeatureFlag{Name: "dark_mode", Active: false}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"name":      "dark_mode",
+			"active":    false,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"active":false`)
+	})
+
+	t.Run("Error - Nothing to update", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"name":      "dark_mode",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: at least one of active, description or strategies must be provided")
+	})
+
+	t.Run("Error - Not found (404)", func(t *testing.T) {
+		mockFeatureFlags.EXPECT().
+			UpdateProjectFeatureFlag("group/project", "missing", gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":   "group/project",
+			"name":        "missing",
+			"description": "gone",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
+
+func TestDeleteFeatureFlagHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := DeleteFeatureFlag(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockFeatureFlags, ctrl := setupMockClientForFeatureFlags(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := DeleteFeatureFlag(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockFeatureFlags.EXPECT().
+			DeleteProjectFeatureFlag("group/project", "dark_mode", gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 204}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"name":      "dark_mode",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `Feature flag "dark_mode" successfully deleted`)
+	})
+
+	t.Run("Error - Not found (404)", func(t *testing.T) {
+		mockFeatureFlags.EXPECT().
+			DeleteProjectFeatureFlag("group/project", "missing", gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"name":      "missing",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
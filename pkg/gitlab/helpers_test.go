@@ -148,6 +148,30 @@ func setupMockClientForMilestones(t *testing.T) (*gl.Client, *mock_gitlab.MockMi
 	return client, mockMilestones, ctrl
 }
 
+// Helper to create a mock GetClientFn for testing handlers for the GroupMilestones service
+func setupMockClientForGroupMilestones(t *testing.T) (*gl.Client, *mock_gitlab.MockGroupMilestonesServiceInterface, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockGroupMilestones := mock_gitlab.NewMockGroupMilestonesServiceInterface(ctrl)
+
+	client := &gl.Client{
+		GroupMilestones: mockGroupMilestones,
+	}
+
+	return client, mockGroupMilestones, ctrl
+}
+
+// Helper to create a mock GetClientFn for testing handlers for the GroupLabels service
+func setupMockClientForGroupLabels(t *testing.T) (*gl.Client, *mock_gitlab.MockGroupLabelsServiceInterface, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockGroupLabels := mock_gitlab.NewMockGroupLabelsServiceInterface(ctrl)
+
+	client := &gl.Client{
+		GroupLabels: mockGroupLabels,
+	}
+
+	return client, mockGroupLabels, ctrl
+}
+
 // Helper to create a mock GetClientFn for testing handlers for the Search service
 func setupMockClientForSearch(t *testing.T) (*gl.Client, *mock_gitlab.MockSearchServiceInterface, *gomock.Controller) {
 	ctrl := gomock.NewController(t)
@@ -517,6 +541,318 @@ func setupMockClientForReleases(t *testing.T) (*gl.Client, *mock_gitlab.MockRele
 	return client, mockReleases, ctrl
 }
 
+// Helper to create a mock GetClientFn for testing handlers for the ReleaseLinks service
+func setupMockClientForReleaseLinks(t *testing.T) (*gl.Client, *mock_gitlab.MockReleaseLinksServiceInterface, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockReleaseLinks := mock_gitlab.NewMockReleaseLinksServiceInterface(ctrl)
+
+	client := &gl.Client{
+		ReleaseLinks: mockReleaseLinks,
+	}
+
+	return client, mockReleaseLinks, ctrl
+}
+
+// Helper to create a mock GetClientFn for testing handlers for the ProjectVariables service
+func setupMockClientForProjectVariables(t *testing.T) (*gl.Client, *mock_gitlab.MockProjectVariablesServiceInterface, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockProjectVariables := mock_gitlab.NewMockProjectVariablesServiceInterface(ctrl)
+
+	client := &gl.Client{
+		ProjectVariables: mockProjectVariables,
+	}
+
+	return client, mockProjectVariables, ctrl
+}
+
+// Helper to create a mock GetClientFn for testing handlers for the GroupVariables service
+func setupMockClientForGroupVariables(t *testing.T) (*gl.Client, *mock_gitlab.MockGroupVariablesServiceInterface, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockGroupVariables := mock_gitlab.NewMockGroupVariablesServiceInterface(ctrl)
+
+	client := &gl.Client{
+		GroupVariables: mockGroupVariables,
+	}
+
+	return client, mockGroupVariables, ctrl
+}
+
+// Helper to create a mock GetClientFn for testing handlers for the Validate service
+func setupMockClientForValidate(t *testing.T) (*gl.Client, *mock_gitlab.MockValidateServiceInterface, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockValidate := mock_gitlab.NewMockValidateServiceInterface(ctrl)
+
+	client := &gl.Client{
+		Validate: mockValidate,
+	}
+
+	return client, mockValidate, ctrl
+}
+
+// Helper to create a mock GetClientFn for testing handlers for the Environments service
+func setupMockClientForEnvironments(t *testing.T) (*gl.Client, *mock_gitlab.MockEnvironmentsServiceInterface, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockEnvironments := mock_gitlab.NewMockEnvironmentsServiceInterface(ctrl)
+
+	client := &gl.Client{
+		Environments: mockEnvironments,
+	}
+
+	return client, mockEnvironments, ctrl
+}
+
+// Helper to create a mock GetClientFn for testing handlers for the Deployments service
+func setupMockClientForDeployments(t *testing.T) (*gl.Client, *mock_gitlab.MockDeploymentsServiceInterface, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockDeployments := mock_gitlab.NewMockDeploymentsServiceInterface(ctrl)
+
+	client := &gl.Client{
+		Deployments: mockDeployments,
+	}
+
+	return client, mockDeployments, ctrl
+}
+
+// Helper to create a mock GetClientFn for testing handlers for the MergeRequestApprovals service
+func setupMockClientForMergeRequestApprovals(t *testing.T) (*gl.Client, *mock_gitlab.MockMergeRequestApprovalsServiceInterface, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockApprovals := mock_gitlab.NewMockMergeRequestApprovalsServiceInterface(ctrl)
+
+	client := &gl.Client{
+		MergeRequestApprovals: mockApprovals,
+	}
+
+	return client, mockApprovals, ctrl
+}
+
+// Helper to create a mock GetClientFn for testing handlers for the Discussions service
+func setupMockClientForDiscussions(t *testing.T) (*gl.Client, *mock_gitlab.MockDiscussionsServiceInterface, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockDiscussions := mock_gitlab.NewMockDiscussionsServiceInterface(ctrl)
+
+	client := &gl.Client{
+		Discussions: mockDiscussions,
+	}
+
+	return client, mockDiscussions, ctrl
+}
+
+// Helper to create a mock GetClientFn for testing handlers for the Wikis service
+func setupMockClientForWikis(t *testing.T) (*gl.Client, *mock_gitlab.MockWikisServiceInterface, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockWikis := mock_gitlab.NewMockWikisServiceInterface(ctrl)
+
+	client := &gl.Client{
+		Wikis: mockWikis,
+	}
+
+	return client, mockWikis, ctrl
+}
+
+// Helper to create a mock GetClientFn for testing handlers for the ProjectSnippets service
+func setupMockClientForProjectSnippets(t *testing.T) (*gl.Client, *mock_gitlab.MockProjectSnippetsServiceInterface, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockSnippets := mock_gitlab.NewMockProjectSnippetsServiceInterface(ctrl)
+
+	client := &gl.Client{
+		ProjectSnippets: mockSnippets,
+	}
+
+	return client, mockSnippets, ctrl
+}
+
+// Helper to create a mock GetClientFn for testing handlers for the ProjectAccessTokens service
+func setupMockClientForProjectAccessTokens(t *testing.T) (*gl.Client, *mock_gitlab.MockProjectAccessTokensServiceInterface, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockTokens := mock_gitlab.NewMockProjectAccessTokensServiceInterface(ctrl)
+
+	client := &gl.Client{
+		ProjectAccessTokens: mockTokens,
+	}
+
+	return client, mockTokens, ctrl
+}
+
+// Helper to create a mock GetClientFn for testing handlers for the AwardEmoji service
+func setupMockClientForAwardEmoji(t *testing.T) (*gl.Client, *mock_gitlab.MockAwardEmojiServiceInterface, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockAwardEmoji := mock_gitlab.NewMockAwardEmojiServiceInterface(ctrl)
+
+	client := &gl.Client{
+		AwardEmoji: mockAwardEmoji,
+	}
+
+	return client, mockAwardEmoji, ctrl
+}
+
+// Helper to create a mock GetClientFn for testing handlers for the Todos service
+func setupMockClientForTodos(t *testing.T) (*gl.Client, *mock_gitlab.MockTodosServiceInterface, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockTodos := mock_gitlab.NewMockTodosServiceInterface(ctrl)
+
+	client := &gl.Client{
+		Todos: mockTodos,
+	}
+
+	return client, mockTodos, ctrl
+}
+
+// Helper to create a mock GetClientFn for testing handlers for the PipelineSchedules service
+func setupMockClientForPipelineSchedules(t *testing.T) (*gl.Client, *mock_gitlab.MockPipelineSchedulesServiceInterface, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockPipelineSchedules := mock_gitlab.NewMockPipelineSchedulesServiceInterface(ctrl)
+
+	client := &gl.Client{
+		PipelineSchedules: mockPipelineSchedules,
+	}
+
+	return client, mockPipelineSchedules, ctrl
+}
+
+// Helper to create a mock GetClientFn for testing handlers for the Runners service
+func setupMockClientForRunners(t *testing.T) (*gl.Client, *mock_gitlab.MockRunnersServiceInterface, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockRunners := mock_gitlab.NewMockRunnersServiceInterface(ctrl)
+
+	client := &gl.Client{
+		Runners: mockRunners,
+	}
+
+	return client, mockRunners, ctrl
+}
+
+// Helper to create a mock GetClientFn for testing handlers for the IssueBoards service
+func setupMockClientForBoards(t *testing.T) (*gl.Client, *mock_gitlab.MockIssueBoardsServiceInterface, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockBoards := mock_gitlab.NewMockIssueBoardsServiceInterface(ctrl)
+
+	client := &gl.Client{
+		Boards: mockBoards,
+	}
+
+	return client, mockBoards, ctrl
+}
+
+// Helper to create a mock GetClientFn for testing handlers for the Epics service
+func setupMockClientForEpics(t *testing.T) (*gl.Client, *mock_gitlab.MockEpicsServiceInterface, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockEpics := mock_gitlab.NewMockEpicsServiceInterface(ctrl)
+
+	client := &gl.Client{
+		Epics: mockEpics,
+	}
+
+	return client, mockEpics, ctrl
+}
+
+// Helper to create a mock GetClientFn for testing handlers for the EpicIssues service
+func setupMockClientForEpicIssues(t *testing.T) (*gl.Client, *mock_gitlab.MockEpicIssuesServiceInterface, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockEpicIssues := mock_gitlab.NewMockEpicIssuesServiceInterface(ctrl)
+
+	client := &gl.Client{
+		EpicIssues: mockEpicIssues,
+	}
+
+	return client, mockEpicIssues, ctrl
+}
+
+// Helper to create a mock GetClientFn for testing handlers for the GroupEpicBoards service
+func setupMockClientForGroupEpicBoards(t *testing.T) (*gl.Client, *mock_gitlab.MockGroupEpicBoardsServiceInterface, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockGroupEpicBoards := mock_gitlab.NewMockGroupEpicBoardsServiceInterface(ctrl)
+
+	client := &gl.Client{
+		GroupEpicBoards: mockGroupEpicBoards,
+	}
+
+	return client, mockGroupEpicBoards, ctrl
+}
+
+// Helper to create a mock GetClientFn for testing handlers for the ProtectedBranches service
+func setupMockClientForProtectedBranches(t *testing.T) (*gl.Client, *mock_gitlab.MockProtectedBranchesServiceInterface, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockProtectedBranches := mock_gitlab.NewMockProtectedBranchesServiceInterface(ctrl)
+
+	client := &gl.Client{
+		ProtectedBranches: mockProtectedBranches,
+	}
+
+	return client, mockProtectedBranches, ctrl
+}
+
+// Helper to create a mock GetClientFn for testing handlers for the ProtectedTags service
+func setupMockClientForProtectedTags(t *testing.T) (*gl.Client, *mock_gitlab.MockProtectedTagsServiceInterface, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockProtectedTags := mock_gitlab.NewMockProtectedTagsServiceInterface(ctrl)
+
+	client := &gl.Client{
+		ProtectedTags: mockProtectedTags,
+	}
+
+	return client, mockProtectedTags, ctrl
+}
+
+// Helper to create a mock GetClientFn for testing handlers for the DeployKeys service
+func setupMockClientForDeployKeys(t *testing.T) (*gl.Client, *mock_gitlab.MockDeployKeysServiceInterface, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockDeployKeys := mock_gitlab.NewMockDeployKeysServiceInterface(ctrl)
+
+	client := &gl.Client{
+		DeployKeys: mockDeployKeys,
+	}
+
+	return client, mockDeployKeys, ctrl
+}
+
+// Helper to create a mock GetClientFn for testing handlers for the DeployTokens service
+func setupMockClientForDeployTokens(t *testing.T) (*gl.Client, *mock_gitlab.MockDeployTokensServiceInterface, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockDeployTokens := mock_gitlab.NewMockDeployTokensServiceInterface(ctrl)
+
+	client := &gl.Client{
+		DeployTokens: mockDeployTokens,
+	}
+
+	return client, mockDeployTokens, ctrl
+}
+
+// Helper to create a mock GetClientFn for testing handlers for the Packages service
+func setupMockClientForPackages(t *testing.T) (*gl.Client, *mock_gitlab.MockPackagesServiceInterface, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockPackages := mock_gitlab.NewMockPackagesServiceInterface(ctrl)
+
+	client := &gl.Client{
+		Packages: mockPackages,
+	}
+
+	return client, mockPackages, ctrl
+}
+
+// Helper to create a mock GetClientFn for testing handlers for the ContainerRegistry service
+func setupMockClientForContainerRegistry(t *testing.T) (*gl.Client, *mock_gitlab.MockContainerRegistryServiceInterface, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockContainerRegistry := mock_gitlab.NewMockContainerRegistryServiceInterface(ctrl)
+
+	client := &gl.Client{
+		ContainerRegistry: mockContainerRegistry,
+	}
+
+	return client, mockContainerRegistry, ctrl
+}
+
+// Helper to create a mock GetClientFn for testing handlers for the ProjectFeatureFlags service
+func setupMockClientForFeatureFlags(t *testing.T) (*gl.Client, *mock_gitlab.MockProjectFeatureFlagServiceInterface, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockFeatureFlags := mock_gitlab.NewMockProjectFeatureFlagServiceInterface(ctrl)
+
+	client := &gl.Client{
+		ProjectFeatureFlags: mockFeatureFlags,
+	}
+
+	return client, mockFeatureFlags, ctrl
+}
+
 // Helper to create a mock GetClientFn for testing handlers for the Jobs service
 func setupMockClientForJobs(t *testing.T) (*gl.Client, *mock_gitlab.MockJobsServiceInterface, *gomock.Controller) {
 	ctrl := gomock.NewController(t)
@@ -540,3 +876,51 @@ func setupMockClientForPipelines(t *testing.T) (*gl.Client, *mock_gitlab.MockPip
 
 	return client, mockPipelines, ctrl
 }
+
+// Helper to create a mock GetClientFn for testing handlers for the ResourceStateEvents service
+func setupMockClientForResourceStateEvents(t *testing.T) (*gl.Client, *mock_gitlab.MockResourceStateEventsServiceInterface, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockStateEvents := mock_gitlab.NewMockResourceStateEventsServiceInterface(ctrl)
+
+	client := &gl.Client{
+		ResourceStateEvents: mockStateEvents,
+	}
+
+	return client, mockStateEvents, ctrl
+}
+
+// Helper to create a mock GetClientFn for testing handlers for the ResourceLabelEvents service
+func setupMockClientForResourceLabelEvents(t *testing.T) (*gl.Client, *mock_gitlab.MockResourceLabelEventsServiceInterface, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockLabelEvents := mock_gitlab.NewMockResourceLabelEventsServiceInterface(ctrl)
+
+	client := &gl.Client{
+		ResourceLabelEvents: mockLabelEvents,
+	}
+
+	return client, mockLabelEvents, ctrl
+}
+
+// Helper to create a mock GetClientFn for testing handlers for the ResourceMilestoneEvents service
+func setupMockClientForResourceMilestoneEvents(t *testing.T) (*gl.Client, *mock_gitlab.MockResourceMilestoneEventsServiceInterface, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockMilestoneEvents := mock_gitlab.NewMockResourceMilestoneEventsServiceInterface(ctrl)
+
+	client := &gl.Client{
+		ResourceMilestoneEvents: mockMilestoneEvents,
+	}
+
+	return client, mockMilestoneEvents, ctrl
+}
+
+// Helper to create a mock GetClientFn for testing handlers for the Namespaces service
+func setupMockClientForNamespaces(t *testing.T) (*gl.Client, *mock_gitlab.MockNamespacesServiceInterface, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mockNamespaces := mock_gitlab.NewMockNamespacesServiceInterface(ctrl)
+
+	client := &gl.Client{
+		Namespaces: mockNamespaces,
+	}
+
+	return client, mockNamespaces, ctrl
+}
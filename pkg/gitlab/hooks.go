@@ -0,0 +1,268 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gl "gitlab.com/gitlab-org/api/client-go"
+)
+
+// ListProjectHooks defines the MCP tool for listing webhooks configured on a GitLab project.
+func ListProjectHooks(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listProjectHooks",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_PROJECT_HOOKS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Project Webhooks",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			opts := &gl.ListProjectHooksOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+			}
+			hooks, resp, err := glClient.Projects.ListProjectHooks(projectID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, fmt.Sprintf("hooks from project %q", projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(hooks) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(hooks)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal project hooks list: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// CreateProjectHook defines the MCP tool for adding a webhook to a GitLab project.
+func CreateProjectHook(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"createProjectHook",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_CREATE_PROJECT_HOOK_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Create GitLab Project Webhook",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("url",
+				mcp.Description("The URL the webhook will POST events to."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithString("token",
+				mcp.Description("Secret token sent in the X-Gitlab-Token header to validate received payloads."),
+			),
+			mcp.WithBoolean("pushEvents",
+				mcp.Description("Trigger the hook on push events (GitLab default: true)."),
+			),
+			mcp.WithBoolean("tagPushEvents",
+				mcp.Description("Trigger the hook on tag push events."),
+			),
+			mcp.WithBoolean("mergeRequestsEvents",
+				mcp.Description("Trigger the hook on merge request events."),
+			),
+			mcp.WithBoolean("issuesEvents",
+				mcp.Description("Trigger the hook on issue events."),
+			),
+			mcp.WithBoolean("noteEvents",
+				mcp.Description("Trigger the hook on comment events."),
+			),
+			mcp.WithBoolean("pipelineEvents",
+				mcp.Description("Trigger the hook on pipeline events."),
+			),
+			mcp.WithBoolean("jobEvents",
+				mcp.Description("Trigger the hook on job events."),
+			),
+			mcp.WithBoolean("releasesEvents",
+				mcp.Description("Trigger the hook on release events."),
+			),
+			mcp.WithBoolean("wikiPageEvents",
+				mcp.Description("Trigger the hook on wiki page events."),
+			),
+			mcp.WithBoolean("enableSslVerification",
+				mcp.Description("Verify the SSL certificate of the hook URL (GitLab default: true)."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			url, err := requiredParam[string](&request, "url")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional parameters
+			token, err := OptionalParam[string](&request, "token")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			opts := &gl.AddProjectHookOptions{
+				URL: &url,
+			}
+
+			if token != "" {
+				opts.Token = &token
+			}
+
+			// Boolean flags are only sent when given, so GitLab's defaults apply otherwise
+			boolParams := []struct {
+				name   string
+				target **bool
+			}{
+				{"pushEvents", &opts.PushEvents},
+				{"tagPushEvents", &opts.TagPushEvents},
+				{"mergeRequestsEvents", &opts.MergeRequestsEvents},
+				{"issuesEvents", &opts.IssuesEvents},
+				{"noteEvents", &opts.NoteEvents},
+				{"pipelineEvents", &opts.PipelineEvents},
+				{"jobEvents", &opts.JobEvents},
+				{"releasesEvents", &opts.ReleasesEvents},
+				{"wikiPageEvents", &opts.WikiPageEvents},
+				{"enableSslVerification", &opts.EnableSSLVerification},
+			}
+			for _, p := range boolParams {
+				value, err := OptionalBoolParam(&request, p.name)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+				}
+				*p.target = value
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			hook, resp, err := glClient.Projects.AddProjectHook(projectID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("project %q", projectID), "create project hook")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(hook)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal project hook data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// DeleteProjectHook defines the MCP tool for removing a webhook from a GitLab project.
+func DeleteProjectHook(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"deleteProjectHook",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_DELETE_PROJECT_HOOK_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Delete GitLab Project Webhook",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("hookId",
+				mcp.Description("The ID of the webhook to delete."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			hookIDFloat, err := requiredParam[float64](&request, "hookId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			hookID := int64(hookIDFloat)
+			if float64(hookID) != hookIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: hookId %v is not a valid integer", hookIDFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			resp, err := glClient.Projects.DeleteProjectHook(projectID, hookID, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("hook %d in project %q", hookID, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf(`{"message":"Hook %d successfully deleted from project %q"}`, hookID, projectID)), nil
+		}
+}
@@ -0,0 +1,206 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+func TestListProjectHooksHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListProjectHooks(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockProjects, ctrl := setupMockClient(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListProjectHooks(mockGetClient, nil)
+
+	tests := []struct {
+		name               string
+		inputArgs          map[string]any
+		mockSetup          func()
+		expectedText       string
+		expectHandlerError bool
+		expectResultError  bool
+		errorContains      string
+	}{
+		{
+			name:      "Success - List hooks",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockProjects.EXPECT().
+					ListProjectHooks("group/project", gomock.Any(), gomock.Any()).
+					Return([]*gl.ProjectHook{{ID: 1, URL: "https://example.com/hook", PushEvents: true}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: `"url":"https://example.com/hook"`,
+		},
+		{
+			name:      "Success - Empty list",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockProjects.EXPECT().
+					ListProjectHooks("group/project", gomock.Any(), gomock.Any()).
+					Return([]*gl.ProjectHook{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: "[]",
+		},
+		{
+			name:              "Error - Missing projectId",
+			inputArgs:         map[string]any{},
+			mockSetup:         func() {},
+			expectResultError: true,
+			errorContains:     "Validation Error: missing required parameter: projectId",
+		},
+		{
+			name:      "Error - Forbidden (403)",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockProjects.EXPECT().
+					ListProjectHooks("group/project", gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 403}}, errors.New("gitlab: 403 Forbidden"))
+			},
+			expectHandlerError: true,
+			errorContains:      "failed to list hooks from project",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.inputArgs}})
+
+			if tc.expectHandlerError {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			textContent := getTextResult(t, result)
+			if tc.expectResultError {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.errorContains)
+				return
+			}
+			assert.Contains(t, textContent.Text, tc.expectedText)
+		})
+	}
+}
+
+func TestCreateProjectHookHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := CreateProjectHook(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockProjects, ctrl := setupMockClient(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := CreateProjectHook(mockGetClient, nil)
+
+	t.Run("Success - Only given events are sent", func(t *testing.T) {
+		mockProjects.EXPECT().
+			AddProjectHook("group/project", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.AddProjectHookOptions, _ ...gl.RequestOptionFunc) (*gl.ProjectHook, *gl.Response, error) {
+				assert.Equal(t, "https://example.com/hook", *opts.URL)
+				assert.Equal(t, "s3cret", *opts.Token)
+				assert.False(t, *opts.PushEvents)
+				assert.True(t, *opts.MergeRequestsEvents)
+				assert.False(t, *opts.EnableSSLVerification)
+				assert.Nil(t, opts.IssuesEvents)
+				assert.Nil(t, opts.PipelineEvents)
+				return &gl.ProjectHook{ID: 7, URL: "https://example.com/hook", MergeRequestsEvents: true}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":             "group/project",
+			"url":                   "https://example.com/hook",
+			"token":                 "s3cret",
+			"pushEvents":            false,
+			"mergeRequestsEvents":   true,
+			"enableSslVerification": false,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"id":7`)
+	})
+
+	t.Run("Error - Missing url", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: url")
+	})
+
+	t.Run("Error - Invalid URL (422)", func(t *testing.T) {
+		mockProjects.EXPECT().
+			AddProjectHook("group/project", gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 422}}, errors.New("gitlab: 422 Invalid url given"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"url":       "not-a-url",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
+
+func TestDeleteProjectHookHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := DeleteProjectHook(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockProjects, ctrl := setupMockClient(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := DeleteProjectHook(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockProjects.EXPECT().
+			DeleteProjectHook("group/project", int64(7), gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 204}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"hookId":    7.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Hook 7 successfully deleted")
+	})
+
+	t.Run("Error - Non-integer hookId", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"hookId":    7.5,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: hookId 7.5 is not a valid integer")
+	})
+}
@@ -0,0 +1,427 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gl "gitlab.com/gitlab-org/api/client-go"
+)
+
+// updateIssue applies opts to an issue and returns the updated issue. It is shared by the
+// tools that make a single kind of change to an issue; operation describes that change in
+// error messages, e.g. "add issue labels".
+func updateIssue(ctx context.Context, getClient GetClientFn, projectID string, issueIid int64, opts *gl.UpdateIssueOptions, operation string) (*mcp.CallToolResult, error) {
+	// --- Obtain GitLab client
+	glClient, err := getClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+	}
+
+	// --- Call GitLab API
+	issue, resp, err := glClient.Issues.UpdateIssue(projectID, issueIid, opts, gl.WithContext(ctx))
+
+	// --- Handle API errors
+	if err != nil {
+		result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("issue %d in project %q", issueIid, projectID), operation)
+		if result != nil {
+			return result, nil
+		}
+		return nil, apiErr
+	}
+
+	// --- Marshal and return success
+	data, err := json.Marshal(issue)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal issue data: %w", err)
+	}
+	return mcp.NewToolResultText(string(data)), nil
+}
+
+// AddIssueLabels defines the MCP tool for adding labels to an issue without touching its
+// other labels.
+func AddIssueLabels(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"addIssueLabels",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_ADD_ISSUE_LABELS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Add Labels to GitLab Issue",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("issueIid",
+				mcp.Description("The IID (internal ID, integer) of the issue within the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("labels",
+				mcp.Description("Comma-separated list of label names to add to the issue."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			issueIidFloat, err := requiredParam[float64](&request, "issueIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			issueIid := int64(issueIidFloat)
+			if float64(issueIid) != issueIidFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: issueIid %v is not a valid integer", issueIidFloat)), nil
+			}
+
+			labelsStr, err := requiredParam[string](&request, "labels")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			labels, err := ParseLabelString(labelsStr)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			if labels == nil {
+				return mcp.NewToolResultError("Validation Error: labels must contain at least one label"), nil
+			}
+
+			// --- Call GitLab API
+			return updateIssue(ctx, getClient, projectID, issueIid, &gl.UpdateIssueOptions{AddLabels: labels}, "add issue labels")
+		}
+}
+
+// RemoveIssueLabels defines the MCP tool for removing labels from an issue without touching
+// its other labels.
+func RemoveIssueLabels(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"removeIssueLabels",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_REMOVE_ISSUE_LABELS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Remove Labels from GitLab Issue",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("issueIid",
+				mcp.Description("The IID (internal ID, integer) of the issue within the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("labels",
+				mcp.Description("Comma-separated list of label names to remove from the issue."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			issueIidFloat, err := requiredParam[float64](&request, "issueIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			issueIid := int64(issueIidFloat)
+			if float64(issueIid) != issueIidFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: issueIid %v is not a valid integer", issueIidFloat)), nil
+			}
+
+			labelsStr, err := requiredParam[string](&request, "labels")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			labels, err := ParseLabelString(labelsStr)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			if labels == nil {
+				return mcp.NewToolResultError("Validation Error: labels must contain at least one label"), nil
+			}
+
+			// --- Call GitLab API
+			return updateIssue(ctx, getClient, projectID, issueIid, &gl.UpdateIssueOptions{RemoveLabels: labels}, "remove issue labels")
+		}
+}
+
+// SetIssueMilestone defines the MCP tool for assigning an issue to a milestone.
+func SetIssueMilestone(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"setIssueMilestone",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_SET_ISSUE_MILESTONE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Set GitLab Issue Milestone",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("issueIid",
+				mcp.Description("The IID (internal ID, integer) of the issue within the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("milestoneId",
+				mcp.Description("The ID (not the IID) of the milestone to assign the issue to."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			issueIidFloat, err := requiredParam[float64](&request, "issueIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			issueIid := int64(issueIidFloat)
+			if float64(issueIid) != issueIidFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: issueIid %v is not a valid integer", issueIidFloat)), nil
+			}
+
+			milestoneIDFloat, err := requiredParam[float64](&request, "milestoneId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			milestoneID, err := ValidateAndConvertMilestoneID(milestoneIDFloat)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Call GitLab API
+			return updateIssue(ctx, getClient, projectID, issueIid, &gl.UpdateIssueOptions{MilestoneID: gl.Ptr(int64(milestoneID))}, "set issue milestone")
+		}
+}
+
+// ClearIssueMilestone defines the MCP tool for removing an issue from its milestone.
+func ClearIssueMilestone(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"clearIssueMilestone",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_CLEAR_ISSUE_MILESTONE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Clear GitLab Issue Milestone",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("issueIid",
+				mcp.Description("The IID (internal ID, integer) of the issue within the project."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			issueIidFloat, err := requiredParam[float64](&request, "issueIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			issueIid := int64(issueIidFloat)
+			if float64(issueIid) != issueIidFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: issueIid %v is not a valid integer", issueIidFloat)), nil
+			}
+
+			// --- Call GitLab API
+			// A milestone ID of 0 unassigns the milestone
+			return updateIssue(ctx, getClient, projectID, issueIid, &gl.UpdateIssueOptions{MilestoneID: gl.Ptr(int64(0))}, "clear issue milestone")
+		}
+}
+
+// SetIssueAssignees defines the MCP tool for replacing the assignees of an issue.
+func SetIssueAssignees(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"setIssueAssignees",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_SET_ISSUE_ASSIGNEES_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Set GitLab Issue Assignees",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("issueIid",
+				mcp.Description("The IID (internal ID, integer) of the issue within the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("assigneeIds",
+				mcp.Description("Comma-separated list of user IDs to assign. They replace the current assignees."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			issueIidFloat, err := requiredParam[float64](&request, "issueIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			issueIid := int64(issueIidFloat)
+			if float64(issueIid) != issueIidFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: issueIid %v is not a valid integer", issueIidFloat)), nil
+			}
+
+			assigneeIDsStr, err := requiredParam[string](&request, "assigneeIds")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			assigneeIDs, err := ParseIDListString(assigneeIDsStr, "assignee")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			if assigneeIDs == nil {
+				return mcp.NewToolResultError("Validation Error: assigneeIds must contain at least one user ID; use clearIssueAssignees to unassign everyone"), nil
+			}
+
+			// --- Call GitLab API
+			return updateIssue(ctx, getClient, projectID, issueIid, &gl.UpdateIssueOptions{AssigneeIDs: assigneeIDs}, "set issue assignees")
+		}
+}
+
+// ClearIssueAssignees defines the MCP tool for unassigning everyone from an issue.
+func ClearIssueAssignees(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"clearIssueAssignees",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_CLEAR_ISSUE_ASSIGNEES_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Clear GitLab Issue Assignees",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("issueIid",
+				mcp.Description("The IID (internal ID, integer) of the issue within the project."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			issueIidFloat, err := requiredParam[float64](&request, "issueIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			issueIid := int64(issueIidFloat)
+			if float64(issueIid) != issueIidFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: issueIid %v is not a valid integer", issueIidFloat)), nil
+			}
+
+			// --- Call GitLab API
+			// An empty list of assignee IDs unassigns everyone
+			return updateIssue(ctx, getClient, projectID, issueIid, &gl.UpdateIssueOptions{AssigneeIDs: &[]int64{}}, "clear issue assignees")
+		}
+}
+
+// CloseIssue defines the MCP tool for closing an issue.
+func CloseIssue(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"closeIssue",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_CLOSE_ISSUE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Close GitLab Issue",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("issueIid",
+				mcp.Description("The IID (internal ID, integer) of the issue within the project."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			issueIidFloat, err := requiredParam[float64](&request, "issueIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			issueIid := int64(issueIidFloat)
+			if float64(issueIid) != issueIidFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: issueIid %v is not a valid integer", issueIidFloat)), nil
+			}
+
+			// --- Call GitLab API
+			return updateIssue(ctx, getClient, projectID, issueIid, &gl.UpdateIssueOptions{StateEvent: gl.Ptr("close")}, "close issue")
+		}
+}
+
+// ReopenIssue defines the MCP tool for reopening an issue.
+func ReopenIssue(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"reopenIssue",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_REOPEN_ISSUE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Reopen GitLab Issue",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("issueIid",
+				mcp.Description("The IID (internal ID, integer) of the issue within the project."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			issueIidFloat, err := requiredParam[float64](&request, "issueIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			issueIid := int64(issueIidFloat)
+			if float64(issueIid) != issueIidFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: issueIid %v is not a valid integer", issueIidFloat)), nil
+			}
+
+			// --- Call GitLab API
+			return updateIssue(ctx, getClient, projectID, issueIid, &gl.UpdateIssueOptions{StateEvent: gl.Ptr("reopen")}, "reopen issue")
+		}
+}
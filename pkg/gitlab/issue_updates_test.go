@@ -0,0 +1,308 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	mock_gitlab "gitlab.com/gitlab-org/api/client-go/testing"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+// issueUpdateTestCase describes a call of a tool that makes a single change to issue 5 of
+// "group/project".
+type issueUpdateTestCase struct {
+	name            string
+	args            map[string]any
+	setupMock       func(*mock_gitlab.MockIssuesServiceInterface)
+	expectErr       bool // a Go error rather than a tool error result is expected
+	expectResultErr bool
+	expectedText    []string // substrings of the result text, or of the error
+}
+
+// runIssueUpdateTests runs test cases against the tool built by newTool. The projectId and
+// issueIid arguments are added to the arguments of every case.
+func runIssueUpdateTests(t *testing.T, newTool func(GetClientFn, map[string]string) (mcp.Tool, server.ToolHandlerFunc), tests []issueUpdateTestCase) {
+	t.Helper()
+
+	tool, _ := newTool(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockClient, mockIssues, ctrl := setupMockClientForIssues(t)
+			defer ctrl.Finish()
+
+			_, handler := newTool(func(_ context.Context) (*gl.Client, error) {
+				return mockClient, nil
+			}, nil)
+
+			if tc.setupMock != nil {
+				tc.setupMock(mockIssues)
+			}
+
+			args := map[string]any{
+				"projectId": "group/project",
+				"issueIid":  5.0,
+			}
+			for k, v := range tc.args {
+				args[k] = v
+			}
+
+			result, err := handler(ctx, *createMCPRequest(args))
+			if tc.expectErr {
+				require.Error(t, err)
+				for _, expected := range tc.expectedText {
+					assert.Contains(t, err.Error(), expected)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectResultErr, result.IsError)
+			for _, expected := range tc.expectedText {
+				assert.Contains(t, getTextResult(t, result).Text, expected)
+			}
+		})
+	}
+}
+
+func TestAddIssueLabelsHandler(t *testing.T) {
+	runIssueUpdateTests(t, AddIssueLabels, []issueUpdateTestCase{
+		{
+			name: "Success",
+			args: map[string]any{"labels": "bug, urgent,"},
+			setupMock: func(m *mock_gitlab.MockIssuesServiceInterface) {
+				m.EXPECT().UpdateIssue("group/project", int64(5), &gl.UpdateIssueOptions{
+					AddLabels: &gl.LabelOptions{"bug", "urgent"},
+				}, gomock.Any()).Return(&gl.Issue{IID: 5, Labels: gl.Labels{"bug", "docs", "urgent"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: []string{`"iid":5`, `"labels":["bug","docs","urgent"]`},
+		},
+		{
+			name:            "Missing labels",
+			expectResultErr: true,
+			expectedText:    []string{"Validation Error: missing required parameter: labels"},
+		},
+		{
+			name:            "Only separators",
+			args:            map[string]any{"labels": " , "},
+			expectResultErr: true,
+			expectedText:    []string{"Validation Error: labels must contain at least one label"},
+		},
+		{
+			name: "Issue not found",
+			args: map[string]any{"labels": "bug"},
+			setupMock: func(m *mock_gitlab.MockIssuesServiceInterface) {
+				m.EXPECT().UpdateIssue("group/project", int64(5), gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+			},
+			expectResultErr: true,
+			expectedText:    []string{`issue 5 in project "group/project" not found or access denied (404)`},
+		},
+	})
+}
+
+func TestRemoveIssueLabelsHandler(t *testing.T) {
+	runIssueUpdateTests(t, RemoveIssueLabels, []issueUpdateTestCase{
+		{
+			name: "Success",
+			args: map[string]any{"labels": "urgent"},
+			setupMock: func(m *mock_gitlab.MockIssuesServiceInterface) {
+				m.EXPECT().UpdateIssue("group/project", int64(5), &gl.UpdateIssueOptions{
+					RemoveLabels: &gl.LabelOptions{"urgent"},
+				}, gomock.Any()).Return(&gl.Issue{IID: 5, Labels: gl.Labels{"bug"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: []string{`"iid":5`, `"labels":["bug"]`},
+		},
+		{
+			name:            "Invalid issueIid",
+			args:            map[string]any{"issueIid": 5.5, "labels": "urgent"},
+			expectResultErr: true,
+			expectedText:    []string{"Validation Error: issueIid 5.5 is not a valid integer"},
+		},
+		{
+			name: "Server error",
+			args: map[string]any{"labels": "urgent"},
+			setupMock: func(m *mock_gitlab.MockIssuesServiceInterface) {
+				m.EXPECT().UpdateIssue("group/project", int64(5), gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 500}}, errors.New("gitlab: 500 Internal Server Error"))
+			},
+			expectErr:    true,
+			expectedText: []string{`failed to remove issue labels issue 5 in project "group/project"`},
+		},
+	})
+}
+
+func TestSetIssueMilestoneHandler(t *testing.T) {
+	runIssueUpdateTests(t, SetIssueMilestone, []issueUpdateTestCase{
+		{
+			name: "Success",
+			args: map[string]any{"milestoneId": 12.0},
+			setupMock: func(m *mock_gitlab.MockIssuesServiceInterface) {
+				m.EXPECT().UpdateIssue("group/project", int64(5), &gl.UpdateIssueOptions{
+					MilestoneID: gl.Ptr(int64(12)),
+				}, gomock.Any()).Return(&gl.Issue{IID: 5, Milestone: &gl.Milestone{ID: 12, Title: "v1.0"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: []string{`"iid":5`, `"title":"v1.0"`},
+		},
+		{
+			name:            "Missing milestoneId",
+			expectResultErr: true,
+			expectedText:    []string{"Validation Error: missing required parameter: milestoneId"},
+		},
+		{
+			name:            "Invalid milestoneId",
+			args:            map[string]any{"milestoneId": 1.5},
+			expectResultErr: true,
+			expectedText:    []string{"Validation Error: milestoneId 1.5 is not a valid integer"},
+		},
+		{
+			name: "Unknown milestone",
+			args: map[string]any{"milestoneId": 99.0},
+			setupMock: func(m *mock_gitlab.MockIssuesServiceInterface) {
+				m.EXPECT().UpdateIssue("group/project", int64(5), gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 422}}, errors.New("gitlab: 422 Unprocessable Entity"))
+			},
+			expectResultErr: true,
+			expectedText:    []string{"failed to set issue milestone", "(status: 422)"},
+		},
+	})
+}
+
+func TestClearIssueMilestoneHandler(t *testing.T) {
+	runIssueUpdateTests(t, ClearIssueMilestone, []issueUpdateTestCase{
+		{
+			name: "Success",
+			setupMock: func(m *mock_gitlab.MockIssuesServiceInterface) {
+				m.EXPECT().UpdateIssue("group/project", int64(5), &gl.UpdateIssueOptions{
+					MilestoneID: gl.Ptr(int64(0)),
+				}, gomock.Any()).Return(&gl.Issue{IID: 5}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: []string{`"iid":5`, `"milestone":null`},
+		},
+		{
+			name: "Issue not found",
+			setupMock: func(m *mock_gitlab.MockIssuesServiceInterface) {
+				m.EXPECT().UpdateIssue("group/project", int64(5), gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+			},
+			expectResultErr: true,
+			expectedText:    []string{`issue 5 in project "group/project" not found or access denied (404)`},
+		},
+	})
+}
+
+func TestSetIssueAssigneesHandler(t *testing.T) {
+	runIssueUpdateTests(t, SetIssueAssignees, []issueUpdateTestCase{
+		{
+			name: "Success",
+			args: map[string]any{"assigneeIds": "3, 7"},
+			setupMock: func(m *mock_gitlab.MockIssuesServiceInterface) {
+				m.EXPECT().UpdateIssue("group/project", int64(5), &gl.UpdateIssueOptions{
+					AssigneeIDs: &[]int64{3, 7},
+				}, gomock.Any()).Return(&gl.Issue{IID: 5, Assignees: []*gl.IssueAssignee{{ID: 3}, {ID: 7}}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: []string{`"iid":5`, `"assignees":[{"id":3`},
+		},
+		{
+			name:            "Missing assigneeIds",
+			expectResultErr: true,
+			expectedText:    []string{"Validation Error: missing required parameter: assigneeIds"},
+		},
+		{
+			name:            "Invalid assignee ID",
+			args:            map[string]any{"assigneeIds": "3,alice"},
+			expectResultErr: true,
+			expectedText:    []string{`Validation Error: invalid assignee ID "alice"`},
+		},
+		{
+			name:            "Only separators",
+			args:            map[string]any{"assigneeIds": ","},
+			expectResultErr: true,
+			expectedText:    []string{"Validation Error: assigneeIds must contain at least one user ID"},
+		},
+	})
+}
+
+func TestClearIssueAssigneesHandler(t *testing.T) {
+	runIssueUpdateTests(t, ClearIssueAssignees, []issueUpdateTestCase{
+		{
+			name: "Success",
+			setupMock: func(m *mock_gitlab.MockIssuesServiceInterface) {
+				m.EXPECT().UpdateIssue("group/project", int64(5), &gl.UpdateIssueOptions{
+					AssigneeIDs: &[]int64{},
+				}, gomock.Any()).Return(&gl.Issue{IID: 5}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: []string{`"iid":5`},
+		},
+		{
+			name: "Unauthorized",
+			setupMock: func(m *mock_gitlab.MockIssuesServiceInterface) {
+				m.EXPECT().UpdateIssue("group/project", int64(5), gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 401}}, errors.New("gitlab: 401 Unauthorized"))
+			},
+			expectResultErr: true,
+		},
+	})
+}
+
+func TestCloseIssueHandler(t *testing.T) {
+	runIssueUpdateTests(t, CloseIssue, []issueUpdateTestCase{
+		{
+			name: "Success",
+			setupMock: func(m *mock_gitlab.MockIssuesServiceInterface) {
+				m.EXPECT().UpdateIssue("group/project", int64(5), &gl.UpdateIssueOptions{
+					StateEvent: gl.Ptr("close"),
+				}, gomock.Any()).Return(&gl.Issue{IID: 5, State: "closed"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: []string{`"iid":5`, `"state":"closed"`},
+		},
+		{
+			name:            "Non-numeric issueIid",
+			args:            map[string]any{"issueIid": "five"},
+			expectResultErr: true,
+			expectedText:    []string{"Validation Error: parameter 'issueIid' is not of expected type float64"},
+		},
+		{
+			name: "Issue not found",
+			setupMock: func(m *mock_gitlab.MockIssuesServiceInterface) {
+				m.EXPECT().UpdateIssue("group/project", int64(5), gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+			},
+			expectResultErr: true,
+			expectedText:    []string{`issue 5 in project "group/project" not found or access denied (404)`},
+		},
+	})
+}
+
+func TestReopenIssueHandler(t *testing.T) {
+	runIssueUpdateTests(t, ReopenIssue, []issueUpdateTestCase{
+		{
+			name: "Success",
+			setupMock: func(m *mock_gitlab.MockIssuesServiceInterface) {
+				m.EXPECT().UpdateIssue("group/project", int64(5), &gl.UpdateIssueOptions{
+					StateEvent: gl.Ptr("reopen"),
+				}, gomock.Any()).Return(&gl.Issue{IID: 5, State: "opened"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: []string{`"iid":5`, `"state":"opened"`},
+		},
+		{
+			name: "Server error",
+			setupMock: func(m *mock_gitlab.MockIssuesServiceInterface) {
+				m.EXPECT().UpdateIssue("group/project", int64(5), gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 500}}, errors.New("gitlab: 500 Internal Server Error"))
+			},
+			expectErr:    true,
+			expectedText: []string{`failed to reopen issue issue 5 in project "group/project"`},
+		},
+	})
+}
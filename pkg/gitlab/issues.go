@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 
 	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -11,12 +12,32 @@ import (
 	gl "gitlab.com/gitlab-org/api/client-go"
 )
 
+// optionalIssueWeight reads an optional issue weight. Returns nil if the parameter is absent
+// and an error unless the value is a non-negative integer.
+func optionalIssueWeight(r *mcp.CallToolRequest, p string) (*int64, error) {
+	if _, ok := r.GetArguments()[p]; !ok {
+		return nil, nil
+	}
+
+	weightFloat, err := OptionalParam[float64](r, p)
+	if err != nil {
+		return nil, err
+	}
+
+	weight := int64(weightFloat)
+	if float64(weight) != weightFloat || weight < 0 {
+		return nil, fmt.Errorf("%s %v must be a non-negative integer", p, weightFloat)
+	}
+	return &weight, nil
+}
+
 // func getIssueTool(getClient GetClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
 func GetIssue(getClient GetClientFn, t map[string]string) (mcp.Tool, server.ToolHandlerFunc) { // Simplified for now
 	return mcp.NewTool(
 			"getIssue",
 
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_ISSUE_DESCRIPTION)),
+			WithGitLabServer(),
 			// Use WithString, WithNumber for parameters
 			mcp.WithString("projectId",
 				// t("mcp_gitlab_getIssue.projectId.description", "The ID (integer) or URL-encoded path (string) of the project."),
@@ -28,6 +49,7 @@ func GetIssue(getClient GetClientFn, t map[string]string) (mcp.Tool, server.Tool
 				mcp.Description("The IID (internal ID, integer) of the issue within the project."),
 				mcp.Required(), // Correct usage
 			),
+			WithFields(),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        "Get GitLab Issue", // Add title
 				ReadOnlyHint: boolPtr(true),
@@ -61,6 +83,11 @@ func GetIssue(getClient GetClientFn, t map[string]string) (mcp.Tool, server.Tool
 				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: issueIid %v is not a valid integer", issueIidFloat)), nil
 			}
 
+			fields, err := OptionalFieldsParam(&req)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
 			// Call GitLab API using alias 'gl' and passing context
 			issue, resp, err := client.Issues.GetIssue(projectID, issueIid, nil, gl.WithContext(ctx))
 
@@ -79,6 +106,10 @@ func GetIssue(getClient GetClientFn, t map[string]string) (mcp.Tool, server.Tool
 				// Return internal error using fmt.Errorf
 				return nil, fmt.Errorf("failed to marshal issue data: %w", err)
 			}
+			jsonData, err = filterFields(jsonData, fields)
+			if err != nil {
+				return nil, fmt.Errorf("failed to filter issue fields: %w", err)
+			}
 			// Use NewToolResultText
 			return mcp.NewToolResultText(string(jsonData)), nil
 		}
@@ -91,6 +122,7 @@ func ListIssues(getClient GetClientFn, t map[string]string) (tool mcp.Tool, hand
 	return mcp.NewTool(
 			"listIssues",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_ISSUES_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        "List GitLab Issues",
 				ReadOnlyHint: boolPtr(true),
@@ -144,6 +176,7 @@ func ListIssues(getClient GetClientFn, t map[string]string) (tool mcp.Tool, hand
 			mcp.WithString("updatedBefore",
 				mcp.Description("Return issues updated on or before the given time (ISO 8601 format: YYYY-MM-DDTHH:MM:SSZ)."),
 			),
+			WithFields(),
 			// Add standard MCP pagination parameters
 			WithPagination(),
 		),
@@ -229,6 +262,11 @@ func ListIssues(getClient GetClientFn, t map[string]string) (tool mcp.Tool, hand
 				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
 			}
 
+			fields, err := OptionalFieldsParam(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
 			// --- Parse pagination parameters
 			page, perPage, err := OptionalPaginationParams(&request)
 			if err != nil {
@@ -311,6 +349,9 @@ func ListIssues(getClient GetClientFn, t map[string]string) (tool mcp.Tool, hand
 			if err != nil {
 				return nil, fmt.Errorf("failed to optimize issues response: %w", err)
 			}
+			if err := optimized.SelectFields(fields); err != nil {
+				return nil, fmt.Errorf("failed to filter issue fields: %w", err)
+			}
 
 			// --- Handle empty result gracefully
 			if len(issues) == 0 {
@@ -340,6 +381,7 @@ func IssueComment(getClient GetClientFn, t map[string]string) (tool mcp.Tool, ha
 	return mcp.NewTool(
 			"issueComment",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_ISSUE_COMMENT_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithString("action",
 				mcp.Description("The action to perform on the issue comment"),
 				mcp.Required(),
@@ -495,6 +537,7 @@ func GetIssueLabels(getClient GetClientFn, t map[string]string) (tool mcp.Tool,
 	return mcp.NewTool(
 			"getIssueLabels",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_ISSUE_LABELS_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        "Get Issue Labels",
 				ReadOnlyHint: boolPtr(true),
@@ -565,6 +608,7 @@ func CreateIssue(getClient GetClientFn, t map[string]string) (tool mcp.Tool, han
 	return mcp.NewTool(
 			"createIssue",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_CREATE_ISSUE_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title: "Create GitLab Issue",
 			}),
@@ -597,6 +641,15 @@ func CreateIssue(getClient GetClientFn, t map[string]string) (tool mcp.Tool, han
 				mcp.Description("The state event to perform on the issue (close, reopen)."),
 				mcp.Enum("close", "reopen"),
 			),
+			mcp.WithBoolean("confidential",
+				mcp.Description("Whether the issue is confidential, i.e. only visible to project members with at least Reporter access."),
+			),
+			mcp.WithNumber("epicId",
+				mcp.Description("The global ID (not the IID) of the epic to add the issue to. Only effective on GitLab Premium."),
+			),
+			mcp.WithNumber("weight",
+				mcp.Description("The weight of the issue (non-negative integer, 0 means unweighted). Requires GitLab Premium."),
+			),
 		),
 		// Handler function implementation
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -637,6 +690,25 @@ func CreateIssue(getClient GetClientFn, t map[string]string) (tool mcp.Tool, han
 				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
 			}
 
+			confidential, err := OptionalBoolParam(&request, "confidential")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			weight, err := optionalIssueWeight(&request, "weight")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			epicIDFloat, err := OptionalParam[float64](&request, "epicId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			epicID := int64(epicIDFloat)
+			if float64(epicID) != epicIDFloat || epicID < 0 {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: epicId %v is not a valid ID", epicIDFloat)), nil
+			}
+
 			// --- Obtain GitLab client
 			glClient, err := getClient(ctx)
 			if err != nil {
@@ -645,7 +717,13 @@ func CreateIssue(getClient GetClientFn, t map[string]string) (tool mcp.Tool, han
 
 			// --- Construct GitLab API options
 			opts := &gl.CreateIssueOptions{
-				Title: &title,
+				Title:        &title,
+				Confidential: confidential,
+				Weight:       weight,
+			}
+
+			if epicID != 0 {
+				opts.EpicID = &epicID
 			}
 
 			if description != "" {
@@ -709,6 +787,7 @@ func UpdateIssue(getClient GetClientFn, t map[string]string) (tool mcp.Tool, han
 	return mcp.NewTool(
 			"updateIssue",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_UPDATE_ISSUE_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title: "Update GitLab Issue",
 			}),
@@ -744,6 +823,12 @@ func UpdateIssue(getClient GetClientFn, t map[string]string) (tool mcp.Tool, han
 				mcp.Description("The state event to perform on the issue (close, reopen)."),
 				mcp.Enum("close", "reopen"),
 			),
+			mcp.WithBoolean("confidential",
+				mcp.Description("Set to true to make the issue confidential or false to make it public."),
+			),
+			mcp.WithNumber("weight",
+				mcp.Description("The weight of the issue (non-negative integer, 0 means unweighted). Requires GitLab Premium."),
+			),
 		),
 		// Handler function implementation
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -798,6 +883,16 @@ func UpdateIssue(getClient GetClientFn, t map[string]string) (tool mcp.Tool, han
 				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
 			}
 
+			confidential, err := OptionalBoolParam(&request, "confidential")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			weight, err := optionalIssueWeight(&request, "weight")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
 			// --- Obtain GitLab client
 			glClient, err := getClient(ctx)
 			if err != nil {
@@ -805,7 +900,10 @@ func UpdateIssue(getClient GetClientFn, t map[string]string) (tool mcp.Tool, han
 			}
 
 			// --- Construct GitLab API options
-			opts := &gl.UpdateIssueOptions{}
+			opts := &gl.UpdateIssueOptions{
+				Confidential: confidential,
+				Weight:       weight,
+			}
 
 			if title != "" {
 				opts.Title = gl.Ptr(title)
@@ -877,3 +975,585 @@ func UpdateIssue(getClient GetClientFn, t map[string]string) (tool mcp.Tool, han
 			return mcp.NewToolResultText(string(data)), nil
 		}
 }
+
+// GetIssueRelatedMergeRequests defines the MCP tool for listing the merge requests that mention or are linked to an issue.
+func GetIssueRelatedMergeRequests(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"getIssueRelatedMergeRequests",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_ISSUE_RELATED_MERGE_REQUESTS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "Get GitLab Issue Related Merge Requests",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("issueIid",
+				mcp.Description("The IID (internal ID, integer) of the issue within the project."),
+				mcp.Required(),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			issueIidFloat, err := requiredParam[float64](&request, "issueIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			issueIid := int64(issueIidFloat)
+			if float64(issueIid) != issueIidFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: issueIid %v is not a valid integer", issueIidFloat)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			opts := &gl.ListMergeRequestsRelatedToIssueOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+			}
+			mergeRequests, resp, err := glClient.Issues.ListMergeRequestsRelatedToIssue(projectID, issueIid, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("issue %d in project %q", issueIid, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(mergeRequests) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(mergeRequests)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal related merge requests: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// GetIssueClosingMergeRequests defines the MCP tool for listing the merge requests that will close an issue when merged.
+func GetIssueClosingMergeRequests(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"getIssueClosingMergeRequests",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_ISSUE_CLOSING_MERGE_REQUESTS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "Get GitLab Issue Closing Merge Requests",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("issueIid",
+				mcp.Description("The IID (internal ID, integer) of the issue within the project."),
+				mcp.Required(),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			issueIidFloat, err := requiredParam[float64](&request, "issueIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			issueIid := int64(issueIidFloat)
+			if float64(issueIid) != issueIidFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: issueIid %v is not a valid integer", issueIidFloat)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			opts := &gl.ListMergeRequestsClosingIssueOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+			}
+			mergeRequests, resp, err := glClient.Issues.ListMergeRequestsClosingIssue(projectID, issueIid, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("issue %d in project %q", issueIid, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(mergeRequests) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(mergeRequests)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal closing merge requests: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// MoveIssue defines the MCP tool for moving an issue to another project.
+func MoveIssue(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"moveIssue",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_MOVE_ISSUE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Move GitLab Issue",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project the issue currently belongs to."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("issueIid",
+				mcp.Description("The IID (internal ID, integer) of the issue within the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("toProjectId",
+				mcp.Description("The ID (integer) of the project to move the issue to."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			issueIidFloat, err := requiredParam[float64](&request, "issueIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			issueIid := int64(issueIidFloat)
+			if float64(issueIid) != issueIidFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: issueIid %v is not a valid integer", issueIidFloat)), nil
+			}
+
+			toProjectIDFloat, err := requiredParam[float64](&request, "toProjectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			toProjectID := int64(toProjectIDFloat)
+			if float64(toProjectID) != toProjectIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: toProjectId %v is not a valid integer", toProjectIDFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			opts := &gl.MoveIssueOptions{
+				ToProjectID: &toProjectID,
+			}
+			issue, resp, err := glClient.Issues.MoveIssue(projectID, issueIid, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("target project %d not found or access denied (404), or issue %d does not exist in project %q", toProjectID, issueIid, projectID)), nil
+				}
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("issue %d in project %q", issueIid, projectID), "move issue")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(issue)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal issue data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// MaxBulkIssues caps the number of issues a single bulk issue tool call may touch.
+const MaxBulkIssues = 50
+
+// bulkIssuePayload is one entry of the bulkCreateIssues 'issues' array. Fields mirror createIssue.
+type bulkIssuePayload struct {
+	Title       string  `json:"title"`
+	Description string  `json:"description"`
+	Labels      string  `json:"labels"`
+	AssigneeIDs string  `json:"assigneeIds"`
+	MilestoneID float64 `json:"milestoneId"`
+	DueDate     string  `json:"dueDate"`
+}
+
+// bulkIssueResult reports the outcome of a bulk issue tool for a single issue.
+type bulkIssueResult struct {
+	IID    int64  `json:"iid,omitempty"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// parseBulkIssuePayloads reads the required array of issue payloads (or its JSON string form)
+// and converts each entry into create options. The whole batch is rejected if any entry is invalid.
+func parseBulkIssuePayloads(r *mcp.CallToolRequest, p string) ([]*gl.CreateIssueOptions, error) {
+	rawVal, ok := r.GetArguments()[p]
+	if !ok || rawVal == nil {
+		return nil, fmt.Errorf("missing required parameter: %s", p)
+	}
+
+	var raw []byte
+	if s, isStr := rawVal.(string); isStr {
+		raw = []byte(s)
+	} else {
+		var err error
+		if raw, err = json.Marshal(rawVal); err != nil {
+			return nil, fmt.Errorf("parameter '%s' must be an array: %w", p, err)
+		}
+	}
+
+	var payloads []bulkIssuePayload
+	if err := json.Unmarshal(raw, &payloads); err != nil {
+		return nil, fmt.Errorf("parameter '%s' must be an array of objects with title, description, labels, assigneeIds, milestoneId and dueDate: %w", p, err)
+	}
+	if len(payloads) == 0 {
+		return nil, fmt.Errorf("parameter '%s' must contain at least one issue", p)
+	}
+	if len(payloads) > MaxBulkIssues {
+		return nil, fmt.Errorf("parameter '%s' contains %d issues, the maximum is %d", p, len(payloads), MaxBulkIssues)
+	}
+
+	allOpts := make([]*gl.CreateIssueOptions, 0, len(payloads))
+	for i, payload := range payloads {
+		if payload.Title == "" {
+			return nil, fmt.Errorf("parameter '%s' entry %d must set a title", p, i)
+		}
+		opts := &gl.CreateIssueOptions{
+			Title: gl.Ptr(payload.Title),
+		}
+		if payload.Description != "" {
+			opts.Description = gl.Ptr(payload.Description)
+		}
+		if err := ApplyLabelsWithString(opts, payload.Labels); err != nil {
+			return nil, fmt.Errorf("parameter '%s' entry %d: %w", p, i, err)
+		}
+		if payload.AssigneeIDs != "" {
+			if err := ApplyAssigneeIDsWithString(opts, payload.AssigneeIDs); err != nil {
+				return nil, fmt.Errorf("parameter '%s' entry %d: %w", p, i, err)
+			}
+		}
+		if payload.MilestoneID != 0 {
+			milestoneID, err := ValidateAndConvertMilestoneID(payload.MilestoneID)
+			if err != nil {
+				return nil, fmt.Errorf("parameter '%s' entry %d: %w", p, i, err)
+			}
+			opts.MilestoneID = gl.Ptr(int64(milestoneID))
+		}
+		dueDate, err := ParseDueDate(payload.DueDate)
+		if err != nil {
+			return nil, fmt.Errorf("parameter '%s' entry %d: %w", p, i, err)
+		}
+		opts.DueDate = dueDate
+		allOpts = append(allOpts, opts)
+	}
+
+	return allOpts, nil
+}
+
+// BulkCreateIssues defines the MCP tool for creating several issues in a project with one call.
+func BulkCreateIssues(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"bulkCreateIssues",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_BULK_CREATE_ISSUES_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Bulk Create GitLab Issues",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithArray("issues",
+				mcp.Description(fmt.Sprintf("The issues to create (max %d), e.g. [{\"title\": \"First\", \"labels\": \"bug,backend\"}, {\"title\": \"Second\", \"dueDate\": \"2024-12-31\"}].", MaxBulkIssues)),
+				mcp.Required(),
+				mcp.MaxItems(MaxBulkIssues),
+				mcp.Items(map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"title":       map[string]any{"type": "string", "description": "The title of the issue."},
+						"description": map[string]any{"type": "string", "description": "The description of the issue."},
+						"labels":      map[string]any{"type": "string", "description": "Comma-separated list of label names to apply to the issue."},
+						"assigneeIds": map[string]any{"type": "string", "description": "Comma-separated list of user IDs to assign the issue to."},
+						"milestoneId": map[string]any{"type": "number", "description": "The ID of the milestone to associate the issue with."},
+						"dueDate":     map[string]any{"type": "string", "description": "The due date of the issue (ISO 8601 format: YYYY-MM-DD)."},
+					},
+					"required": []string{"title"},
+				}),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			allOpts, err := parseBulkIssuePayloads(&request, "issues")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API once per issue; a failure does not stop the batch
+			results := make([]bulkIssueResult, 0, len(allOpts))
+			for _, opts := range allOpts {
+				issue, _, err := glClient.Issues.CreateIssue(projectID, opts, gl.WithContext(ctx))
+				if err != nil {
+					results = append(results, bulkIssueResult{Status: "error", Error: err.Error()})
+					continue
+				}
+				results = append(results, bulkIssueResult{IID: issue.IID, Status: "created"})
+			}
+
+			// --- Marshal and return results in input order
+			data, err := json.Marshal(results)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal bulk create results: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// BulkCloseIssues defines the MCP tool for closing several issues of a project with one call.
+func BulkCloseIssues(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"bulkCloseIssues",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_BULK_CLOSE_ISSUES_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Bulk Close GitLab Issues",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("issueIids",
+				mcp.Description(fmt.Sprintf("Comma-separated list of issue IIDs to close (max %d), e.g. '12,13,20'.", MaxBulkIssues)),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			issueIidsStr, err := requiredParam[string](&request, "issueIids")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			issueIids, err := ParseIDListString(issueIidsStr, "issue")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			if issueIids == nil {
+				return mcp.NewToolResultError("Validation Error: issueIids must contain at least one issue IID"), nil
+			}
+			if len(*issueIids) > MaxBulkIssues {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: issueIids contains %d issues, the maximum is %d", len(*issueIids), MaxBulkIssues)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API once per issue; a failure does not stop the batch
+			results := make([]bulkIssueResult, 0, len(*issueIids))
+			for _, iid := range *issueIids {
+				opts := &gl.UpdateIssueOptions{
+					StateEvent: gl.Ptr("close"),
+				}
+				if _, _, err := glClient.Issues.UpdateIssue(projectID, iid, opts, gl.WithContext(ctx)); err != nil {
+					results = append(results, bulkIssueResult{IID: iid, Status: "error", Error: err.Error()})
+					continue
+				}
+				results = append(results, bulkIssueResult{IID: iid, Status: "closed"})
+			}
+
+			// --- Marshal and return results in input order
+			data, err := json.Marshal(results)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal bulk close results: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// MaxMilestoneIssuesToClose caps the number of issues a single closeIssuesByMilestone call closes.
+const MaxMilestoneIssuesToClose = 100
+
+// milestoneCloseFailure is an issue that closeIssuesByMilestone failed to close.
+type milestoneCloseFailure struct {
+	IID   int64  `json:"iid"`
+	Error string `json:"error"`
+}
+
+// milestoneCloseSummary is the result of closeIssuesByMilestone. HasMore is set when the
+// milestone has more open issues than a single call closes.
+type milestoneCloseSummary struct {
+	Closed  int                     `json:"closed"`
+	Failed  []milestoneCloseFailure `json:"failed"`
+	HasMore bool                    `json:"hasMore,omitempty"`
+}
+
+// CloseIssuesByMilestone defines the MCP tool for closing the open issues of a milestone.
+func CloseIssuesByMilestone(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"closeIssuesByMilestone",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_CLOSE_ISSUES_BY_MILESTONE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Close GitLab Issues by Milestone",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("milestoneId",
+				mcp.Description(fmt.Sprintf("The ID (not the IID) of the project milestone whose open issues to close (max %d per call).", MaxMilestoneIssuesToClose)),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			milestoneIDFloat, err := requiredParam[float64](&request, "milestoneId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			milestoneID, err := ValidateAndConvertMilestoneID(milestoneIDFloat)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Collect the open issues of the milestone, page by page
+			// The milestone issues endpoint has no state filter, so closed issues are skipped here.
+			summary := milestoneCloseSummary{Failed: []milestoneCloseFailure{}}
+			var openIids []int64
+			listOpts := &gl.GetMilestoneIssuesOptions{ListOptions: gl.ListOptions{Page: 1, PerPage: 100}}
+			for {
+				issues, resp, err := glClient.Milestones.GetMilestoneIssues(projectID, int64(milestoneID), listOpts, gl.WithContext(ctx))
+
+				// --- Handle API errors
+				if err != nil {
+					result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("milestone %d in project %q", milestoneID, projectID))
+					if result != nil {
+						return result, nil
+					}
+					return nil, apiErr
+				}
+
+				for _, issue := range issues {
+					if issue.State != "opened" {
+						continue
+					}
+					if len(openIids) == MaxMilestoneIssuesToClose {
+						summary.HasMore = true
+						break
+					}
+					openIids = append(openIids, issue.IID)
+				}
+				if summary.HasMore || resp == nil || resp.NextPage == 0 {
+					break
+				}
+				listOpts.Page = resp.NextPage
+			}
+
+			// --- Call GitLab API once per issue; a failure does not stop the batch
+			for _, iid := range openIids {
+				opts := &gl.UpdateIssueOptions{
+					StateEvent: gl.Ptr("close"),
+				}
+				if _, _, err := glClient.Issues.UpdateIssue(projectID, iid, opts, gl.WithContext(ctx)); err != nil {
+					summary.Failed = append(summary.Failed, milestoneCloseFailure{IID: iid, Error: err.Error()})
+					continue
+				}
+				summary.Closed++
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(summary)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal milestone close summary: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
@@ -15,19 +15,28 @@ import (
 	// "net/http/httptest"
 	// "net/url" // No longer needed for http server
 	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	gl "gitlab.com/gitlab-org/api/client-go" // GitLab client library
 
 	// Import for mocks
+	mock_gitlab "gitlab.com/gitlab-org/api/client-go/testing"
+
 	// Gomock mocks
 	"go.uber.org/mock/gomock" // Added for gomock
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
 )
 
 // mockGetClientFn is NOT defined locally, assumed provided by other tests or helpers (like setupMockClient*)
 // getTextResult is assumed defined elsewhere in package gitlab_test
 
 func TestGetIssueHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetIssue(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
 	ctx := context.Background()
 
 	// --- Setup Mock Client and GetClientFn once ---
@@ -207,6 +216,10 @@ func TestGetIssueHandler(t *testing.T) {
 
 // TestListIssuesHandler tests the ListIssues tool handler
 func TestListIssuesHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListIssues(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
 	ctx := context.Background()
 
 	// --- Setup Mock Client and GetClientFn once ---
@@ -773,6 +786,7 @@ func TestGetIssueCommentsHandler(t *testing.T) {
 
 	// --- Define the Tool and Handler once ---
 	getIssueCommentsTool, handler := IssueComment(mockGetClient, nil)
+	require.NoError(t, toolsnaps.Test(getIssueCommentsTool.Name, getIssueCommentsTool), "tool schema should match snapshot")
 
 	// Define common test data
 	projectID := "group/project"
@@ -1309,6 +1323,10 @@ func TestGetIssueLabelsHandler(t *testing.T) {
 
 // TestCreateIssueHandler tests the CreateIssue tool handler
 func TestCreateIssueHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := CreateIssue(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
 	ctx := context.Background()
 	mockClient, mockIssues, ctrl := setupMockClientForIssues(t)
 	defer ctrl.Finish()
@@ -1414,6 +1432,122 @@ func TestCreateIssueHandler(t *testing.T) {
 			expectResultError:   false,
 			expectInternalError: false,
 		},
+		{
+			name: "Success - Create confidential issue",
+			args: map[string]any{
+				"projectId":    projectID,
+				"title":        "Security Issue",
+				"confidential": true,
+			},
+			mockSetup: func() {
+				mockIssues.EXPECT().
+					CreateIssue(projectID, gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, opts *gl.CreateIssueOptions, _ ...gl.RequestOptionFunc) (*gl.Issue, *gl.Response, error) {
+						require.NotNil(t, opts.Confidential)
+						assert.True(t, *opts.Confidential)
+						return &gl.Issue{IID: 3, Title: "Security Issue", Confidential: true}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+					})
+			},
+			expectedResult: &gl.Issue{IID: 3, Title: "Security Issue", Confidential: true},
+		},
+		{
+			name: "Success - Create issue with weight",
+			args: map[string]any{
+				"projectId": projectID,
+				"title":     "Weighted Issue",
+				"weight":    3.0,
+			},
+			mockSetup: func() {
+				mockIssues.EXPECT().
+					CreateIssue(projectID, gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, opts *gl.CreateIssueOptions, _ ...gl.RequestOptionFunc) (*gl.Issue, *gl.Response, error) {
+						require.NotNil(t, opts.Weight)
+						assert.Equal(t, int64(3), *opts.Weight)
+						return &gl.Issue{IID: 4, Title: "Weighted Issue", Weight: 3}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+					})
+			},
+			expectedResult: &gl.Issue{IID: 4, Title: "Weighted Issue", Weight: 3},
+		},
+		{
+			name: "Success - Create unweighted issue with weight 0",
+			args: map[string]any{
+				"projectId": projectID,
+				"title":     "Unweighted Issue",
+				"weight":    0.0,
+			},
+			mockSetup: func() {
+				mockIssues.EXPECT().
+					CreateIssue(projectID, gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, opts *gl.CreateIssueOptions, _ ...gl.RequestOptionFunc) (*gl.Issue, *gl.Response, error) {
+						require.NotNil(t, opts.Weight, "weight 0 must still be sent")
+						assert.Equal(t, int64(0), *opts.Weight)
+						return &gl.Issue{IID: 5, Title: "Unweighted Issue"}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+					})
+			},
+			expectedResult: &gl.Issue{IID: 5, Title: "Unweighted Issue"},
+		},
+		{
+			name: "Success - Create issue in epic",
+			args: map[string]any{
+				"projectId": projectID,
+				"title":     "Epic Child",
+				"epicId":    42.0,
+			},
+			mockSetup: func() {
+				mockIssues.EXPECT().
+					CreateIssue(projectID, gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, opts *gl.CreateIssueOptions, _ ...gl.RequestOptionFunc) (*gl.Issue, *gl.Response, error) {
+						require.NotNil(t, opts.EpicID)
+						assert.Equal(t, int64(42), *opts.EpicID)
+						return &gl.Issue{IID: 6, Title: "Epic Child"}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+					})
+			},
+			expectedResult: &gl.Issue{IID: 6, Title: "Epic Child"},
+		},
+		{
+			name: "Error - Invalid epicId",
+			args: map[string]any{
+				"projectId": projectID,
+				"title":     "Epic Child",
+				"epicId":    4.2,
+			},
+			mockSetup:         func() {},
+			expectedResult:    "Validation Error: epicId 4.2 is not a valid ID",
+			expectResultError: true,
+		},
+		{
+			name: "Error - Negative weight",
+			args: map[string]any{
+				"projectId": projectID,
+				"title":     "Weighted Issue",
+				"weight":    -1.0,
+			},
+			mockSetup:         func() {},
+			expectedResult:    "Validation Error: weight -1 must be a non-negative integer",
+			expectResultError: true,
+		},
+		{
+			name: "Error - Fractional weight",
+			args: map[string]any{
+				"projectId": projectID,
+				"title":     "Weighted Issue",
+				"weight":    2.5,
+			},
+			mockSetup:         func() {},
+			expectedResult:    "Validation Error: weight 2.5 must be a non-negative integer",
+			expectResultError: true,
+		},
+		{
+			name: "Error - Invalid confidential",
+			args: map[string]any{
+				"projectId":    projectID,
+				"title":        "Security Issue",
+				"confidential": "maybe",
+			},
+			mockSetup:         func() {},
+			expectedResult:    "Validation Error: parameter 'confidential' must be a boolean",
+			expectResultError: true,
+		},
 		{
 			name: "Error - Missing projectId",
 			args: map[string]any{
@@ -1552,6 +1686,10 @@ func TestCreateIssueHandler(t *testing.T) {
 
 // TestUpdateIssueHandler tests the UpdateIssue tool handler
 func TestUpdateIssueHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := UpdateIssue(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
 	ctx := context.Background()
 	mockClient, mockIssues, ctrl := setupMockClientForIssues(t)
 	defer ctrl.Finish()
@@ -1841,6 +1979,60 @@ func TestUpdateIssueHandler(t *testing.T) {
 			expectResultError:   false,
 			expectInternalError: false,
 		},
+		{
+			name: "Success - Make issue public",
+			args: map[string]any{
+				"projectId":    projectID,
+				"issueIid":     issueIid,
+				"confidential": false,
+			},
+			mockSetup: func() {
+				mockIssues.EXPECT().
+					UpdateIssue(projectID, int64(1), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, _ int64, opts *gl.UpdateIssueOptions, _ ...gl.RequestOptionFunc) (*gl.Issue, *gl.Response, error) {
+						require.NotNil(t, opts.Confidential)
+						assert.False(t, *opts.Confidential)
+						return &gl.Issue{IID: 1, Title: "Test Issue"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+					})
+			},
+			expectedResult: &gl.Issue{IID: 1, Title: "Test Issue"},
+		},
+		{
+			name: "Success - Update weight",
+			args: map[string]any{
+				"projectId": projectID,
+				"issueIid":  issueIid,
+				"weight":    8.0,
+			},
+			mockSetup: func() {
+				mockIssues.EXPECT().
+					UpdateIssue(projectID, int64(1), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, _ int64, opts *gl.UpdateIssueOptions, _ ...gl.RequestOptionFunc) (*gl.Issue, *gl.Response, error) {
+						require.NotNil(t, opts.Weight)
+						assert.Equal(t, int64(8), *opts.Weight)
+						return &gl.Issue{IID: 1, Title: "Test Issue", Weight: 8}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+					})
+			},
+			expectedResult: &gl.Issue{IID: 1, Title: "Test Issue", Weight: 8},
+		},
+		{
+			name: "Success - Update without confidential leaves it unchanged",
+			args: map[string]any{
+				"projectId": projectID,
+				"issueIid":  issueIid,
+				"title":     "Renamed",
+			},
+			mockSetup: func() {
+				mockIssues.EXPECT().
+					UpdateIssue(projectID, int64(1), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, _ int64, opts *gl.UpdateIssueOptions, _ ...gl.RequestOptionFunc) (*gl.Issue, *gl.Response, error) {
+						assert.Nil(t, opts.Confidential)
+						assert.Nil(t, opts.Weight)
+						return &gl.Issue{IID: 1, Title: "Renamed"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+					})
+			},
+			expectedResult: &gl.Issue{IID: 1, Title: "Renamed"},
+		},
 		{
 			name: "Error - Forbidden (403)",
 			args: map[string]any{
@@ -2488,3 +2680,455 @@ func TestUpdateIssueHandler_RealAPI_Integration(t *testing.T) {
 
 	t.Log("Integration test passed: stateEvent and milestoneId work correctly with real GitLab API")
 }
+
+func TestGetIssueRelatedMergeRequestsHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetIssueRelatedMergeRequests(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockIssues, ctrl := setupMockClientForIssues(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := GetIssueRelatedMergeRequests(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockIssues.EXPECT().
+			ListMergeRequestsRelatedToIssue("group/project", int64(3), gomock.Any(), gomock.Any()).
+			Return([]*gl.BasicMergeRequest{{IID: 7, Title: "Fix login"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"issueIid":  3.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"title":"Fix login"`)
+	})
+
+	t.Run("Success - Empty list", func(t *testing.T) {
+		mockIssues.EXPECT().
+			ListMergeRequestsRelatedToIssue("group/project", int64(3), gomock.Any(), gomock.Any()).
+			Return([]*gl.BasicMergeRequest{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"issueIid":  3.0,
+		}}})
+		require.NoError(t, err)
+		assert.Equal(t, "[]", getTextResult(t, result).Text)
+	})
+
+	t.Run("Error - Issue Not Found (404)", func(t *testing.T) {
+		mockIssues.EXPECT().
+			ListMergeRequestsRelatedToIssue("group/project", int64(99), gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"issueIid":  99.0,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, `issue 99 in project "group/project" not found`)
+	})
+}
+
+func TestGetIssueClosingMergeRequestsHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetIssueClosingMergeRequests(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockIssues, ctrl := setupMockClientForIssues(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := GetIssueClosingMergeRequests(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockIssues.EXPECT().
+			ListMergeRequestsClosingIssue("group/project", int64(3), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ int64, opts *gl.ListMergeRequestsClosingIssueOptions, _ ...gl.RequestOptionFunc) ([]*gl.BasicMergeRequest, *gl.Response, error) {
+				assert.Equal(t, int64(2), opts.Page)
+				return []*gl.BasicMergeRequest{{IID: 8, Title: "Closes #3"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"issueIid":  3.0,
+			"page":      2.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"iid":8`)
+	})
+
+	t.Run("Error - Non-integer issueIid", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"issueIid":  3.5,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: issueIid 3.5 is not a valid integer")
+	})
+}
+
+func TestMoveIssueHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := MoveIssue(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockIssues, ctrl := setupMockClientForIssues(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := MoveIssue(mockGetClient, nil)
+
+	t.Run("Success - Returns issue with new IID", func(t *testing.T) {
+		mockIssues.EXPECT().
+			MoveIssue("group/project", int64(3), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ int64, opts *gl.MoveIssueOptions, _ ...gl.RequestOptionFunc) (*gl.Issue, *gl.Response, error) {
+				assert.Equal(t, int64(42), *opts.ToProjectID)
+				return &gl.Issue{IID: 17, ProjectID: 42}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":   "group/project",
+			"issueIid":    3.0,
+			"toProjectId": 42.0,
+		}}})
+		require.NoError(t, err)
+		text := getTextResult(t, result).Text
+		assert.Contains(t, text, `"iid":17`)
+		assert.Contains(t, text, `"project_id":42`)
+	})
+
+	t.Run("Error - Target project not found (404)", func(t *testing.T) {
+		mockIssues.EXPECT().
+			MoveIssue("group/project", int64(3), gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Project Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":   "group/project",
+			"issueIid":    3.0,
+			"toProjectId": 999.0,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "target project 999 not found")
+	})
+
+	t.Run("Error - Move not allowed (400)", func(t *testing.T) {
+		mockIssues.EXPECT().
+			MoveIssue("group/project", int64(3), gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 400}}, errors.New("gitlab: 400 Cannot move issue to project it originates from!"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":   "group/project",
+			"issueIid":    3.0,
+			"toProjectId": 1.0,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "Cannot move issue to project it originates from")
+	})
+
+	t.Run("Error - Non-integer toProjectId", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":   "group/project",
+			"issueIid":    3.0,
+			"toProjectId": 4.2,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: toProjectId 4.2 is not a valid integer")
+	})
+}
+
+func TestBulkCreateIssuesHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := BulkCreateIssues(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockIssues, ctrl := setupMockClientForIssues(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := BulkCreateIssues(mockGetClient, nil)
+
+	t.Run("Success - Reports each issue in input order", func(t *testing.T) {
+		gomock.InOrder(
+			mockIssues.EXPECT().
+				CreateIssue("group/project", gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ any, opts *gl.CreateIssueOptions, _ ...gl.RequestOptionFunc) (*gl.Issue, *gl.Response, error) {
+					assert.Equal(t, "First", *opts.Title)
+					require.NotNil(t, opts.Labels)
+					assert.Equal(t, gl.LabelOptions{"bug", "backend"}, *opts.Labels)
+					require.NotNil(t, opts.AssigneeIDs)
+					assert.Equal(t, []int64{7}, *opts.AssigneeIDs)
+					return &gl.Issue{IID: 11}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+				}),
+			mockIssues.EXPECT().
+				CreateIssue("group/project", gomock.Any(), gomock.Any()).
+				Return(nil, &gl.Response{Response: &http.Response{StatusCode: 400}}, errors.New("gitlab: 400 Milestone not found")),
+			mockIssues.EXPECT().
+				CreateIssue("group/project", gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ any, opts *gl.CreateIssueOptions, _ ...gl.RequestOptionFunc) (*gl.Issue, *gl.Response, error) {
+					require.NotNil(t, opts.DueDate)
+					assert.Equal(t, "2024-12-31", opts.DueDate.String())
+					return &gl.Issue{IID: 12}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+				}),
+		)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"issues": []any{
+				map[string]any{"title": "First", "labels": "bug,backend", "assigneeIds": "7"},
+				map[string]any{"title": "Second", "milestoneId": 99.0},
+				map[string]any{"title": "Third", "dueDate": "2024-12-31"},
+			},
+		}}})
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var results []bulkIssueResult
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &results))
+		assert.Equal(t, []bulkIssueResult{
+			{IID: 11, Status: "created"},
+			{Status: "error", Error: "gitlab: 400 Milestone not found"},
+			{IID: 12, Status: "created"},
+		}, results)
+	})
+
+	t.Run("Success - Accepts JSON string", func(t *testing.T) {
+		mockIssues.EXPECT().
+			CreateIssue("group/project", gomock.Any(), gomock.Any()).
+			Return(&gl.Issue{IID: 5}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"issues":    `[{"title": "From string"}]`,
+		}}})
+		require.NoError(t, err)
+		assert.JSONEq(t, `[{"iid":5,"status":"created"}]`, getTextResult(t, result).Text)
+	})
+
+	tooMany := make([]any, MaxBulkIssues+1)
+	for i := range tooMany {
+		tooMany[i] = map[string]any{"title": fmt.Sprintf("Issue %d", i)}
+	}
+
+	validationTests := []struct {
+		name          string
+		issues        any
+		errorContains string
+	}{
+		{name: "Missing issues", issues: nil, errorContains: "missing required parameter: issues"},
+		{name: "Empty batch", issues: []any{}, errorContains: "must contain at least one issue"},
+		{name: "Batch too large", issues: tooMany, errorContains: "contains 51 issues, the maximum is 50"},
+		{name: "Missing title", issues: []any{map[string]any{"title": "ok"}, map[string]any{"description": "no title"}}, errorContains: "entry 1 must set a title"},
+		{name: "Invalid due date", issues: []any{map[string]any{"title": "a", "dueDate": "31/12/2024"}}, errorContains: "entry 0: dueDate must be in YYYY-MM-DD format"},
+		{name: "Not an array", issues: `{"title": "a"}`, errorContains: "must be an array of objects"},
+	}
+
+	for _, tc := range validationTests {
+		t.Run("Error - "+tc.name, func(t *testing.T) {
+			args := map[string]any{"projectId": "group/project"}
+			if tc.issues != nil {
+				args["issues"] = tc.issues
+			}
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+			require.NoError(t, err)
+			assert.True(t, result.IsError)
+			assert.Contains(t, getTextResult(t, result).Text, "Validation Error:")
+			assert.Contains(t, getTextResult(t, result).Text, tc.errorContains)
+		})
+	}
+}
+
+func TestBulkCloseIssuesHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := BulkCloseIssues(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockIssues, ctrl := setupMockClientForIssues(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := BulkCloseIssues(mockGetClient, nil)
+
+	t.Run("Success - Closes each issue and reports failures", func(t *testing.T) {
+		closeIssue := func(_ any, _ int64, opts *gl.UpdateIssueOptions, _ ...gl.RequestOptionFunc) (*gl.Issue, *gl.Response, error) {
+			require.NotNil(t, opts.StateEvent)
+			assert.Equal(t, "close", *opts.StateEvent)
+			return &gl.Issue{State: "closed"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+		}
+		gomock.InOrder(
+			mockIssues.EXPECT().UpdateIssue("group/project", int64(12), gomock.Any(), gomock.Any()).DoAndReturn(closeIssue),
+			mockIssues.EXPECT().UpdateIssue("group/project", int64(13), gomock.Any(), gomock.Any()).
+				Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found")),
+			mockIssues.EXPECT().UpdateIssue("group/project", int64(20), gomock.Any(), gomock.Any()).DoAndReturn(closeIssue),
+		)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"issueIids": "12, 13,20",
+		}}})
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var results []bulkIssueResult
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &results))
+		assert.Equal(t, []bulkIssueResult{
+			{IID: 12, Status: "closed"},
+			{IID: 13, Status: "error", Error: "gitlab: 404 Not Found"},
+			{IID: 20, Status: "closed"},
+		}, results)
+	})
+
+	tooMany := make([]string, MaxBulkIssues+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprint(i + 1)
+	}
+
+	validationTests := []struct {
+		name          string
+		issueIids     any
+		errorContains string
+	}{
+		{name: "Missing issueIids", errorContains: "missing required parameter: issueIids"},
+		{name: "Only separators", issueIids: " , ", errorContains: "issueIids must contain at least one issue IID"},
+		{name: "Non-integer IID", issueIids: "12,abc", errorContains: `invalid issue ID "abc"`},
+		{name: "Too many IIDs", issueIids: strings.Join(tooMany, ","), errorContains: "issueIids contains 51 issues, the maximum is 50"},
+	}
+
+	for _, tc := range validationTests {
+		t.Run("Error - "+tc.name, func(t *testing.T) {
+			args := map[string]any{"projectId": "group/project"}
+			if tc.issueIids != nil {
+				args["issueIids"] = tc.issueIids
+			}
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+			require.NoError(t, err)
+			assert.True(t, result.IsError)
+			assert.Contains(t, getTextResult(t, result).Text, "Validation Error:")
+			assert.Contains(t, getTextResult(t, result).Text, tc.errorContains)
+		})
+	}
+}
+
+func TestCloseIssuesByMilestoneHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := CloseIssuesByMilestone(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+
+	setup := func(t *testing.T) (server.ToolHandlerFunc, *mock_gitlab.MockMilestonesServiceInterface, *mock_gitlab.MockIssuesServiceInterface) {
+		ctrl := gomock.NewController(t)
+		mockMilestones := mock_gitlab.NewMockMilestonesServiceInterface(ctrl)
+		mockIssues := mock_gitlab.NewMockIssuesServiceInterface(ctrl)
+		client := &gl.Client{Milestones: mockMilestones, Issues: mockIssues}
+		_, handler := CloseIssuesByMilestone(func(_ context.Context) (*gl.Client, error) {
+			return client, nil
+		}, nil)
+		return handler, mockMilestones, mockIssues
+	}
+
+	page := func(next int64) *gl.Response {
+		return &gl.Response{Response: &http.Response{StatusCode: 200}, NextPage: next}
+	}
+
+	t.Run("Success - Closes open issues across pages and reports failures", func(t *testing.T) {
+		handler, mockMilestones, mockIssues := setup(t)
+		gomock.InOrder(
+			mockMilestones.EXPECT().GetMilestoneIssues("group/project", int64(7), &gl.GetMilestoneIssuesOptions{ListOptions: gl.ListOptions{Page: 1, PerPage: 100}}, gomock.Any()).
+				Return([]*gl.Issue{{IID: 1, State: "opened"}, {IID: 2, State: "closed"}}, page(2), nil),
+			mockMilestones.EXPECT().GetMilestoneIssues("group/project", int64(7), &gl.GetMilestoneIssuesOptions{ListOptions: gl.ListOptions{Page: 2, PerPage: 100}}, gomock.Any()).
+				Return([]*gl.Issue{{IID: 3, State: "opened"}}, page(0), nil),
+		)
+		gomock.InOrder(
+			mockIssues.EXPECT().UpdateIssue("group/project", int64(1), &gl.UpdateIssueOptions{StateEvent: gl.Ptr("close")}, gomock.Any()).
+				Return(&gl.Issue{IID: 1, State: "closed"}, page(0), nil),
+			mockIssues.EXPECT().UpdateIssue("group/project", int64(3), gomock.Any(), gomock.Any()).
+				Return(nil, &gl.Response{Response: &http.Response{StatusCode: 403}}, errors.New("gitlab: 403 Forbidden")),
+		)
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{"projectId": "group/project", "milestoneId": 7.0}))
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+		assert.JSONEq(t, `{"closed": 1, "failed": [{"iid": 3, "error": "gitlab: 403 Forbidden"}]}`, getTextResult(t, result).Text)
+	})
+
+	t.Run("Success - Stops at the cap", func(t *testing.T) {
+		handler, mockMilestones, mockIssues := setup(t)
+		issues := make([]*gl.Issue, MaxMilestoneIssuesToClose+1)
+		for i := range issues {
+			issues[i] = &gl.Issue{IID: int64(i + 1), State: "opened"}
+		}
+		mockMilestones.EXPECT().GetMilestoneIssues("group/project", int64(7), gomock.Any(), gomock.Any()).Return(issues, page(2), nil)
+		mockIssues.EXPECT().UpdateIssue("group/project", gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&gl.Issue{State: "closed"}, page(0), nil).Times(MaxMilestoneIssuesToClose)
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{"projectId": "group/project", "milestoneId": 7.0}))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"closed": 100, "failed": [], "hasMore": true}`, getTextResult(t, result).Text)
+	})
+
+	t.Run("Success - No open issues", func(t *testing.T) {
+		handler, mockMilestones, _ := setup(t)
+		mockMilestones.EXPECT().GetMilestoneIssues("group/project", int64(7), gomock.Any(), gomock.Any()).Return([]*gl.Issue{}, page(0), nil)
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{"projectId": "group/project", "milestoneId": 7.0}))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"closed": 0, "failed": []}`, getTextResult(t, result).Text)
+	})
+
+	t.Run("Error - Milestone not found", func(t *testing.T) {
+		handler, mockMilestones, _ := setup(t)
+		mockMilestones.EXPECT().GetMilestoneIssues("group/project", int64(99), gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{"projectId": "group/project", "milestoneId": 99.0}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, `milestone 99 in project "group/project" not found or access denied (404)`)
+	})
+
+	validationTests := []struct {
+		name          string
+		args          map[string]any
+		errorContains string
+	}{
+		{name: "Missing projectId", args: map[string]any{"milestoneId": 7.0}, errorContains: "missing required parameter: projectId"},
+		{name: "Missing milestoneId", args: map[string]any{"projectId": "group/project"}, errorContains: "missing required parameter: milestoneId"},
+		{name: "Non-integer milestoneId", args: map[string]any{"projectId": "group/project", "milestoneId": 7.5}, errorContains: "milestoneId 7.5 is not a valid integer"},
+	}
+
+	for _, tc := range validationTests {
+		t.Run("Error - "+tc.name, func(t *testing.T) {
+			handler, _, _ := setup(t)
+			result, err := handler(ctx, *createMCPRequest(tc.args))
+			require.NoError(t, err)
+			assert.True(t, result.IsError)
+			assert.Contains(t, getTextResult(t, result).Text, "Validation Error: "+tc.errorContains)
+		})
+	}
+}
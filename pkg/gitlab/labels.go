@@ -0,0 +1,348 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gl "gitlab.com/gitlab-org/api/client-go"
+)
+
+// ListGroupLabels defines the MCP tool for listing the labels of a GitLab group.
+func ListGroupLabels(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listGroupLabels",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_GROUP_LABELS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Group Labels",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("groupId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the group."),
+				mcp.Required(),
+			),
+			// Optional filtering parameters
+			mcp.WithBoolean("withCounts",
+				mcp.Description("Include the open issue, closed issue and open merge request counts of each label (GitLab default: false)."),
+			),
+			mcp.WithBoolean("includeAncestorGroups",
+				mcp.Description("Include the labels of ancestor groups (GitLab default: true)."),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			groupID, err := requiredParam[string](&request, "groupId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional filtering parameters
+			withCounts, err := OptionalBoolParam(&request, "withCounts")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			includeAncestorGroups, err := OptionalBoolParam(&request, "includeAncestorGroups")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.ListGroupLabelsOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+				WithCounts:            withCounts,
+				IncludeAncestorGroups: includeAncestorGroups,
+			}
+
+			// --- Call GitLab API
+			labels, resp, err := glClient.GroupLabels.ListGroupLabels(groupID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, fmt.Sprintf("labels from group %q", groupID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(labels) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(labels)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal group labels list: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// CreateGroupLabel defines the MCP tool for creating a label in a GitLab group.
+func CreateGroupLabel(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"createGroupLabel",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_CREATE_GROUP_LABEL_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Create GitLab Group Label",
+			}),
+			// Required parameters
+			mcp.WithString("groupId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the group."),
+				mcp.Required(),
+			),
+			mcp.WithString("name",
+				mcp.Description("The name of the label."),
+				mcp.Required(),
+			),
+			mcp.WithString("color",
+				mcp.Description("The color of the label as a hex code with leading '#' (e.g. '#FFAABB') or a CSS color name."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithString("description",
+				mcp.Description("The description of the label."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			groupID, err := requiredParam[string](&request, "groupId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			name, err := requiredParam[string](&request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			color, err := requiredParam[string](&request, "color")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional parameters
+			description, err := OptionalParam[string](&request, "description")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.CreateGroupLabelOptions{
+				Name:  &name,
+				Color: &color,
+			}
+
+			if description != "" {
+				opts.Description = &description
+			}
+
+			// --- Call GitLab API
+			label, resp, err := glClient.GroupLabels.CreateGroupLabel(groupID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("group %q", groupID), "create group label")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(label)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal group label data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// UpdateGroupLabel defines the MCP tool for updating a label of a GitLab group.
+func UpdateGroupLabel(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"updateGroupLabel",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_UPDATE_GROUP_LABEL_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Update GitLab Group Label",
+			}),
+			// Required parameters
+			mcp.WithString("groupId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the group."),
+				mcp.Required(),
+			),
+			mcp.WithString("labelId",
+				mcp.Description("The ID (integer) or name (string) of the label."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithString("newName",
+				mcp.Description("The new name of the label."),
+			),
+			mcp.WithString("color",
+				mcp.Description("The new color of the label as a hex code with leading '#' (e.g. '#FFAABB') or a CSS color name."),
+			),
+			mcp.WithString("description",
+				mcp.Description("The new description of the label."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			groupID, err := requiredParam[string](&request, "groupId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			labelID, err := requiredParam[string](&request, "labelId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional parameters
+			newName, err := OptionalParam[string](&request, "newName")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			color, err := OptionalParam[string](&request, "color")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			description, err := OptionalParam[string](&request, "description")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// An empty description is meaningful (it clears the description), so presence decides
+			_, hasDescription := request.GetArguments()["description"]
+			if newName == "" && color == "" && !hasDescription {
+				return mcp.NewToolResultError("Validation Error: at least one of newName, color or description must be set"), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.UpdateGroupLabelOptions{}
+
+			if newName != "" {
+				opts.NewName = &newName
+			}
+
+			if color != "" {
+				opts.Color = &color
+			}
+
+			if hasDescription {
+				opts.Description = &description
+			}
+
+			// --- Call GitLab API
+			label, resp, err := glClient.GroupLabels.UpdateGroupLabel(groupID, labelID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("label %q in group %q", labelID, groupID), "update group label")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(label)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal group label data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// DeleteGroupLabel defines the MCP tool for deleting a label of a GitLab group.
+func DeleteGroupLabel(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"deleteGroupLabel",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_DELETE_GROUP_LABEL_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Delete GitLab Group Label",
+			}),
+			// Required parameters
+			mcp.WithString("groupId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the group."),
+				mcp.Required(),
+			),
+			mcp.WithString("labelId",
+				mcp.Description("The ID (integer) or name (string) of the label."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			groupID, err := requiredParam[string](&request, "groupId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			labelID, err := requiredParam[string](&request, "labelId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			resp, err := glClient.GroupLabels.DeleteGroupLabel(groupID, labelID, nil, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("label %q in group %q", labelID, groupID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf(`{"message":"Label %q successfully deleted from group %q"}`, labelID, groupID)), nil
+		}
+}
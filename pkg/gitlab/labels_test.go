@@ -0,0 +1,267 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+func TestListGroupLabelsHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListGroupLabels(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockGroupLabels, ctrl := setupMockClientForGroupLabels(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListGroupLabels(mockGetClient, nil)
+
+	t.Run("Success - Flags and pagination are forwarded", func(t *testing.T) {
+		mockGroupLabels.EXPECT().
+			ListGroupLabels("my-group", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.ListGroupLabelsOptions, _ ...gl.RequestOptionFunc) ([]*gl.GroupLabel, *gl.Response, error) {
+				assert.True(t, *opts.WithCounts)
+				assert.False(t, *opts.IncludeAncestorGroups)
+				assert.Equal(t, int64(3), opts.Page)
+				assert.Equal(t, int64(50), opts.PerPage)
+				return []*gl.GroupLabel{{ID: 1, Name: "priority::high", Color: "#FF0000", OpenIssuesCount: 4}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId":               "my-group",
+			"withCounts":            true,
+			"includeAncestorGroups": false,
+			"page":                  3.0,
+			"per_page":              50.0,
+		}}})
+		require.NoError(t, err)
+		text := getTextResult(t, result).Text
+		assert.Contains(t, text, `"name":"priority::high"`)
+		assert.Contains(t, text, `"open_issues_count":4`)
+	})
+
+	t.Run("Success - Empty list", func(t *testing.T) {
+		mockGroupLabels.EXPECT().
+			ListGroupLabels("my-group", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.ListGroupLabelsOptions, _ ...gl.RequestOptionFunc) ([]*gl.GroupLabel, *gl.Response, error) {
+				assert.Nil(t, opts.WithCounts)
+				assert.Nil(t, opts.IncludeAncestorGroups)
+				return []*gl.GroupLabel{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+		}}})
+		require.NoError(t, err)
+		assert.Equal(t, "[]", getTextResult(t, result).Text)
+	})
+
+	t.Run("Error - Missing groupId", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: groupId")
+	})
+}
+
+func TestCreateGroupLabelHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := CreateGroupLabel(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockGroupLabels, ctrl := setupMockClientForGroupLabels(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := CreateGroupLabel(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockGroupLabels.EXPECT().
+			CreateGroupLabel("my-group", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.CreateGroupLabelOptions, _ ...gl.RequestOptionFunc) (*gl.GroupLabel, *gl.Response, error) {
+				assert.Equal(t, "team::backend", *opts.Name)
+				assert.Equal(t, "#0033CC", *opts.Color)
+				assert.Equal(t, "Backend work", *opts.Description)
+				return &gl.GroupLabel{ID: 8, Name: "team::backend", Color: "#0033CC"}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId":     "my-group",
+			"name":        "team::backend",
+			"color":       "#0033CC",
+			"description": "Backend work",
+		}}})
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, `"id":8`)
+	})
+
+	t.Run("Error - Missing color", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+			"name":    "team::backend",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: color")
+	})
+
+	t.Run("Error - Label already exists (409)", func(t *testing.T) {
+		mockGroupLabels.EXPECT().
+			CreateGroupLabel("my-group", gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 409}}, errors.New("gitlab: 409 Label already exists"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+			"name":    "team::backend",
+			"color":   "#0033CC",
+		}}})
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "failed to create group label")
+	})
+}
+
+func TestUpdateGroupLabelHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := UpdateGroupLabel(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockGroupLabels, ctrl := setupMockClientForGroupLabels(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := UpdateGroupLabel(mockGetClient, nil)
+
+	t.Run("Success - Rename by name", func(t *testing.T) {
+		mockGroupLabels.EXPECT().
+			UpdateGroupLabel("my-group", "bug", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ any, opts *gl.UpdateGroupLabelOptions, _ ...gl.RequestOptionFunc) (*gl.GroupLabel, *gl.Response, error) {
+				assert.Equal(t, "type::bug", *opts.NewName)
+				assert.Nil(t, opts.Color)
+				assert.Nil(t, opts.Description)
+				return &gl.GroupLabel{ID: 3, Name: "type::bug"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+			"labelId": "bug",
+			"newName": "type::bug",
+		}}})
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, `"name":"type::bug"`)
+	})
+
+	t.Run("Success - Clear description by ID", func(t *testing.T) {
+		mockGroupLabels.EXPECT().
+			UpdateGroupLabel("my-group", "3", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ any, opts *gl.UpdateGroupLabelOptions, _ ...gl.RequestOptionFunc) (*gl.GroupLabel, *gl.Response, error) {
+				require.NotNil(t, opts.Description)
+				assert.Equal(t, "", *opts.Description)
+				return &gl.GroupLabel{ID: 3, Name: "type::bug"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId":     "my-group",
+			"labelId":     "3",
+			"description": "",
+		}}})
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	t.Run("Error - Nothing to update", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+			"labelId": "bug",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: at least one of newName, color or description must be set")
+	})
+
+	t.Run("Error - Not found (404)", func(t *testing.T) {
+		mockGroupLabels.EXPECT().
+			UpdateGroupLabel("my-group", "missing", gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Label Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+			"labelId": "missing",
+			"color":   "#FFFFFF",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `label "missing" in group "my-group" not found`)
+	})
+}
+
+func TestDeleteGroupLabelHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := DeleteGroupLabel(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockGroupLabels, ctrl := setupMockClientForGroupLabels(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := DeleteGroupLabel(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockGroupLabels.EXPECT().
+			DeleteGroupLabel("my-group", "8", gomock.Nil(), gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 204}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+			"labelId": "8",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `Label "8" successfully deleted from group "my-group"`)
+	})
+
+	t.Run("Error - Missing labelId", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: labelId")
+	})
+
+	t.Run("Error - Not found (404)", func(t *testing.T) {
+		mockGroupLabels.EXPECT().
+			DeleteGroupLabel("my-group", "missing", gomock.Nil(), gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Label Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+			"labelId": "missing",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, `label "missing" in group "my-group" not found`)
+	})
+}
@@ -0,0 +1,215 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+func TestListMergeRequestApprovalsHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListMergeRequestApprovals(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockApprovals, ctrl := setupMockClientForMergeRequestApprovals(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListMergeRequestApprovals(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockApprovals.EXPECT().
+			GetConfiguration("group/project", int64(1), gomock.Any()).
+			Return(&gl.MergeRequestApprovals{IID: 1, Approved: true, ApprovalsLeft: 0}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 1.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"approved":true`)
+	})
+
+	t.Run("Error - Merge Request Not Found (404)", func(t *testing.T) {
+		mockApprovals.EXPECT().
+			GetConfiguration("group/project", int64(99), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 99.0,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "approvals for merge request 99")
+	})
+
+	t.Run("Error - Missing mergeRequestIid", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: mergeRequestIid")
+	})
+}
+
+func TestGetMergeRequestApprovalStateHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetMergeRequestApprovalState(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockApprovals, ctrl := setupMockClientForMergeRequestApprovals(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := GetMergeRequestApprovalState(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockApprovals.EXPECT().
+			GetApprovalState("group/project", int64(1), gomock.Any()).
+			Return(&gl.MergeRequestApprovalState{Rules: []*gl.MergeRequestApprovalRule{{
+				Name:              "Backend",
+				ApprovalsRequired: 2,
+				ApprovedBy:        []*gl.BasicUser{{Username: "alice"}},
+				Approved:          false,
+			}}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 1.0,
+		}}})
+		require.NoError(t, err)
+		text := getTextResult(t, result).Text
+		assert.Contains(t, text, `"approvals_required":2`)
+		assert.Contains(t, text, `"username":"alice"`)
+		assert.Contains(t, text, `"approved":false`)
+	})
+
+	t.Run("Error - Merge Request Not Found (404)", func(t *testing.T) {
+		mockApprovals.EXPECT().
+			GetApprovalState("group/project", int64(99), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 99.0,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "approval state of merge request 99")
+	})
+
+	t.Run("Error - Invalid mergeRequestIid", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 1.5,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: mergeRequestIid 1.5 is not a valid integer")
+	})
+}
+
+func TestApproveMergeRequestHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ApproveMergeRequest(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockApprovals, ctrl := setupMockClientForMergeRequestApprovals(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ApproveMergeRequest(mockGetClient, nil)
+
+	t.Run("Success - With sha", func(t *testing.T) {
+		mockApprovals.EXPECT().
+			ApproveMergeRequest("group/project", int64(1), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ int64, opts *gl.ApproveMergeRequestOptions, _ ...gl.RequestOptionFunc) (*gl.MergeRequestApprovals, *gl.Response, error) {
+				assert.Equal(t, "abc123", *opts.SHA)
+				return &gl.MergeRequestApprovals{IID: 1, Approved: true}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 1.0,
+			"sha":             "abc123",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"approved":true`)
+	})
+
+	t.Run("Error - GitLab API Error (500)", func(t *testing.T) {
+		mockApprovals.EXPECT().
+			ApproveMergeRequest("group/project", int64(2), gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 500}}, errors.New("gitlab: 500 Internal Server Error"))
+
+		_, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 2.0,
+		}}})
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "failed to approve merge request")
+	})
+}
+
+func TestUnapproveMergeRequestHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := UnapproveMergeRequest(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockApprovals, ctrl := setupMockClientForMergeRequestApprovals(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := UnapproveMergeRequest(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockApprovals.EXPECT().
+			UnapproveMergeRequest("group/project", int64(1), gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 201}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 1.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Approval of merge request 1")
+	})
+
+	t.Run("Error - Not approved by user (404)", func(t *testing.T) {
+		mockApprovals.EXPECT().
+			UnapproveMergeRequest("group/project", int64(2), gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 2.0,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
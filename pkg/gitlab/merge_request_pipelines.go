@@ -0,0 +1,183 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gl "gitlab.com/gitlab-org/api/client-go"
+)
+
+// latestPipelineResult is the response of the getLatestMergeRequestPipeline tool
+type latestPipelineResult struct {
+	*gl.PipelineInfo
+	Passed bool `json:"passed"`
+}
+
+// GetMergeRequestPipelines defines the MCP tool for listing the pipelines of a merge request.
+func GetMergeRequestPipelines(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"getMergeRequestPipelines",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_MERGE_REQUEST_PIPELINES_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "Get GitLab Merge Request Pipelines",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("mergeRequestIid",
+				mcp.Description("The IID (internal ID, integer) of the merge request within the project."),
+				mcp.Required(),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			mrIidFloat, err := requiredParam[float64](&request, "mergeRequestIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			mrIid := int64(mrIidFloat)
+			if float64(mrIid) != mrIidFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: mergeRequestIid %v is not a valid integer", mrIidFloat)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API: the client library takes no list options for this endpoint
+			pipelines, resp, err := glClient.MergeRequests.ListMergeRequestPipelines(projectID, mrIid,
+				withQueryParam("page", strconv.Itoa(page)),
+				withQueryParam("per_page", strconv.Itoa(perPage)),
+				gl.WithContext(ctx),
+			)
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, fmt.Sprintf("pipelines for merge request %d in project %q", mrIid, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(pipelines) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(pipelines)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal merge request pipelines list: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// GetLatestMergeRequestPipeline defines the MCP tool for checking the most recent pipeline of a merge request.
+func GetLatestMergeRequestPipeline(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"getLatestMergeRequestPipeline",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_LATEST_MERGE_REQUEST_PIPELINE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "Get Latest GitLab Merge Request Pipeline",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("mergeRequestIid",
+				mcp.Description("The IID (internal ID, integer) of the merge request within the project."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			mrIidFloat, err := requiredParam[float64](&request, "mergeRequestIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			mrIid := int64(mrIidFloat)
+			if float64(mrIid) != mrIidFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: mergeRequestIid %v is not a valid integer", mrIidFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			mrDesc := fmt.Sprintf("merge request %d in project %q", mrIid, projectID)
+
+			// --- Call GitLab API: pipelines are returned newest first
+			pipelines, resp, err := glClient.MergeRequests.ListMergeRequestPipelines(projectID, mrIid,
+				withQueryParam("per_page", "1"),
+				gl.WithContext(ctx),
+			)
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, mrDesc)
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(pipelines) == 0 {
+				data, err := json.Marshal(map[string]any{
+					"message": "No pipelines found for " + mrDesc,
+					"passed":  false,
+				})
+				if err != nil {
+					return nil, fmt.Errorf("failed to marshal merge request pipeline: %w", err)
+				}
+				return mcp.NewToolResultText(string(data)), nil
+			}
+
+			// --- Marshal and return success
+			latest := pipelines[0]
+			data, err := json.Marshal(latestPipelineResult{
+				PipelineInfo: latest,
+				Passed:       latest.Status == "success",
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal merge request pipeline: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
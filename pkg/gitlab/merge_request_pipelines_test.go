@@ -0,0 +1,187 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+func TestGetMergeRequestPipelinesHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetMergeRequestPipelines(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockMRs, ctrl := setupMockClientForMergeRequests(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := GetMergeRequestPipelines(mockGetClient, nil)
+
+	tests := []struct {
+		name               string
+		inputArgs          map[string]any
+		mockSetup          func()
+		expectedText       string
+		expectHandlerError bool
+		expectResultError  bool
+		errorContains      string
+	}{
+		{
+			name:      "Success - List pipelines with pagination",
+			inputArgs: map[string]any{"projectId": "group/project", "mergeRequestIid": 5.0, "page": 2, "per_page": 10},
+			mockSetup: func() {
+				mockMRs.EXPECT().
+					ListMergeRequestPipelines("group/project", int64(5), gomock.Any()).
+					DoAndReturn(func(_ any, _ int64, options ...gl.RequestOptionFunc) ([]*gl.PipelineInfo, *gl.Response, error) {
+						req, err := retryablehttp.NewRequest(http.MethodGet, "https://gitlab.example.com/api/v4/projects/1/merge_requests/5/pipelines", nil)
+						require.NoError(t, err)
+						for _, fn := range options {
+							require.NoError(t, fn(req))
+						}
+						assert.Equal(t, "2", req.URL.Query().Get("page"))
+						assert.Equal(t, "10", req.URL.Query().Get("per_page"))
+						return []*gl.PipelineInfo{{ID: 100, Status: "success"}, {ID: 99, Status: "failed"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+					})
+			},
+			expectedText: `"id":100`,
+		},
+		{
+			name:      "Success - Empty list",
+			inputArgs: map[string]any{"projectId": "group/project", "mergeRequestIid": 5.0},
+			mockSetup: func() {
+				mockMRs.EXPECT().
+					ListMergeRequestPipelines("group/project", int64(5), gomock.Any()).
+					Return([]*gl.PipelineInfo{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: "[]",
+		},
+		{
+			name:              "Error - Non-integer mergeRequestIid",
+			inputArgs:         map[string]any{"projectId": "group/project", "mergeRequestIid": 5.5},
+			mockSetup:         func() {},
+			expectResultError: true,
+			errorContains:     "Validation Error: mergeRequestIid 5.5 is not a valid integer",
+		},
+		{
+			name:      "Error - Forbidden (403)",
+			inputArgs: map[string]any{"projectId": "group/project", "mergeRequestIid": 5.0},
+			mockSetup: func() {
+				mockMRs.EXPECT().
+					ListMergeRequestPipelines("group/project", int64(5), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 403}}, errors.New("gitlab: 403 Forbidden"))
+			},
+			expectHandlerError: true,
+			errorContains:      "failed to list pipelines for merge request 5",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.inputArgs}})
+
+			if tc.expectHandlerError {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			textContent := getTextResult(t, result)
+			if tc.expectResultError {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.errorContains)
+				return
+			}
+			assert.Contains(t, textContent.Text, tc.expectedText)
+		})
+	}
+}
+
+func TestGetLatestMergeRequestPipelineHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetLatestMergeRequestPipeline(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockMRs, ctrl := setupMockClientForMergeRequests(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := GetLatestMergeRequestPipeline(mockGetClient, nil)
+
+	args := map[string]any{"projectId": "group/project", "mergeRequestIid": 5.0}
+
+	t.Run("Success - Passed", func(t *testing.T) {
+		mockMRs.EXPECT().
+			ListMergeRequestPipelines("group/project", int64(5), gomock.Any()).
+			DoAndReturn(func(_ any, _ int64, options ...gl.RequestOptionFunc) ([]*gl.PipelineInfo, *gl.Response, error) {
+				req, err := retryablehttp.NewRequest(http.MethodGet, "https://gitlab.example.com/api/v4/projects/1/merge_requests/5/pipelines", nil)
+				require.NoError(t, err)
+				for _, fn := range options {
+					require.NoError(t, fn(req))
+				}
+				assert.Equal(t, "1", req.URL.Query().Get("per_page"))
+				return []*gl.PipelineInfo{{ID: 100, Status: "success", SHA: "abc123"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+		require.NoError(t, err)
+		text := getTextResult(t, result).Text
+		assert.Contains(t, text, `"id":100`)
+		assert.Contains(t, text, `"status":"success"`)
+		assert.Contains(t, text, `"passed":true`)
+	})
+
+	t.Run("Success - Failed pipeline", func(t *testing.T) {
+		mockMRs.EXPECT().
+			ListMergeRequestPipelines("group/project", int64(5), gomock.Any()).
+			Return([]*gl.PipelineInfo{{ID: 101, Status: "failed"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"passed":false`)
+	})
+
+	t.Run("Success - No pipelines", func(t *testing.T) {
+		mockMRs.EXPECT().
+			ListMergeRequestPipelines("group/project", int64(5), gomock.Any()).
+			Return([]*gl.PipelineInfo{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+		require.NoError(t, err)
+		text := getTextResult(t, result).Text
+		assert.Contains(t, text, "No pipelines found for merge request 5")
+		assert.Contains(t, text, `"passed":false`)
+	})
+
+	t.Run("Error - Not found (404)", func(t *testing.T) {
+		mockMRs.EXPECT().
+			ListMergeRequestPipelines("group/project", int64(5), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "not found or access denied")
+	})
+}
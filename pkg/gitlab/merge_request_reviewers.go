@@ -0,0 +1,284 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gl "gitlab.com/gitlab-org/api/client-go"
+)
+
+// reviewerIDs returns the user IDs of the reviewers of a merge request.
+func reviewerIDs(mr *gl.MergeRequest) []int64 {
+	ids := make([]int64, 0, len(mr.Reviewers))
+	for _, reviewer := range mr.Reviewers {
+		if reviewer != nil {
+			ids = append(ids, reviewer.ID)
+		}
+	}
+	return ids
+}
+
+// marshalReviewers returns the reviewers of a merge request as a JSON array (never null).
+func marshalReviewers(mr *gl.MergeRequest) (*mcp.CallToolResult, error) {
+	reviewers := mr.Reviewers
+	if reviewers == nil {
+		reviewers = []*gl.BasicUser{}
+	}
+	data, err := json.Marshal(reviewers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal merge request reviewers: %w", err)
+	}
+	return mcp.NewToolResultText(string(data)), nil
+}
+
+// ListMergeRequestReviewers defines the MCP tool for listing the reviewers of a merge request.
+func ListMergeRequestReviewers(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listMergeRequestReviewers",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_MERGE_REQUEST_REVIEWERS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Merge Request Reviewers",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("mergeRequestIid",
+				mcp.Description("The IID (internal ID, integer) of the merge request within the project."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			mrIidFloat, err := requiredParam[float64](&request, "mergeRequestIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			mrIid := int64(mrIidFloat)
+			if float64(mrIid) != mrIidFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: mergeRequestIid %v is not a valid integer", mrIidFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			mr, resp, err := glClient.MergeRequests.GetMergeRequest(projectID, mrIid, nil, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("merge request %d in project %q", mrIid, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			return marshalReviewers(mr)
+		}
+}
+
+// AddMergeRequestReviewer defines the MCP tool for requesting reviews from additional users.
+// Existing reviewers are kept.
+func AddMergeRequestReviewer(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"addMergeRequestReviewer",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_ADD_MERGE_REQUEST_REVIEWER_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Add GitLab Merge Request Reviewer",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("mergeRequestIid",
+				mcp.Description("The IID (internal ID, integer) of the merge request within the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("reviewerIds",
+				mcp.Description("Comma-separated list of user IDs to add as reviewers."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			mrIidFloat, err := requiredParam[float64](&request, "mergeRequestIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			mrIid := int64(mrIidFloat)
+			if float64(mrIid) != mrIidFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: mergeRequestIid %v is not a valid integer", mrIidFloat)), nil
+			}
+
+			reviewerIdsStr, err := requiredParam[string](&request, "reviewerIds")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			newReviewers, err := ParseIDListString(reviewerIdsStr, "reviewer")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			if newReviewers == nil {
+				return mcp.NewToolResultError("Validation Error: reviewerIds must contain at least one user ID"), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Fetch the current reviewers, since the API replaces the whole list
+			mr, resp, err := glClient.MergeRequests.GetMergeRequest(projectID, mrIid, nil, gl.WithContext(ctx))
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("merge request %d in project %q", mrIid, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			ids := reviewerIDs(mr)
+			for _, id := range *newReviewers {
+				if !slices.Contains(ids, id) {
+					ids = append(ids, id)
+				}
+			}
+
+			// --- Call GitLab API
+			updated, resp, err := glClient.MergeRequests.UpdateMergeRequest(projectID, mrIid, &gl.UpdateMergeRequestOptions{
+				ReviewerIDs: &ids,
+			}, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("merge request %d in project %q", mrIid, projectID), "add merge request reviewer")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			return marshalReviewers(updated)
+		}
+}
+
+// RemoveMergeRequestReviewer defines the MCP tool for removing a single reviewer from a merge request.
+func RemoveMergeRequestReviewer(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"removeMergeRequestReviewer",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_REMOVE_MERGE_REQUEST_REVIEWER_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Remove GitLab Merge Request Reviewer",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("mergeRequestIid",
+				mcp.Description("The IID (internal ID, integer) of the merge request within the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("reviewerId",
+				mcp.Description("The user ID of the reviewer to remove."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			mrIidFloat, err := requiredParam[float64](&request, "mergeRequestIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			mrIid := int64(mrIidFloat)
+			if float64(mrIid) != mrIidFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: mergeRequestIid %v is not a valid integer", mrIidFloat)), nil
+			}
+
+			reviewerIDFloat, err := requiredParam[float64](&request, "reviewerId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			reviewerID := int64(reviewerIDFloat)
+			if float64(reviewerID) != reviewerIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: reviewerId %v is not a valid integer", reviewerIDFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Fetch the current reviewers, since the API replaces the whole list
+			mr, resp, err := glClient.MergeRequests.GetMergeRequest(projectID, mrIid, nil, gl.WithContext(ctx))
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("merge request %d in project %q", mrIid, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			current := reviewerIDs(mr)
+			if !slices.Contains(current, reviewerID) {
+				return mcp.NewToolResultError(fmt.Sprintf("user %d is not a reviewer of merge request %d in project %q", reviewerID, mrIid, projectID)), nil
+			}
+			ids := make([]int64, 0, len(current)-1)
+			for _, id := range current {
+				if id != reviewerID {
+					ids = append(ids, id)
+				}
+			}
+
+			// --- Call GitLab API; an empty list unassigns all reviewers
+			updated, resp, err := glClient.MergeRequests.UpdateMergeRequest(projectID, mrIid, &gl.UpdateMergeRequestOptions{
+				ReviewerIDs: &ids,
+			}, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("merge request %d in project %q", mrIid, projectID), "remove merge request reviewer")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			return marshalReviewers(updated)
+		}
+}
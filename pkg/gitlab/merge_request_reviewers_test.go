@@ -0,0 +1,243 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+// mergeRequestWithReviewers returns a merge request reviewed by the given user IDs.
+func mergeRequestWithReviewers(ids ...int64) *gl.MergeRequest {
+	mr := &gl.MergeRequest{BasicMergeRequest: gl.BasicMergeRequest{IID: 1}}
+	for _, id := range ids {
+		mr.Reviewers = append(mr.Reviewers, &gl.BasicUser{ID: id})
+	}
+	return mr
+}
+
+func TestListMergeRequestReviewersHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListMergeRequestReviewers(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockMRs, ctrl := setupMockClientForMergeRequests(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListMergeRequestReviewers(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockMRs.EXPECT().
+			GetMergeRequest("group/project", int64(1), gomock.Any(), gomock.Any()).
+			Return(mergeRequestWithReviewers(1, 2), &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 1.0,
+		}}})
+		require.NoError(t, err)
+		text := getTextResult(t, result).Text
+		assert.Contains(t, text, `"id":1`)
+		assert.Contains(t, text, `"id":2`)
+	})
+
+	t.Run("Success - No reviewers", func(t *testing.T) {
+		mockMRs.EXPECT().
+			GetMergeRequest("group/project", int64(1), gomock.Any(), gomock.Any()).
+			Return(mergeRequestWithReviewers(), &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 1.0,
+		}}})
+		require.NoError(t, err)
+		assert.Equal(t, "[]", getTextResult(t, result).Text)
+	})
+
+	t.Run("Error - Not Found (404)", func(t *testing.T) {
+		mockMRs.EXPECT().
+			GetMergeRequest("group/project", int64(99), gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 99.0,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "not found")
+	})
+}
+
+func TestAddMergeRequestReviewerHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := AddMergeRequestReviewer(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockMRs, ctrl := setupMockClientForMergeRequests(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := AddMergeRequestReviewer(mockGetClient, nil)
+
+	t.Run("Success - Appends to existing reviewers", func(t *testing.T) {
+		mockMRs.EXPECT().
+			GetMergeRequest("group/project", int64(1), gomock.Any(), gomock.Any()).
+			Return(mergeRequestWithReviewers(1, 2), &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+		mockMRs.EXPECT().
+			UpdateMergeRequest("group/project", int64(1), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ int64, opts *gl.UpdateMergeRequestOptions, _ ...gl.RequestOptionFunc) (*gl.MergeRequest, *gl.Response, error) {
+				require.NotNil(t, opts.ReviewerIDs)
+				assert.Equal(t, []int64{1, 2, 3}, *opts.ReviewerIDs, "existing reviewers are kept and duplicates skipped")
+				return mergeRequestWithReviewers(1, 2, 3), &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 1.0,
+			"reviewerIds":     "2,3",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"id":3`)
+	})
+
+	t.Run("Error - Invalid reviewer ID", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 1.0,
+			"reviewerIds":     "2,x",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `Validation Error: invalid reviewer ID "x"`)
+	})
+
+	t.Run("Error - No reviewer IDs", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 1.0,
+			"reviewerIds":     " , ",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: reviewerIds must contain at least one user ID")
+	})
+
+	t.Run("Error - Update rejected (422)", func(t *testing.T) {
+		mockMRs.EXPECT().
+			GetMergeRequest("group/project", int64(1), gomock.Any(), gomock.Any()).
+			Return(mergeRequestWithReviewers(), &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+		mockMRs.EXPECT().
+			UpdateMergeRequest("group/project", int64(1), gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 422}}, errors.New("gitlab: 422 Unprocessable Entity"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 1.0,
+			"reviewerIds":     "3",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "failed to add merge request reviewer")
+	})
+}
+
+func TestRemoveMergeRequestReviewerHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := RemoveMergeRequestReviewer(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockMRs, ctrl := setupMockClientForMergeRequests(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := RemoveMergeRequestReviewer(mockGetClient, nil)
+
+	t.Run("Success - Removes only the given reviewer", func(t *testing.T) {
+		mockMRs.EXPECT().
+			GetMergeRequest("group/project", int64(1), gomock.Any(), gomock.Any()).
+			Return(mergeRequestWithReviewers(1, 2), &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+		mockMRs.EXPECT().
+			UpdateMergeRequest("group/project", int64(1), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ int64, opts *gl.UpdateMergeRequestOptions, _ ...gl.RequestOptionFunc) (*gl.MergeRequest, *gl.Response, error) {
+				require.NotNil(t, opts.ReviewerIDs)
+				assert.Equal(t, []int64{2}, *opts.ReviewerIDs)
+				return mergeRequestWithReviewers(2), &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 1.0,
+			"reviewerId":      1.0,
+		}}})
+		require.NoError(t, err)
+		text := getTextResult(t, result).Text
+		assert.Contains(t, text, `"id":2`)
+		assert.NotContains(t, text, `"id":1,`)
+	})
+
+	t.Run("Success - Removing the last reviewer sends an empty list", func(t *testing.T) {
+		mockMRs.EXPECT().
+			GetMergeRequest("group/project", int64(1), gomock.Any(), gomock.Any()).
+			Return(mergeRequestWithReviewers(1), &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+		mockMRs.EXPECT().
+			UpdateMergeRequest("group/project", int64(1), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ int64, opts *gl.UpdateMergeRequestOptions, _ ...gl.RequestOptionFunc) (*gl.MergeRequest, *gl.Response, error) {
+				require.NotNil(t, opts.ReviewerIDs)
+				assert.Empty(t, *opts.ReviewerIDs)
+				return mergeRequestWithReviewers(), &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 1.0,
+			"reviewerId":      1.0,
+		}}})
+		require.NoError(t, err)
+		assert.Equal(t, "[]", getTextResult(t, result).Text)
+	})
+
+	t.Run("Error - User is not a reviewer", func(t *testing.T) {
+		mockMRs.EXPECT().
+			GetMergeRequest("group/project", int64(1), gomock.Any(), gomock.Any()).
+			Return(mergeRequestWithReviewers(2), &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 1.0,
+			"reviewerId":      7.0,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, `user 7 is not a reviewer of merge request 1 in project "group/project"`)
+	})
+
+	t.Run("Error - Non-integer reviewerId", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 1.0,
+			"reviewerId":      1.5,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: reviewerId 1.5 is not a valid integer")
+	})
+}
@@ -5,8 +5,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
+	"net/http"
 	"strconv"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -18,6 +21,7 @@ func GetMergeRequest(getClient GetClientFn, t map[string]string) (tool mcp.Tool,
 	return mcp.NewTool(
 			"getMergeRequest",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_MERGE_REQUEST_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        "Get GitLab Merge Request",
 				ReadOnlyHint: boolPtr(true),
@@ -30,6 +34,7 @@ func GetMergeRequest(getClient GetClientFn, t map[string]string) (tool mcp.Tool,
 				mcp.Required(),
 				mcp.Description("The IID (internal ID, integer) of the merge request within the project."),
 			),
+			WithFields(),
 		),
 		// Handler function implementation
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -49,6 +54,12 @@ func GetMergeRequest(getClient GetClientFn, t map[string]string) (tool mcp.Tool,
 				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: mergeRequestIid %v is not a valid integer", mrIidFloat)), nil
 			}
 
+			// --- Parse optional parameters
+			fields, err := OptionalFieldsParam(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
 			// --- Obtain GitLab client
 			glClient, err := getClient(ctx)
 			if err != nil {
@@ -72,6 +83,10 @@ func GetMergeRequest(getClient GetClientFn, t map[string]string) (tool mcp.Tool,
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal merge request data: %w", err)
 			}
+			data, err = filterFields(data, fields)
+			if err != nil {
+				return nil, fmt.Errorf("failed to filter merge request fields: %w", err)
+			}
 			return mcp.NewToolResultText(string(data)), nil
 		}
 }
@@ -82,6 +97,7 @@ func MergeRequestComment(getClient GetClientFn, t map[string]string) (tool mcp.T
 	return mcp.NewTool(
 			"mergeRequestComment",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_MERGE_REQUEST_COMMENT_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithString("action",
 				mcp.Description("The action to perform on the merge request comment"),
 				mcp.Required(),
@@ -237,6 +253,7 @@ func ListMergeRequests(getClient GetClientFn, t map[string]string) (tool mcp.Too
 	return mcp.NewTool(
 			"listMergeRequests",
 			mcp.WithDescription("Lists merge requests for a GitLab project with filtering and pagination options."),
+			WithGitLabServer(),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        "List GitLab Merge Requests",
 				ReadOnlyHint: boolPtr(true),
@@ -290,6 +307,7 @@ func ListMergeRequests(getClient GetClientFn, t map[string]string) (tool mcp.Too
 				mcp.Description("Return merge requests ordered by the specified field ('created_at', 'updated_at', or 'title'). Default: 'created_at'."),
 				mcp.Enum("created_at", "updated_at", "title"),
 			),
+			WithFields(),
 			// Add standard MCP pagination parameters
 			WithPagination(),
 		),
@@ -301,6 +319,11 @@ func ListMergeRequests(getClient GetClientFn, t map[string]string) (tool mcp.Too
 				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
 			}
 
+			fields, err := OptionalFieldsParam(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
 			// --- Obtain GitLab client
 			glClient, err := getClient(ctx)
 			if err != nil {
@@ -421,6 +444,9 @@ func ListMergeRequests(getClient GetClientFn, t map[string]string) (tool mcp.Too
 			if err != nil {
 				return nil, fmt.Errorf("failed to optimize merge requests response: %w", err)
 			}
+			if err := optimized.SelectFields(fields); err != nil {
+				return nil, fmt.Errorf("failed to filter merge request fields: %w", err)
+			}
 
 			// --- Handle empty result gracefully
 			if len(mrs) == 0 {
@@ -449,6 +475,7 @@ func CreateMergeRequest(getClient GetClientFn, t map[string]string) (tool mcp.To
 	return mcp.NewTool(
 			"createMergeRequest",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_CREATE_MERGE_REQUEST_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title: "Create GitLab Merge Request",
 			}),
@@ -479,6 +506,9 @@ func CreateMergeRequest(getClient GetClientFn, t map[string]string) (tool mcp.To
 			mcp.WithString("assigneeIds",
 				mcp.Description("Comma-separated list of user IDs to assign the merge request to."),
 			),
+			mcp.WithString("reviewerIds",
+				mcp.Description("Comma-separated list of user IDs to request a review from."),
+			),
 			mcp.WithNumber("milestoneId",
 				mcp.Description("The ID of the milestone to associate the merge request with."),
 			),
@@ -528,6 +558,11 @@ func CreateMergeRequest(getClient GetClientFn, t map[string]string) (tool mcp.To
 				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
 			}
 
+			reviewerIdsStr, err := OptionalParam[string](&request, "reviewerIds")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
 			milestoneIDFloat, err := OptionalParam[float64](&request, "milestoneId")
 			if err != nil {
 				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
@@ -574,6 +609,13 @@ func CreateMergeRequest(getClient GetClientFn, t map[string]string) (tool mcp.To
 				}
 			}
 
+			// Apply reviewer IDs using helper
+			if reviewerIdsStr != "" {
+				if err := ApplyReviewerIDsWithString(opts, reviewerIdsStr); err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+				}
+			}
+
 			if milestoneIDFloat != 0 {
 				milestoneID, err := ValidateAndConvertMilestoneID(milestoneIDFloat)
 				if err != nil {
@@ -616,6 +658,7 @@ func UpdateMergeRequest(getClient GetClientFn, t map[string]string) (tool mcp.To
 	return mcp.NewTool(
 			"updateMergeRequest",
 			mcp.WithDescription("Updates an existing merge request in a GitLab project."),
+			WithGitLabServer(),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title: "Update GitLab Merge Request",
 			}),
@@ -644,6 +687,9 @@ func UpdateMergeRequest(getClient GetClientFn, t map[string]string) (tool mcp.To
 			mcp.WithString("assigneeIds",
 				mcp.Description("Comma-separated list of user IDs to assign the merge request to."),
 			),
+			mcp.WithString("reviewerIds",
+				mcp.Description("Comma-separated list of user IDs to request a review from."),
+			),
 			mcp.WithNumber("milestoneId",
 				mcp.Description("The ID of the milestone to associate the merge request with."),
 			),
@@ -701,6 +747,11 @@ func UpdateMergeRequest(getClient GetClientFn, t map[string]string) (tool mcp.To
 				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
 			}
 
+			reviewerIdsStr, err := OptionalParam[string](&request, "reviewerIds")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
 			milestoneIDFloat, err := OptionalParam[float64](&request, "milestoneId")
 			if err != nil {
 				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
@@ -756,6 +807,13 @@ func UpdateMergeRequest(getClient GetClientFn, t map[string]string) (tool mcp.To
 				}
 			}
 
+			// Apply reviewer IDs using helper
+			if reviewerIdsStr != "" {
+				if err := ApplyReviewerIDsWithString(opts, reviewerIdsStr); err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+				}
+			}
+
 			if milestoneIDFloat != 0 {
 				milestoneID, err := ValidateAndConvertMilestoneID(milestoneIDFloat)
 				if err != nil {
@@ -804,3 +862,513 @@ func UpdateMergeRequest(getClient GetClientFn, t map[string]string) (tool mcp.To
 			return mcp.NewToolResultText(string(data)), nil
 		}
 }
+
+// MergeMergeRequest defines the MCP tool for merging (accepting) a GitLab merge request.
+func MergeMergeRequest(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"mergeMergeRequest",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_MERGE_MERGE_REQUEST_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Merge GitLab Merge Request",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("mergeRequestIid",
+				mcp.Description("The IID (internal ID, integer) of the merge request within the project."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithBoolean("shouldRemoveSourceBranch",
+				mcp.Description("Remove the source branch after the merge."),
+			),
+			mcp.WithBoolean("mergeWhenPipelineSucceeds",
+				mcp.Description("Merge automatically once the pipeline succeeds instead of merging immediately."),
+			),
+			mcp.WithString("sha",
+				mcp.Description("If given, the merge only happens if this matches the HEAD of the source branch."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			mrIidFloat, err := requiredParam[float64](&request, "mergeRequestIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			mrIid := int64(mrIidFloat)
+			if float64(mrIid) != mrIidFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: mergeRequestIid %v is not a valid integer", mrIidFloat)), nil
+			}
+
+			// --- Parse optional parameters
+			shouldRemoveSourceBranch, err := OptionalBoolParam(&request, "shouldRemoveSourceBranch")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			mergeWhenPipelineSucceeds, err := OptionalBoolParam(&request, "mergeWhenPipelineSucceeds")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			sha, err := OptionalParam[string](&request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.AcceptMergeRequestOptions{
+				ShouldRemoveSourceBranch: shouldRemoveSourceBranch,
+				// merge_when_pipeline_succeeds is deprecated in favour of auto_merge
+				AutoMerge: mergeWhenPipelineSucceeds,
+			}
+
+			if sha != "" {
+				opts.SHA = &sha
+			}
+
+			// --- Call GitLab API
+			mr, resp, err := glClient.MergeRequests.AcceptMergeRequest(projectID, mrIid, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				mrDesc := fmt.Sprintf("merge request %d in project %q", mrIid, projectID)
+				if resp != nil {
+					switch resp.StatusCode {
+					case http.StatusMethodNotAllowed:
+						return mcp.NewToolResultError(fmt.Sprintf("%s cannot be merged (405): it may be a draft, have conflicts, unresolved discussions or missing approvals", mrDesc)), nil
+					case http.StatusNotAcceptable:
+						return mcp.NewToolResultError(fmt.Sprintf("%s is not mergeable (406): the pipeline may have failed or the merge request is already merged or closed", mrDesc)), nil
+					case http.StatusConflict:
+						return mcp.NewToolResultError(fmt.Sprintf("%s was not merged (409): sha %q does not match the HEAD of the source branch", mrDesc, sha)), nil
+					}
+				}
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, mrDesc, "merge merge request")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(mr)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal merge request data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// RebaseMergeRequest defines the MCP tool for rebasing a merge request's source branch onto its target branch.
+func RebaseMergeRequest(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"rebaseMergeRequest",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_REBASE_MERGE_REQUEST_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Rebase GitLab Merge Request",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("mergeRequestIid",
+				mcp.Description("The IID (internal ID, integer) of the merge request within the project."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithBoolean("skipCi",
+				mcp.Description("Skip creating a CI pipeline for the rebased commits."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			mrIidFloat, err := requiredParam[float64](&request, "mergeRequestIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			mrIid := int64(mrIidFloat)
+			if float64(mrIid) != mrIidFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: mergeRequestIid %v is not a valid integer", mrIidFloat)), nil
+			}
+
+			// --- Parse optional parameters
+			skipCI, err := OptionalBoolParam(&request, "skipCi")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			opts := &gl.RebaseMergeRequestOptions{
+				SkipCI: skipCI,
+			}
+			resp, err := glClient.MergeRequests.RebaseMergeRequest(projectID, mrIid, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				mrDesc := fmt.Sprintf("merge request %d in project %q", mrIid, projectID)
+				if resp != nil {
+					switch resp.StatusCode {
+					case http.StatusForbidden:
+						return mcp.NewToolResultError(fmt.Sprintf("insufficient permissions to rebase %s (403): push access to the source branch is required", mrDesc)), nil
+					case http.StatusConflict:
+						return mcp.NewToolResultError(fmt.Sprintf("a rebase of %s is already in progress (409); check the merge request's rebase_in_progress field and retry once it has finished", mrDesc)), nil
+					}
+				}
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, mrDesc, "rebase merge request")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// The rebase runs asynchronously; GitLab only acknowledges the request here
+			return mcp.NewToolResultText(fmt.Sprintf(`{"message":"Rebase of merge request %d in project %q has been started","rebase_in_progress":true}`, mrIid, projectID)), nil
+		}
+}
+
+const (
+	// DefaultMaxDiffBytes is the default diff budget for getMergeRequestDiff and compareRepositoryRefs
+	DefaultMaxDiffBytes = 100 * 1024
+	// MaxMaxDiffBytes is the largest diff budget a caller may request
+	MaxMaxDiffBytes = 1024 * 1024
+)
+
+// mergeRequestFileDiff is a single file diff, flagged when its content was cut short
+type mergeRequestFileDiff struct {
+	*gl.Diff
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// mergeRequestDiffResult is the response of the getMergeRequestDiff tool
+type mergeRequestDiffResult struct {
+	VersionID      int64                  `json:"version_id"`
+	HeadCommitSHA  string                 `json:"head_commit_sha"`
+	BaseCommitSHA  string                 `json:"base_commit_sha"`
+	StartCommitSHA string                 `json:"start_commit_sha"`
+	Diffs          []mergeRequestFileDiff `json:"diffs"`
+	Truncated      bool                   `json:"truncated"`
+	MaxDiffBytes   int                    `json:"max_diff_bytes"`
+}
+
+// optionalMaxDiffBytes reads the maxDiffBytes parameter, falling back to DefaultMaxDiffBytes.
+func optionalMaxDiffBytes(request *mcp.CallToolRequest) (int, error) {
+	maxDiffBytesFloat, err := OptionalParam[float64](request, "maxDiffBytes")
+	if err != nil {
+		return 0, err
+	}
+	if maxDiffBytesFloat == 0 {
+		return DefaultMaxDiffBytes, nil
+	}
+	maxDiffBytes := int(maxDiffBytesFloat)
+	if float64(maxDiffBytes) != maxDiffBytesFloat || maxDiffBytes < 1 || maxDiffBytes > MaxMaxDiffBytes {
+		return 0, fmt.Errorf("maxDiffBytes must be an integer between 1 and %d, got %v", MaxMaxDiffBytes, maxDiffBytesFloat)
+	}
+	return maxDiffBytes, nil
+}
+
+// truncateDiffs caps the combined size of the diff contents at maxBytes.
+// Once the budget is used up, each remaining file diff is cut at a UTF-8 boundary
+// and ends with a note on how much of it was omitted.
+func truncateDiffs(diffs []*gl.Diff, maxBytes int) ([]mergeRequestFileDiff, bool) {
+	result := make([]mergeRequestFileDiff, 0, len(diffs))
+	remaining := maxBytes
+	truncated := false
+
+	for _, d := range diffs {
+		if len(d.Diff) <= remaining {
+			remaining -= len(d.Diff)
+			result = append(result, mergeRequestFileDiff{Diff: d})
+			continue
+		}
+
+		cut := remaining
+		for cut > 0 && !utf8.RuneStart(d.Diff[cut]) {
+			cut--
+		}
+
+		fileDiff := *d
+		fileDiff.Diff = d.Diff[:cut] + fmt.Sprintf("\n... [diff truncated: %d of %d bytes shown]", cut, len(d.Diff))
+		result = append(result, mergeRequestFileDiff{Diff: &fileDiff, Truncated: true})
+		remaining = 0
+		truncated = true
+	}
+
+	return result, truncated
+}
+
+// GetMergeRequestDiff defines the MCP tool for retrieving the latest diff of a merge request.
+func GetMergeRequestDiff(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"getMergeRequestDiff",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_MERGE_REQUEST_DIFF_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "Get GitLab Merge Request Diff",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("mergeRequestIid",
+				mcp.Description("The IID (internal ID, integer) of the merge request within the project."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithBoolean("unidiff",
+				mcp.Description("Return diffs in unified diff format."),
+			),
+			mcp.WithNumber("maxDiffBytes",
+				mcp.Description(fmt.Sprintf("Maximum combined size of all file diffs in bytes (default %d, max %d). Diffs beyond the limit are truncated.", DefaultMaxDiffBytes, MaxMaxDiffBytes)),
+				mcp.Min(1),
+				mcp.Max(MaxMaxDiffBytes),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			mrIidFloat, err := requiredParam[float64](&request, "mergeRequestIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			mrIid := int64(mrIidFloat)
+			if float64(mrIid) != mrIidFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: mergeRequestIid %v is not a valid integer", mrIidFloat)), nil
+			}
+
+			// --- Parse optional parameters
+			unidiff, err := OptionalBoolParam(&request, "unidiff")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			maxDiffBytes, err := optionalMaxDiffBytes(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			mrDesc := fmt.Sprintf("merge request %d in project %q", mrIid, projectID)
+
+			// --- Call GitLab API: versions are returned newest first
+			versions, resp, err := glClient.MergeRequests.GetMergeRequestDiffVersions(projectID, mrIid, &gl.GetMergeRequestDiffVersionsOptions{
+				ListOptions: gl.ListOptions{PerPage: 1},
+			}, gl.WithContext(ctx))
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, mrDesc)
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(versions) == 0 {
+				data, err := json.Marshal(mergeRequestDiffResult{Diffs: []mergeRequestFileDiff{}, MaxDiffBytes: maxDiffBytes})
+				if err != nil {
+					return nil, fmt.Errorf("failed to marshal merge request diff: %w", err)
+				}
+				return mcp.NewToolResultText(string(data)), nil
+			}
+
+			version, resp, err := glClient.MergeRequests.GetSingleMergeRequestDiffVersion(projectID, mrIid, versions[0].ID, &gl.GetSingleMergeRequestDiffVersionOptions{
+				Unidiff: unidiff,
+			}, gl.WithContext(ctx))
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("diff version %d of %s", versions[0].ID, mrDesc))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			diffs, truncated := truncateDiffs(version.Diffs, maxDiffBytes)
+
+			// --- Marshal and return success
+			data, err := json.Marshal(mergeRequestDiffResult{
+				VersionID:      version.ID,
+				HeadCommitSHA:  version.HeadCommitSHA,
+				BaseCommitSHA:  version.BaseCommitSHA,
+				StartCommitSHA: version.StartCommitSHA,
+				Diffs:          diffs,
+				Truncated:      truncated,
+				MaxDiffBytes:   maxDiffBytes,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal merge request diff: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// MaxDiffStatsFiles caps the number of per-file entries returned by getMergeRequestDiffStats.
+const MaxDiffStatsFiles = 200
+
+// mergeRequestFileStats holds the line counts of a single changed file
+type mergeRequestFileStats struct {
+	Path        string `json:"path"`
+	OldPath     string `json:"old_path,omitempty"`
+	Additions   int    `json:"additions"`
+	Deletions   int    `json:"deletions"`
+	NewFile     bool   `json:"new_file"`
+	DeletedFile bool   `json:"deleted_file"`
+	RenamedFile bool   `json:"renamed_file"`
+	TooLarge    bool   `json:"too_large,omitempty"`
+}
+
+// mergeRequestDiffStats is the response of the getMergeRequestDiffStats tool
+type mergeRequestDiffStats struct {
+	FilesChanged int                     `json:"files_changed"`
+	Additions    int                     `json:"additions"`
+	Deletions    int                     `json:"deletions"`
+	Files        []mergeRequestFileStats `json:"files"`
+	Truncated    bool                    `json:"truncated"`
+}
+
+// countDiffLines counts the added and removed lines of a diff in GitLab's format, which
+// starts at the first hunk header and has no ---/+++ file headers.
+func countDiffLines(diff string) (additions, deletions int) {
+	for _, line := range strings.Split(diff, "\n") {
+		switch {
+		case strings.HasPrefix(line, "+"):
+			additions++
+		case strings.HasPrefix(line, "-"):
+			deletions++
+		}
+	}
+	return additions, deletions
+}
+
+// GetMergeRequestDiffStats defines the MCP tool for summarising the size of a merge request.
+func GetMergeRequestDiffStats(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"getMergeRequestDiffStats",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_MERGE_REQUEST_DIFF_STATS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "Get GitLab Merge Request Diff Stats",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("mergeRequestIid",
+				mcp.Description("The IID (internal ID, integer) of the merge request within the project."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			mrIidFloat, err := requiredParam[float64](&request, "mergeRequestIid")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			mrIid := int64(mrIidFloat)
+			if float64(mrIid) != mrIidFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: mergeRequestIid %v is not a valid integer", mrIidFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API, page by page; totals cover every file, the file list is capped
+			stats := mergeRequestDiffStats{Files: []mergeRequestFileStats{}}
+			opts := &gl.ListMergeRequestDiffsOptions{ListOptions: gl.ListOptions{Page: 1, PerPage: 100}}
+			for {
+				diffs, resp, err := glClient.MergeRequests.ListMergeRequestDiffs(projectID, mrIid, opts, gl.WithContext(ctx))
+
+				// --- Handle API errors
+				if err != nil {
+					result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("diffs of merge request %d in project %q", mrIid, projectID))
+					if result != nil {
+						return result, nil
+					}
+					return nil, apiErr
+				}
+
+				for _, d := range diffs {
+					additions, deletions := countDiffLines(d.Diff)
+					stats.FilesChanged++
+					stats.Additions += additions
+					stats.Deletions += deletions
+
+					if len(stats.Files) == MaxDiffStatsFiles {
+						stats.Truncated = true
+						continue
+					}
+					fileStats := mergeRequestFileStats{
+						Path:        d.NewPath,
+						Additions:   additions,
+						Deletions:   deletions,
+						NewFile:     d.NewFile,
+						DeletedFile: d.DeletedFile,
+						RenamedFile: d.RenamedFile,
+						TooLarge:    d.TooLarge,
+					}
+					if d.RenamedFile {
+						fileStats.OldPath = d.OldPath
+					}
+					stats.Files = append(stats.Files, fileStats)
+				}
+
+				if resp == nil || resp.NextPage == 0 {
+					break
+				}
+				opts.Page = resp.NextPage
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(stats)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal merge request diff stats: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
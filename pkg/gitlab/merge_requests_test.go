@@ -1034,6 +1034,42 @@ func convertToBasicMRs(mrs []*gl.MergeRequest) []*gl.BasicMergeRequest {
 	return basicMRs
 }
 
+func TestListMergeRequestsHandler_Fields(t *testing.T) {
+	ctx := context.Background()
+	mockClient, mockMRs, ctrl := setupMockClientForMergeRequests(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+	_, handler := ListMergeRequests(mockGetClient, nil)
+
+	mockMRs.EXPECT().
+		ListProjectMergeRequests("group/project", gomock.Any(), gomock.Any()).
+		Return([]*gl.BasicMergeRequest{
+			{IID: 1, Title: "First", State: "opened", SourceBranch: "feature-1"},
+			{IID: 2, Title: "Second", State: "merged", SourceBranch: "feature-2"},
+		}, &gl.Response{Response: &http.Response{StatusCode: 200}, TotalItems: 2, CurrentPage: 1}, nil)
+
+	result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"projectId": "group/project",
+		"fields":    "iid,title",
+	}}})
+	require.NoError(t, err)
+
+	var response struct {
+		Items      []map[string]any    `json:"items"`
+		Pagination *PaginationMetadata `json:"pagination"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+	assert.Equal(t, []map[string]any{
+		{"iid": float64(1), "title": "First"},
+		{"iid": float64(2), "title": "Second"},
+	}, response.Items)
+	require.NotNil(t, response.Pagination)
+	assert.Equal(t, int64(2), response.Pagination.TotalItems)
+}
+
 // TestCreateMergeRequestHandler tests the CreateMergeRequest tool handler
 func TestCreateMergeRequestHandler(t *testing.T) {
 	// Tool schema snapshot test
@@ -1117,6 +1153,7 @@ func TestCreateMergeRequestHandler(t *testing.T) {
 				"description":        "This is a complete MR",
 				"labels":             "bug,critical",
 				"assigneeIds":        "1,2",
+				"reviewerIds":        "3, 4",
 				"milestoneId":        5.0,
 				"removeSourceBranch": true,
 				"squash":             true,
@@ -1143,6 +1180,8 @@ func TestCreateMergeRequestHandler(t *testing.T) {
 						assert.NotNil(t, opts.Labels)
 						assert.NotNil(t, opts.AssigneeIDs)
 						assert.Equal(t, 2, len(*opts.AssigneeIDs))
+						require.NotNil(t, opts.ReviewerIDs)
+						assert.Equal(t, []int64{3, 4}, *opts.ReviewerIDs)
 						assert.Equal(t, int64(5), *opts.MilestoneID)
 						assert.NotNil(t, opts.RemoveSourceBranch)
 						assert.True(t, *opts.RemoveSourceBranch)
@@ -1426,6 +1465,18 @@ func TestUpdateMergeRequestHandler(t *testing.T) {
 			expectResultError:   true,
 			expectInternalError: false,
 		},
+		{
+			name: "Error - Invalid reviewer IDs format",
+			args: map[string]any{
+				"projectId":       projectID,
+				"mergeRequestIid": mrIid,
+				"reviewerIds":     "12,x",
+			},
+			mockSetup:           func() {},
+			expectedResult:      "Validation Error: invalid reviewer ID \"x\"",
+			expectResultError:   true,
+			expectInternalError: false,
+		},
 		{
 			name: "Error - Invalid milestoneId (not integer)",
 			args: map[string]any{
@@ -1458,6 +1509,7 @@ func TestUpdateMergeRequestHandler(t *testing.T) {
 				"description":     "Updated description",
 				"labels":          "bug,enhancement",
 				"assigneeIds":     "123,456",
+				"reviewerIds":     "789",
 				"milestoneId":     5.0,
 			},
 			mockSetup: func() {
@@ -1477,6 +1529,8 @@ func TestUpdateMergeRequestHandler(t *testing.T) {
 						assert.Equal(t, "Updated description", *opts.Description)
 						assert.NotNil(t, opts.Labels)
 						assert.NotNil(t, opts.AssigneeIDs)
+						require.NotNil(t, opts.ReviewerIDs)
+						assert.Equal(t, []int64{789}, *opts.ReviewerIDs)
 						assert.Equal(t, int64(5), *opts.MilestoneID)
 						return expectedMR, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
 					})
@@ -1987,3 +2041,437 @@ func TestUpdateMergeRequestCommentHandler(t *testing.T) {
 		})
 	}
 }
+
+// TestMergeMergeRequestHandler tests the MergeMergeRequest tool
+func TestMergeMergeRequestHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := MergeMergeRequest(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockMRs, ctrl := setupMockClientForMergeRequests(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := MergeMergeRequest(mockGetClient, nil)
+
+	projectID := "group/project"
+
+	tests := []struct {
+		name                string
+		args                map[string]any
+		mockSetup           func()
+		expectResultError   bool
+		expectInternalError bool
+		contains            string
+	}{
+		{
+			name: "Success - Options are forwarded",
+			args: map[string]any{
+				"projectId":                 projectID,
+				"mergeRequestIid":           1.0,
+				"shouldRemoveSourceBranch":  true,
+				"mergeWhenPipelineSucceeds": true,
+				"sha":                       "abc123",
+			},
+			mockSetup: func() {
+				mockMRs.EXPECT().
+					AcceptMergeRequest(projectID, int64(1), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, _ int64, opts *gl.AcceptMergeRequestOptions, _ ...gl.RequestOptionFunc) (*gl.MergeRequest, *gl.Response, error) {
+						assert.True(t, *opts.ShouldRemoveSourceBranch)
+						assert.True(t, *opts.AutoMerge)
+						assert.Equal(t, "abc123", *opts.SHA)
+						mr := &gl.MergeRequest{}
+						mr.IID = 1
+						mr.State = "merged"
+						return mr, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+					})
+			},
+			contains: `"state":"merged"`,
+		},
+		{
+			name: "Error - Merge blocked (405)",
+			args: map[string]any{"projectId": projectID, "mergeRequestIid": 2.0},
+			mockSetup: func() {
+				mockMRs.EXPECT().
+					AcceptMergeRequest(projectID, int64(2), gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 405}}, errors.New("gitlab: 405 Method Not Allowed"))
+			},
+			expectResultError: true,
+			contains:          "cannot be merged (405)",
+		},
+		{
+			name: "Error - Not mergeable (406)",
+			args: map[string]any{"projectId": projectID, "mergeRequestIid": 3.0},
+			mockSetup: func() {
+				mockMRs.EXPECT().
+					AcceptMergeRequest(projectID, int64(3), gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 406}}, errors.New("gitlab: 406 Branch cannot be merged"))
+			},
+			expectResultError: true,
+			contains:          "is not mergeable (406)",
+		},
+		{
+			name: "Error - SHA mismatch (409)",
+			args: map[string]any{"projectId": projectID, "mergeRequestIid": 4.0, "sha": "stale"},
+			mockSetup: func() {
+				mockMRs.EXPECT().
+					AcceptMergeRequest(projectID, int64(4), gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 409}}, errors.New("gitlab: 409 SHA does not match HEAD of source branch"))
+			},
+			expectResultError: true,
+			contains:          `sha "stale" does not match`,
+		},
+		{
+			name:              "Error - Missing mergeRequestIid",
+			args:              map[string]any{"projectId": projectID},
+			mockSetup:         func() {},
+			expectResultError: true,
+			contains:          "Validation Error: missing required parameter: mergeRequestIid",
+		},
+		{
+			name: "Error - GitLab API Error (500)",
+			args: map[string]any{"projectId": projectID, "mergeRequestIid": 5.0},
+			mockSetup: func() {
+				mockMRs.EXPECT().
+					AcceptMergeRequest(projectID, int64(5), gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 500}}, errors.New("gitlab: 500 Internal Server Error"))
+			},
+			expectInternalError: true,
+			contains:            "failed to merge merge request",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}})
+
+			if tc.expectInternalError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.contains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, tc.expectResultError, result.IsError)
+			assert.Contains(t, getTextResult(t, result).Text, tc.contains)
+		})
+	}
+}
+
+// TestRebaseMergeRequestHandler tests the RebaseMergeRequest tool
+func TestRebaseMergeRequestHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := RebaseMergeRequest(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockMRs, ctrl := setupMockClientForMergeRequests(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := RebaseMergeRequest(mockGetClient, nil)
+
+	projectID := "group/project"
+
+	tests := []struct {
+		name                string
+		args                map[string]any
+		mockSetup           func()
+		expectResultError   bool
+		expectInternalError bool
+		contains            string
+	}{
+		{
+			name: "Success - Rebase started",
+			args: map[string]any{"projectId": projectID, "mergeRequestIid": 1.0, "skipCi": true},
+			mockSetup: func() {
+				mockMRs.EXPECT().
+					RebaseMergeRequest(projectID, int64(1), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, _ int64, opts *gl.RebaseMergeRequestOptions, _ ...gl.RequestOptionFunc) (*gl.Response, error) {
+						assert.True(t, *opts.SkipCI)
+						return &gl.Response{Response: &http.Response{StatusCode: 202}}, nil
+					})
+			},
+			contains: `"rebase_in_progress":true`,
+		},
+		{
+			name: "Error - Insufficient permissions (403)",
+			args: map[string]any{"projectId": projectID, "mergeRequestIid": 2.0},
+			mockSetup: func() {
+				mockMRs.EXPECT().
+					RebaseMergeRequest(projectID, int64(2), gomock.Any(), gomock.Any()).
+					Return(&gl.Response{Response: &http.Response{StatusCode: 403}}, errors.New("gitlab: 403 Forbidden"))
+			},
+			expectResultError: true,
+			contains:          "insufficient permissions",
+		},
+		{
+			name: "Error - Rebase in progress (409)",
+			args: map[string]any{"projectId": projectID, "mergeRequestIid": 3.0},
+			mockSetup: func() {
+				mockMRs.EXPECT().
+					RebaseMergeRequest(projectID, int64(3), gomock.Any(), gomock.Any()).
+					Return(&gl.Response{Response: &http.Response{StatusCode: 409}}, errors.New("gitlab: 409 Conflict"))
+			},
+			expectResultError: true,
+			contains:          "already in progress",
+		},
+		{
+			name: "Error - GitLab API Error (500)",
+			args: map[string]any{"projectId": projectID, "mergeRequestIid": 4.0},
+			mockSetup: func() {
+				mockMRs.EXPECT().
+					RebaseMergeRequest(projectID, int64(4), gomock.Any(), gomock.Any()).
+					Return(&gl.Response{Response: &http.Response{StatusCode: 500}}, errors.New("gitlab: 500 Internal Server Error"))
+			},
+			expectInternalError: true,
+			contains:            "failed to rebase merge request",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}})
+
+			if tc.expectInternalError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.contains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, tc.expectResultError, result.IsError)
+			assert.Contains(t, getTextResult(t, result).Text, tc.contains)
+		})
+	}
+}
+
+// TestGetMergeRequestDiffHandler tests the GetMergeRequestDiff tool
+func TestGetMergeRequestDiffHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetMergeRequestDiff(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockMRs, ctrl := setupMockClientForMergeRequests(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := GetMergeRequestDiff(mockGetClient, nil)
+
+	projectID := "group/project"
+	okResp := &gl.Response{Response: &http.Response{StatusCode: 200}}
+
+	t.Run("Success - Latest version within budget", func(t *testing.T) {
+		mockMRs.EXPECT().
+			GetMergeRequestDiffVersions(projectID, int64(1), gomock.Any(), gomock.Any()).
+			Return([]*gl.MergeRequestDiffVersion{{ID: 7}}, okResp, nil)
+		mockMRs.EXPECT().
+			GetSingleMergeRequestDiffVersion(projectID, int64(1), int64(7), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ int64, _ int64, opts *gl.GetSingleMergeRequestDiffVersionOptions, _ ...gl.RequestOptionFunc) (*gl.MergeRequestDiffVersion, *gl.Response, error) {
+				assert.True(t, *opts.Unidiff)
+				return &gl.MergeRequestDiffVersion{
+					ID:            7,
+					HeadCommitSHA: "head",
+					Diffs:         []*gl.Diff{{NewPath: "main.go", Diff: "@@ -1 +1 @@\n-a\n+b\n"}},
+				}, okResp, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       projectID,
+			"mergeRequestIid": 1.0,
+			"unidiff":         true,
+		}}})
+		require.NoError(t, err)
+
+		var diff mergeRequestDiffResult
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &diff))
+		assert.Equal(t, int64(7), diff.VersionID)
+		assert.Equal(t, "head", diff.HeadCommitSHA)
+		assert.False(t, diff.Truncated)
+		assert.Equal(t, DefaultMaxDiffBytes, diff.MaxDiffBytes)
+		require.Len(t, diff.Diffs, 1)
+		assert.Equal(t, "main.go", diff.Diffs[0].NewPath)
+	})
+
+	t.Run("Success - Diffs beyond maxDiffBytes are truncated", func(t *testing.T) {
+		mockMRs.EXPECT().
+			GetMergeRequestDiffVersions(projectID, int64(2), gomock.Any(), gomock.Any()).
+			Return([]*gl.MergeRequestDiffVersion{{ID: 8}}, okResp, nil)
+		mockMRs.EXPECT().
+			GetSingleMergeRequestDiffVersion(projectID, int64(2), int64(8), gomock.Any(), gomock.Any()).
+			Return(&gl.MergeRequestDiffVersion{
+				ID: 8,
+				Diffs: []*gl.Diff{
+					{NewPath: "a.go", Diff: strings.Repeat("a", 6)},
+					{NewPath: "b.go", Diff: strings.Repeat("b", 10)},
+					{NewPath: "c.go", Diff: "c"},
+				},
+			}, okResp, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       projectID,
+			"mergeRequestIid": 2.0,
+			"maxDiffBytes":    10.0,
+		}}})
+		require.NoError(t, err)
+
+		var diff mergeRequestDiffResult
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &diff))
+		assert.True(t, diff.Truncated)
+		require.Len(t, diff.Diffs, 3)
+		assert.False(t, diff.Diffs[0].Truncated)
+		assert.True(t, diff.Diffs[1].Truncated)
+		assert.True(t, strings.HasPrefix(diff.Diffs[1].Diff.Diff, "bbbb\n"))
+		assert.Contains(t, diff.Diffs[1].Diff.Diff, "[diff truncated: 4 of 10 bytes shown]")
+		assert.True(t, diff.Diffs[2].Truncated)
+	})
+
+	t.Run("Success - No diff versions", func(t *testing.T) {
+		mockMRs.EXPECT().
+			GetMergeRequestDiffVersions(projectID, int64(3), gomock.Any(), gomock.Any()).
+			Return([]*gl.MergeRequestDiffVersion{}, okResp, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       projectID,
+			"mergeRequestIid": 3.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"diffs":[]`)
+	})
+
+	t.Run("Error - maxDiffBytes above limit", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       projectID,
+			"mergeRequestIid": 1.0,
+			"maxDiffBytes":    float64(MaxMaxDiffBytes + 1),
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: maxDiffBytes must be an integer between 1 and")
+	})
+
+	t.Run("Error - Merge Request Not Found (404)", func(t *testing.T) {
+		mockMRs.EXPECT().
+			GetMergeRequestDiffVersions(projectID, int64(99), gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       projectID,
+			"mergeRequestIid": 99.0,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "merge request 99 in project \"group/project\" not found")
+	})
+}
+
+// TestGetMergeRequestDiffStatsHandler tests the GetMergeRequestDiffStats tool
+func TestGetMergeRequestDiffStatsHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetMergeRequestDiffStats(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockMRs, ctrl := setupMockClientForMergeRequests(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := GetMergeRequestDiffStats(mockGetClient, nil)
+
+	projectID := "group/project"
+
+	t.Run("Success - Totals across pages", func(t *testing.T) {
+		gomock.InOrder(
+			mockMRs.EXPECT().
+				ListMergeRequestDiffs(projectID, int64(1), &gl.ListMergeRequestDiffsOptions{ListOptions: gl.ListOptions{Page: 1, PerPage: 100}}, gomock.Any()).
+				Return([]*gl.MergeRequestDiff{
+					{OldPath: "main.go", NewPath: "main.go", Diff: "@@ -1,2 +1,3 @@\n-a\n+b\n+c\n d\n"},
+					{OldPath: "old.go", NewPath: "new.go", RenamedFile: true, Diff: "@@ -1 +1 @@\n---x\n+++x\n"},
+				}, &gl.Response{Response: &http.Response{StatusCode: 200}, NextPage: 2}, nil),
+			mockMRs.EXPECT().
+				ListMergeRequestDiffs(projectID, int64(1), &gl.ListMergeRequestDiffsOptions{ListOptions: gl.ListOptions{Page: 2, PerPage: 100}}, gomock.Any()).
+				Return([]*gl.MergeRequestDiff{
+					{OldPath: "gone.go", NewPath: "gone.go", DeletedFile: true, Diff: "@@ -1 +0,0 @@\n-x\n\\ No newline at end of file\n"},
+				}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil),
+		)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       projectID,
+			"mergeRequestIid": 1.0,
+		}}})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"files_changed": 3, "additions": 3, "deletions": 3, "truncated": false,
+			"files": [
+				{"path": "main.go", "additions": 2, "deletions": 1, "new_file": false, "deleted_file": false, "renamed_file": false},
+				{"path": "new.go", "old_path": "old.go", "additions": 1, "deletions": 1, "new_file": false, "deleted_file": false, "renamed_file": true},
+				{"path": "gone.go", "additions": 0, "deletions": 1, "new_file": false, "deleted_file": true, "renamed_file": false}
+			]
+		}`, getTextResult(t, result).Text)
+	})
+
+	t.Run("Success - File list truncated", func(t *testing.T) {
+		diffs := make([]*gl.MergeRequestDiff, MaxDiffStatsFiles+5)
+		for i := range diffs {
+			diffs[i] = &gl.MergeRequestDiff{NewPath: fmt.Sprintf("file%d.go", i), NewFile: true, Diff: "@@ -0,0 +1 @@\n+x\n"}
+		}
+		mockMRs.EXPECT().
+			ListMergeRequestDiffs(projectID, int64(2), gomock.Any(), gomock.Any()).
+			Return(diffs, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       projectID,
+			"mergeRequestIid": 2.0,
+		}}})
+		require.NoError(t, err)
+
+		var stats mergeRequestDiffStats
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &stats))
+		assert.Equal(t, MaxDiffStatsFiles+5, stats.FilesChanged)
+		assert.Equal(t, MaxDiffStatsFiles+5, stats.Additions)
+		assert.Len(t, stats.Files, MaxDiffStatsFiles)
+		assert.True(t, stats.Truncated)
+	})
+
+	t.Run("Error - Merge Request Not Found (404)", func(t *testing.T) {
+		mockMRs.EXPECT().
+			ListMergeRequestDiffs(projectID, int64(99), gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       projectID,
+			"mergeRequestIid": 99.0,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, `diffs of merge request 99 in project "group/project" not found`)
+	})
+
+	t.Run("Error - Missing mergeRequestIid", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": projectID,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: mergeRequestIid")
+	})
+}
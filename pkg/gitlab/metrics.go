@@ -0,0 +1,86 @@
+package gitlab
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Metrics records the number of invocations, errors and the cumulative duration of each
+// tool. It is safe for concurrent use.
+type Metrics struct {
+	tools sync.Map // tool name -> *toolCounters
+}
+
+// toolCounters holds the counters of a single tool.
+type toolCounters struct {
+	TotalRequests   atomic.Int64
+	TotalErrors     atomic.Int64
+	TotalDurationMs atomic.Int64
+}
+
+// ToolMetrics is a point-in-time copy of the counters of a single tool.
+type ToolMetrics struct {
+	TotalRequests   int64 `json:"totalRequests"`
+	TotalErrors     int64 `json:"totalErrors"`
+	TotalDurationMs int64 `json:"totalDurationMs"`
+}
+
+// NewMetrics creates an empty Metrics.
+func NewMetrics() *Metrics {
+	return &Metrics{}
+}
+
+// WithMetrics records every tool invocation in m.
+func WithMetrics(m *Metrics) server.ServerOption {
+	return server.WithToolHandlerMiddleware(func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return m.metricsMiddleware(request.Params.Name, next)(ctx, request)
+		}
+	})
+}
+
+// metricsMiddleware wraps handler so that each call is counted under toolName. A call is an
+// error if the handler returns an error or a tool error result.
+func (m *Metrics) metricsMiddleware(toolName string, handler server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		start := time.Now()
+		result, err := handler(ctx, request)
+
+		counters := m.counters(toolName)
+		counters.TotalRequests.Add(1)
+		counters.TotalDurationMs.Add(time.Since(start).Milliseconds())
+		if err != nil || (result != nil && result.IsError) {
+			counters.TotalErrors.Add(1)
+		}
+		return result, err
+	}
+}
+
+// counters returns the counters of toolName, creating them on first use.
+func (m *Metrics) counters(toolName string) *toolCounters {
+	if c, ok := m.tools.Load(toolName); ok {
+		return c.(*toolCounters)
+	}
+	c, _ := m.tools.LoadOrStore(toolName, &toolCounters{})
+	return c.(*toolCounters)
+}
+
+// GetMetricsSnapshot returns the current counters of every tool that has been called.
+func (m *Metrics) GetMetricsSnapshot() map[string]ToolMetrics {
+	snapshot := make(map[string]ToolMetrics)
+	m.tools.Range(func(key, value any) bool {
+		c := value.(*toolCounters)
+		snapshot[key.(string)] = ToolMetrics{
+			TotalRequests:   c.TotalRequests.Load(),
+			TotalErrors:     c.TotalErrors.Load(),
+			TotalDurationMs: c.TotalDurationMs.Load(),
+		}
+		return true
+	})
+	return snapshot
+}
@@ -0,0 +1,84 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsMiddleware(t *testing.T) {
+	m := NewMetrics()
+
+	ok := m.metricsMiddleware("getProject", func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		time.Sleep(5 * time.Millisecond)
+		return mcp.NewToolResultText("ok"), nil
+	})
+	toolError := m.metricsMiddleware("getProject", func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultError("not found"), nil
+	})
+	goError := m.metricsMiddleware("listIssues", func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return nil, errors.New("boom")
+	})
+
+	result, err := ok(context.Background(), mcp.CallToolRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "ok", getTextResult(t, result).Text, "result is passed through")
+	_, _ = toolError(context.Background(), mcp.CallToolRequest{})
+	_, err = goError(context.Background(), mcp.CallToolRequest{})
+	assert.EqualError(t, err, "boom", "error is passed through")
+
+	snapshot := m.GetMetricsSnapshot()
+	require.Len(t, snapshot, 2)
+	assert.Equal(t, int64(2), snapshot["getProject"].TotalRequests)
+	assert.Equal(t, int64(1), snapshot["getProject"].TotalErrors)
+	assert.GreaterOrEqual(t, snapshot["getProject"].TotalDurationMs, int64(5))
+	assert.Equal(t, ToolMetrics{TotalRequests: 1, TotalErrors: 1, TotalDurationMs: snapshot["listIssues"].TotalDurationMs}, snapshot["listIssues"])
+}
+
+func TestMetricsMiddleware_Concurrent(t *testing.T) {
+	m := NewMetrics()
+	handler := m.metricsMiddleware("getProject", func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText("ok"), nil
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = handler(context.Background(), mcp.CallToolRequest{})
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int64(50), m.GetMetricsSnapshot()["getProject"].TotalRequests)
+}
+
+func TestGetServerMetadata_ToolMetrics(t *testing.T) {
+	m := NewMetrics()
+	_, _ = m.metricsMiddleware("getProject", func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText("ok"), nil
+	})(context.Background(), mcp.CallToolRequest{})
+
+	_, handler := GetServerMetadata(NewTokenStore(), nil, ServerInfo{Metrics: m}, nil)
+	result, err := handler(context.Background(), *createMCPRequest(map[string]any{}))
+	require.NoError(t, err)
+
+	var metadata struct {
+		ToolMetrics map[string]ToolMetrics `json:"toolMetrics"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &metadata))
+	assert.Equal(t, int64(1), metadata.ToolMetrics["getProject"].TotalRequests)
+
+	_, handler = GetServerMetadata(NewTokenStore(), nil, ServerInfo{}, nil)
+	result, err = handler(context.Background(), *createMCPRequest(map[string]any{}))
+	require.NoError(t, err)
+	assert.NotContains(t, getTextResult(t, result).Text, "toolMetrics", "omitted without metrics")
+}
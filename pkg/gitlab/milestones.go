@@ -17,6 +17,7 @@ func Milestone(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handl
 	return mcp.NewTool(
 			"milestone",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_MILESTONE_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title: "Manage GitLab Milestone",
 			}),
@@ -287,6 +288,7 @@ func ListMilestones(getClient GetClientFn, t map[string]string) (tool mcp.Tool,
 	return mcp.NewTool(
 			"listMilestones",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_MILESTONES_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        "List GitLab Milestones",
 				ReadOnlyHint: boolPtr(true),
@@ -386,3 +388,704 @@ func ListMilestones(getClient GetClientFn, t map[string]string) (tool mcp.Tool,
 			return mcp.NewToolResultText(string(data)), nil
 		}
 }
+
+// ListProjectMilestones defines the MCP tool for listing project milestones with
+// title and IID filters in addition to the state and search filters.
+func ListProjectMilestones(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listProjectMilestones",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_PROJECT_MILESTONES_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Project Milestones",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			// Optional filtering parameters
+			mcp.WithString("state",
+				mcp.Description("Return only active or closed milestones."),
+				mcp.Enum("active", "closed"),
+			),
+			mcp.WithString("title",
+				mcp.Description("Return only the milestone with the given title."),
+			),
+			mcp.WithString("search",
+				mcp.Description("Search milestones against their title and description."),
+			),
+			mcp.WithString("iids",
+				mcp.Description("Comma-separated list of milestone IIDs to return (e.g. '1,2,3')."),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		// Handler function implementation
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional filtering parameters
+			state, err := OptionalParam[string](&request, "state")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			title, err := OptionalParam[string](&request, "title")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			search, err := OptionalParam[string](&request, "search")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			iidsStr, err := OptionalParam[string](&request, "iids")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			iids, err := ParseIDListString(iidsStr, "milestone IID")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.ListMilestonesOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+				IIDs: iids,
+			}
+
+			if state != "" {
+				opts.State = &state
+			}
+
+			if title != "" {
+				opts.Title = &title
+			}
+
+			if search != "" {
+				opts.Search = &search
+			}
+
+			// --- Call GitLab API
+			milestones, resp, err := glClient.Milestones.ListMilestones(projectID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, fmt.Sprintf("milestones from project %q", projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(milestones) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Truncate long text fields for list operations
+			truncator := NewTextTruncator(MaxFieldLength)
+			truncatedMilestones, err := truncator.TruncateListResponse(milestones, MilestoneFields)
+			if err != nil {
+				return nil, fmt.Errorf("failed to truncate milestones: %w", err)
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(truncatedMilestones)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal milestones list: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// CreateProjectMilestone defines the MCP tool for creating a milestone in a GitLab project.
+func CreateProjectMilestone(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"createProjectMilestone",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_CREATE_PROJECT_MILESTONE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Create GitLab Project Milestone",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("title",
+				mcp.Description("The title of the milestone."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithString("description",
+				mcp.Description("The description of the milestone."),
+			),
+			mcp.WithString("dueDate",
+				mcp.Description("The due date of the milestone (ISO 8601 format: YYYY-MM-DD)."),
+			),
+			mcp.WithString("startDate",
+				mcp.Description("The start date of the milestone (ISO 8601 format: YYYY-MM-DD)."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			return milestoneCreate(ctx, &request, glClient, projectID)
+		}
+}
+
+// UpdateProjectMilestone defines the MCP tool for updating a milestone in a GitLab project.
+func UpdateProjectMilestone(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"updateProjectMilestone",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_UPDATE_PROJECT_MILESTONE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Update GitLab Project Milestone",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("milestoneId",
+				mcp.Description("The ID of the milestone to update."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithString("title",
+				mcp.Description("The new title of the milestone."),
+			),
+			mcp.WithString("description",
+				mcp.Description("The new description of the milestone."),
+			),
+			mcp.WithString("dueDate",
+				mcp.Description("The due date of the milestone (ISO 8601 format: YYYY-MM-DD)."),
+			),
+			mcp.WithString("startDate",
+				mcp.Description("The start date of the milestone (ISO 8601 format: YYYY-MM-DD)."),
+			),
+			mcp.WithString("stateEvent",
+				mcp.Description("The state event to perform on the milestone (activate, close)."),
+				mcp.Enum("activate", "close"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			return milestoneUpdate(ctx, &request, glClient, projectID)
+		}
+}
+
+// CloseProjectMilestone defines a convenience MCP tool that closes a project milestone.
+// It is equivalent to updateProjectMilestone with stateEvent "close".
+func CloseProjectMilestone(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"closeProjectMilestone",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_CLOSE_PROJECT_MILESTONE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Close GitLab Project Milestone",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("milestoneId",
+				mcp.Description("The ID of the milestone to close."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			milestoneIDFloat, err := requiredParam[float64](&request, "milestoneId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			milestoneID := int64(milestoneIDFloat)
+			if float64(milestoneID) != milestoneIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: milestoneId %v is not a valid integer", milestoneIDFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			opts := &gl.UpdateMilestoneOptions{
+				StateEvent: gl.Ptr("close"),
+			}
+			milestone, resp, err := glClient.Milestones.UpdateMilestone(projectID, milestoneID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("milestone %d in project %q", milestoneID, projectID), "close milestone")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(milestone)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal milestone data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// parseGroupMilestoneDates reads the optional startDate and dueDate parameters (YYYY-MM-DD)
+// of the group milestone tools.
+func parseGroupMilestoneDates(request *mcp.CallToolRequest) (startDate, dueDate *gl.ISOTime, err error) {
+	startDateStr, err := OptionalParam[string](request, "startDate")
+	if err != nil {
+		return nil, nil, err
+	}
+	if startDateStr != "" {
+		parsed, err := time.Parse("2006-01-02", startDateStr)
+		if err != nil {
+			return nil, nil, fmt.Errorf("startDate must be in YYYY-MM-DD format, got %q: %w", startDateStr, err)
+		}
+		startDate = gl.Ptr(gl.ISOTime(parsed))
+	}
+
+	dueDateStr, err := OptionalParam[string](request, "dueDate")
+	if err != nil {
+		return nil, nil, err
+	}
+	dueDate, err = ParseDueDate(dueDateStr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return startDate, dueDate, nil
+}
+
+// ListGroupMilestones defines the MCP tool for listing the milestones of a GitLab group.
+func ListGroupMilestones(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listGroupMilestones",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_GROUP_MILESTONES_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Group Milestones",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("groupId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the group."),
+				mcp.Required(),
+			),
+			// Optional filtering parameters
+			mcp.WithString("state",
+				mcp.Description("Return only active or closed milestones."),
+				mcp.Enum("active", "closed"),
+			),
+			mcp.WithString("search",
+				mcp.Description("Search milestones against their title and description."),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			groupID, err := requiredParam[string](&request, "groupId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional filtering parameters
+			state, err := OptionalParam[string](&request, "state")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			search, err := OptionalParam[string](&request, "search")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.ListGroupMilestonesOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+			}
+
+			if state != "" {
+				opts.State = &state
+			}
+
+			if search != "" {
+				opts.Search = &search
+			}
+
+			// --- Call GitLab API
+			milestones, resp, err := glClient.GroupMilestones.ListGroupMilestones(groupID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, fmt.Sprintf("milestones from group %q", groupID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(milestones) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Truncate long text fields for list operations
+			truncator := NewTextTruncator(MaxFieldLength)
+			truncatedMilestones, err := truncator.TruncateListResponse(milestones, MilestoneFields)
+			if err != nil {
+				return nil, fmt.Errorf("failed to truncate milestones: %w", err)
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(truncatedMilestones)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal group milestones list: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// GetGroupMilestone defines the MCP tool for retrieving a single milestone of a GitLab group.
+func GetGroupMilestone(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"getGroupMilestone",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_GROUP_MILESTONE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "Get GitLab Group Milestone",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("groupId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the group."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("milestoneId",
+				mcp.Description("The ID of the group milestone."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			groupID, err := requiredParam[string](&request, "groupId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			milestoneIDFloat, err := requiredParam[float64](&request, "milestoneId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			milestoneID := int64(milestoneIDFloat)
+			if float64(milestoneID) != milestoneIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: milestoneId %v is not a valid integer", milestoneIDFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			milestone, resp, err := glClient.GroupMilestones.GetGroupMilestone(groupID, milestoneID, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("milestone %d in group %q", milestoneID, groupID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(milestone)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal group milestone data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// CreateGroupMilestone defines the MCP tool for creating a milestone in a GitLab group.
+func CreateGroupMilestone(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"createGroupMilestone",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_CREATE_GROUP_MILESTONE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Create GitLab Group Milestone",
+			}),
+			// Required parameters
+			mcp.WithString("groupId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the group."),
+				mcp.Required(),
+			),
+			mcp.WithString("title",
+				mcp.Description("The title of the milestone."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithString("description",
+				mcp.Description("The description of the milestone."),
+			),
+			mcp.WithString("dueDate",
+				mcp.Description("The due date of the milestone (ISO 8601 format: YYYY-MM-DD)."),
+			),
+			mcp.WithString("startDate",
+				mcp.Description("The start date of the milestone (ISO 8601 format: YYYY-MM-DD)."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			groupID, err := requiredParam[string](&request, "groupId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			title, err := requiredParam[string](&request, "title")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional parameters
+			description, err := OptionalParam[string](&request, "description")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			startDate, dueDate, err := parseGroupMilestoneDates(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.CreateGroupMilestoneOptions{
+				Title:     &title,
+				StartDate: startDate,
+				DueDate:   dueDate,
+			}
+
+			if description != "" {
+				opts.Description = &description
+			}
+
+			// --- Call GitLab API
+			milestone, resp, err := glClient.GroupMilestones.CreateGroupMilestone(groupID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("group %q", groupID), "create group milestone")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(milestone)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal group milestone data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// UpdateGroupMilestone defines the MCP tool for updating a milestone in a GitLab group.
+func UpdateGroupMilestone(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"updateGroupMilestone",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_UPDATE_GROUP_MILESTONE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Update GitLab Group Milestone",
+			}),
+			// Required parameters
+			mcp.WithString("groupId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the group."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("milestoneId",
+				mcp.Description("The ID of the group milestone to update."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithString("title",
+				mcp.Description("The new title of the milestone."),
+			),
+			mcp.WithString("description",
+				mcp.Description("The new description of the milestone."),
+			),
+			mcp.WithString("dueDate",
+				mcp.Description("The due date of the milestone (ISO 8601 format: YYYY-MM-DD)."),
+			),
+			mcp.WithString("startDate",
+				mcp.Description("The start date of the milestone (ISO 8601 format: YYYY-MM-DD)."),
+			),
+			mcp.WithString("stateEvent",
+				mcp.Description("The state event to perform on the milestone (activate, close)."),
+				mcp.Enum("activate", "close"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			groupID, err := requiredParam[string](&request, "groupId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			milestoneIDFloat, err := requiredParam[float64](&request, "milestoneId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			milestoneID := int64(milestoneIDFloat)
+			if float64(milestoneID) != milestoneIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: milestoneId %v is not a valid integer", milestoneIDFloat)), nil
+			}
+
+			// --- Parse optional parameters
+			title, err := OptionalParam[string](&request, "title")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			description, err := OptionalParam[string](&request, "description")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			startDate, dueDate, err := parseGroupMilestoneDates(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			stateEvent, err := OptionalParam[string](&request, "stateEvent")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.UpdateGroupMilestoneOptions{
+				StartDate: startDate,
+				DueDate:   dueDate,
+			}
+
+			if title != "" {
+				opts.Title = &title
+			}
+
+			if description != "" {
+				opts.Description = &description
+			}
+
+			if stateEvent != "" {
+				opts.StateEvent = &stateEvent
+			}
+
+			// --- Call GitLab API
+			milestone, resp, err := glClient.GroupMilestones.UpdateGroupMilestone(groupID, milestoneID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("milestone %d in group %q", milestoneID, groupID), "update group milestone")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(milestone)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal group milestone data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
@@ -876,3 +876,535 @@ func TestListMilestonesHandler(t *testing.T) {
 		assert.Nil(t, result)
 	})
 }
+
+// TestListProjectMilestonesHandler tests the ListProjectMilestones tool handler
+func TestListProjectMilestonesHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListProjectMilestones(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockMilestones, ctrl := setupMockClientForMilestones(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListProjectMilestones(mockGetClient, nil)
+
+	projectID := "group/project"
+
+	tests := []struct {
+		name              string
+		args              map[string]any
+		mockSetup         func()
+		expectedText      string
+		expectResultError bool
+	}{
+		{
+			name: "Success - Filters by title and iids",
+			args: map[string]any{
+				"projectId": projectID,
+				"state":     "closed",
+				"title":     "Sprint 1",
+				"iids":      "1, 3",
+			},
+			mockSetup: func() {
+				mockMilestones.EXPECT().
+					ListMilestones(projectID, gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, opts *gl.ListMilestonesOptions, _ ...gl.RequestOptionFunc) ([]*gl.Milestone, *gl.Response, error) {
+						assert.Equal(t, "closed", *opts.State)
+						assert.Equal(t, "Sprint 1", *opts.Title)
+						assert.Equal(t, []int64{1, 3}, *opts.IIDs)
+						assert.Nil(t, opts.Search)
+						return []*gl.Milestone{{ID: 123, IID: 1, Title: "Sprint 1", State: "closed"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+					})
+			},
+			expectedText: `"title":"Sprint 1"`,
+		},
+		{
+			name: "Success - Empty list",
+			args: map[string]any{
+				"projectId": projectID,
+			},
+			mockSetup: func() {
+				mockMilestones.EXPECT().
+					ListMilestones(projectID, gomock.Any(), gomock.Any()).
+					Return([]*gl.Milestone{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: "[]",
+		},
+		{
+			name: "Error - Invalid iids",
+			args: map[string]any{
+				"projectId": projectID,
+				"iids":      "1,abc",
+			},
+			mockSetup:         func() {},
+			expectedText:      "Validation Error: invalid milestone IID ID \"abc\"",
+			expectResultError: true,
+		},
+		{
+			name:              "Error - Missing projectId",
+			args:              map[string]any{},
+			mockSetup:         func() {},
+			expectedText:      "Validation Error: missing required parameter: projectId",
+			expectResultError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tc.args,
+				},
+			}
+
+			result, err := handler(ctx, request)
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, tc.expectResultError, result.IsError)
+			assert.Contains(t, getTextResult(t, result).Text, tc.expectedText)
+		})
+	}
+}
+
+// TestCreateProjectMilestoneHandler tests the CreateProjectMilestone tool handler
+func TestCreateProjectMilestoneHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := CreateProjectMilestone(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockMilestones, ctrl := setupMockClientForMilestones(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := CreateProjectMilestone(mockGetClient, nil)
+
+	t.Run("Success - Create with dates", func(t *testing.T) {
+		mockMilestones.EXPECT().
+			CreateMilestone("group/project", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.CreateMilestoneOptions, _ ...gl.RequestOptionFunc) (*gl.Milestone, *gl.Response, error) {
+				assert.Equal(t, "Sprint 1", *opts.Title)
+				assert.Equal(t, "2026-01-31", opts.DueDate.String())
+				assert.Equal(t, "2026-01-01", opts.StartDate.String())
+				return &gl.Milestone{ID: 123, IID: 1, Title: "Sprint 1"}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"title":     "Sprint 1",
+			"dueDate":   "2026-01-31",
+			"startDate": "2026-01-01",
+		}}})
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, `"title":"Sprint 1"`)
+	})
+
+	t.Run("Error - Missing title", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: title")
+	})
+}
+
+// TestUpdateProjectMilestoneHandler tests the UpdateProjectMilestone tool handler
+func TestUpdateProjectMilestoneHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := UpdateProjectMilestone(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockMilestones, ctrl := setupMockClientForMilestones(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := UpdateProjectMilestone(mockGetClient, nil)
+
+	t.Run("Success - Update title", func(t *testing.T) {
+		mockMilestones.EXPECT().
+			UpdateMilestone("group/project", int64(7), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ int64, opts *gl.UpdateMilestoneOptions, _ ...gl.RequestOptionFunc) (*gl.Milestone, *gl.Response, error) {
+				assert.Equal(t, "Renamed", *opts.Title)
+				assert.Nil(t, opts.StateEvent)
+				return &gl.Milestone{ID: 7, Title: "Renamed"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":   "group/project",
+			"milestoneId": 7.0,
+			"title":       "Renamed",
+		}}})
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, `"title":"Renamed"`)
+	})
+
+	t.Run("Error - Missing milestoneId", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: milestoneId")
+	})
+}
+
+// TestCloseProjectMilestoneHandler tests the CloseProjectMilestone tool handler
+func TestCloseProjectMilestoneHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := CloseProjectMilestone(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockMilestones, ctrl := setupMockClientForMilestones(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := CloseProjectMilestone(mockGetClient, nil)
+
+	tests := []struct {
+		name                string
+		args                map[string]any
+		mockSetup           func()
+		expectedText        string
+		expectResultError   bool
+		expectInternalError bool
+	}{
+		{
+			name: "Success - Close milestone",
+			args: map[string]any{
+				"projectId":   "group/project",
+				"milestoneId": 5.0,
+			},
+			mockSetup: func() {
+				mockMilestones.EXPECT().
+					UpdateMilestone("group/project", int64(5), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, _ int64, opts *gl.UpdateMilestoneOptions, _ ...gl.RequestOptionFunc) (*gl.Milestone, *gl.Response, error) {
+						assert.Equal(t, "close", *opts.StateEvent)
+						return &gl.Milestone{ID: 5, State: "closed"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+					})
+			},
+			expectedText: `"state":"closed"`,
+		},
+		{
+			name: "Error - Non-integer milestoneId",
+			args: map[string]any{
+				"projectId":   "group/project",
+				"milestoneId": 1.5,
+			},
+			mockSetup:         func() {},
+			expectedText:      "Validation Error: milestoneId 1.5 is not a valid integer",
+			expectResultError: true,
+		},
+		{
+			name: "Error - Milestone Not Found (404)",
+			args: map[string]any{
+				"projectId":   "group/project",
+				"milestoneId": 999.0,
+			},
+			mockSetup: func() {
+				mockMilestones.EXPECT().
+					UpdateMilestone("group/project", int64(999), gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Milestone Not Found"))
+			},
+			expectedText:      "milestone 999 in project \"group/project\" not found or access denied (404)",
+			expectResultError: true,
+		},
+		{
+			name: "Error - GitLab API Error (500)",
+			args: map[string]any{
+				"projectId":   "group/project",
+				"milestoneId": 5.0,
+			},
+			mockSetup: func() {
+				mockMilestones.EXPECT().
+					UpdateMilestone("group/project", int64(5), gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 500}}, errors.New("gitlab: 500 Internal Server Error"))
+			},
+			expectedText:        "failed to close milestone",
+			expectInternalError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}})
+
+			if tc.expectInternalError {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.expectedText)
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, tc.expectResultError, result.IsError)
+			assert.Contains(t, getTextResult(t, result).Text, tc.expectedText)
+		})
+	}
+}
+
+// TestListGroupMilestonesHandler tests the ListGroupMilestones tool handler
+func TestListGroupMilestonesHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListGroupMilestones(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockGroupMilestones, ctrl := setupMockClientForGroupMilestones(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListGroupMilestones(mockGetClient, nil)
+
+	t.Run("Success - Filters and pagination are forwarded", func(t *testing.T) {
+		mockGroupMilestones.EXPECT().
+			ListGroupMilestones("my-group", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.ListGroupMilestonesOptions, _ ...gl.RequestOptionFunc) ([]*gl.GroupMilestone, *gl.Response, error) {
+				assert.Equal(t, "active", *opts.State)
+				assert.Equal(t, "Q3", *opts.Search)
+				assert.Equal(t, int64(2), opts.Page)
+				assert.Equal(t, int64(10), opts.PerPage)
+				return []*gl.GroupMilestone{{ID: 5, IID: 1, GroupID: 9, Title: "Q3 release"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId":  "my-group",
+			"state":    "active",
+			"search":   "Q3",
+			"page":     2.0,
+			"per_page": 10.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"title":"Q3 release"`)
+	})
+
+	t.Run("Success - Empty list", func(t *testing.T) {
+		mockGroupMilestones.EXPECT().
+			ListGroupMilestones("my-group", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.ListGroupMilestonesOptions, _ ...gl.RequestOptionFunc) ([]*gl.GroupMilestone, *gl.Response, error) {
+				assert.Nil(t, opts.State)
+				assert.Nil(t, opts.Search)
+				return []*gl.GroupMilestone{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+		}}})
+		require.NoError(t, err)
+		assert.Equal(t, "[]", getTextResult(t, result).Text)
+	})
+
+	t.Run("Error - Missing groupId", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: groupId")
+	})
+}
+
+// TestGetGroupMilestoneHandler tests the GetGroupMilestone tool handler
+func TestGetGroupMilestoneHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetGroupMilestone(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockGroupMilestones, ctrl := setupMockClientForGroupMilestones(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := GetGroupMilestone(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockGroupMilestones.EXPECT().
+			GetGroupMilestone("my-group", int64(5), gomock.Any()).
+			Return(&gl.GroupMilestone{ID: 5, Title: "Q3 release"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId":     "my-group",
+			"milestoneId": 5.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"id":5`)
+	})
+
+	t.Run("Error - Non-integer milestoneId", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId":     "my-group",
+			"milestoneId": 5.5,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: milestoneId 5.5 is not a valid integer")
+	})
+
+	t.Run("Error - Not found (404)", func(t *testing.T) {
+		mockGroupMilestones.EXPECT().
+			GetGroupMilestone("my-group", int64(99), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId":     "my-group",
+			"milestoneId": 99.0,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, `milestone 99 in group "my-group" not found`)
+	})
+}
+
+// TestCreateGroupMilestoneHandler tests the CreateGroupMilestone tool handler
+func TestCreateGroupMilestoneHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := CreateGroupMilestone(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockGroupMilestones, ctrl := setupMockClientForGroupMilestones(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := CreateGroupMilestone(mockGetClient, nil)
+
+	t.Run("Success - Create with dates", func(t *testing.T) {
+		mockGroupMilestones.EXPECT().
+			CreateGroupMilestone("my-group", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.CreateGroupMilestoneOptions, _ ...gl.RequestOptionFunc) (*gl.GroupMilestone, *gl.Response, error) {
+				assert.Equal(t, "Q3 release", *opts.Title)
+				assert.Equal(t, "Cross-project goals", *opts.Description)
+				assert.Equal(t, "2026-09-30", opts.DueDate.String())
+				assert.Equal(t, "2026-07-01", opts.StartDate.String())
+				return &gl.GroupMilestone{ID: 5, Title: "Q3 release"}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId":     "my-group",
+			"title":       "Q3 release",
+			"description": "Cross-project goals",
+			"dueDate":     "2026-09-30",
+			"startDate":   "2026-07-01",
+		}}})
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, `"title":"Q3 release"`)
+	})
+
+	t.Run("Error - Invalid startDate", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId":   "my-group",
+			"title":     "Q3 release",
+			"startDate": "07/01/2026",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: startDate must be in YYYY-MM-DD format")
+	})
+
+	t.Run("Error - Missing title", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: title")
+	})
+
+	t.Run("Error - Duplicate title (400)", func(t *testing.T) {
+		mockGroupMilestones.EXPECT().
+			CreateGroupMilestone("my-group", gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 400}}, errors.New("gitlab: 400 Title already being used"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+			"title":   "Q3 release",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "failed to create group milestone")
+	})
+}
+
+// TestUpdateGroupMilestoneHandler tests the UpdateGroupMilestone tool handler
+func TestUpdateGroupMilestoneHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := UpdateGroupMilestone(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockGroupMilestones, ctrl := setupMockClientForGroupMilestones(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := UpdateGroupMilestone(mockGetClient, nil)
+
+	t.Run("Success - Close milestone", func(t *testing.T) {
+		mockGroupMilestones.EXPECT().
+			UpdateGroupMilestone("my-group", int64(5), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ int64, opts *gl.UpdateGroupMilestoneOptions, _ ...gl.RequestOptionFunc) (*gl.GroupMilestone, *gl.Response, error) {
+				assert.Equal(t, "close", *opts.StateEvent)
+				assert.Nil(t, opts.Title)
+				assert.Nil(t, opts.DueDate)
+				return &gl.GroupMilestone{ID: 5, State: "closed"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId":     "my-group",
+			"milestoneId": 5.0,
+			"stateEvent":  "close",
+		}}})
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, `"state":"closed"`)
+	})
+
+	t.Run("Error - Missing milestoneId", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: milestoneId")
+	})
+
+	t.Run("Error - Not found (404)", func(t *testing.T) {
+		mockGroupMilestones.EXPECT().
+			UpdateGroupMilestone("my-group", int64(99), gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId":     "my-group",
+			"milestoneId": 99.0,
+			"title":       "Renamed",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `milestone 99 in group "my-group" not found`)
+	})
+}
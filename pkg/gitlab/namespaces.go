@@ -0,0 +1,143 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gl "gitlab.com/gitlab-org/api/client-go"
+)
+
+// ListNamespaces defines the MCP tool for listing the namespaces (users and groups) visible to the
+// current user, e.g. to find the namespace ID to create a project in.
+func ListNamespaces(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listNamespaces",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_NAMESPACES_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Namespaces",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Optional parameters
+			mcp.WithString("search",
+				mcp.Description("Return only namespaces whose name or path matches this string."),
+			),
+			mcp.WithBoolean("owned",
+				mcp.Description("Return only namespaces owned by the current user."),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse optional parameters
+			search, err := OptionalParam[string](&request, "search")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			owned, err := OptionalBoolParam(&request, "owned")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			opts := &gl.ListNamespacesOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+				OwnedOnly: owned,
+			}
+			if search != "" {
+				opts.Search = &search
+			}
+			namespaces, resp, err := glClient.Namespaces.ListNamespaces(opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, "namespaces")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(namespaces) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(namespaces)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal namespaces list: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// GetNamespace defines the MCP tool for retrieving a single namespace by ID or path.
+func GetNamespace(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"getNamespace",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_NAMESPACE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "Get GitLab Namespace",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("namespaceId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the namespace."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			namespaceID, err := requiredParam[string](&request, "namespaceId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			namespace, resp, err := glClient.Namespaces.GetNamespace(namespaceID, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("namespace %q", namespaceID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(namespace)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal namespace: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
@@ -0,0 +1,141 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+func TestListNamespacesHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListNamespaces(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockNamespaces, ctrl := setupMockClientForNamespaces(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListNamespaces(mockGetClient, nil)
+
+	t.Run("Success - With filters", func(t *testing.T) {
+		mockNamespaces.EXPECT().
+			ListNamespaces(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(opts *gl.ListNamespacesOptions, _ ...gl.RequestOptionFunc) ([]*gl.Namespace, *gl.Response, error) {
+				require.NotNil(t, opts.Search)
+				assert.Equal(t, "team", *opts.Search)
+				require.NotNil(t, opts.OwnedOnly)
+				assert.True(t, *opts.OwnedOnly)
+				assert.Equal(t, int64(2), opts.Page)
+				assert.Equal(t, int64(10), opts.PerPage)
+				return []*gl.Namespace{{ID: 7, Name: "Team", Path: "team", Kind: "group", FullPath: "org/team"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+			})
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{
+			"search":   "team",
+			"owned":    true,
+			"page":     2.0,
+			"per_page": 10.0,
+		}))
+		require.NoError(t, err)
+		text := getTextResult(t, result).Text
+		assert.Contains(t, text, `"id":7`)
+		assert.Contains(t, text, `"full_path":"org/team"`)
+	})
+
+	t.Run("Success - No filters", func(t *testing.T) {
+		mockNamespaces.EXPECT().
+			ListNamespaces(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(opts *gl.ListNamespacesOptions, _ ...gl.RequestOptionFunc) ([]*gl.Namespace, *gl.Response, error) {
+				assert.Nil(t, opts.Search)
+				assert.Nil(t, opts.OwnedOnly)
+				return []*gl.Namespace{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+			})
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{}))
+		require.NoError(t, err)
+		assert.Equal(t, "[]", getTextResult(t, result).Text)
+	})
+
+	t.Run("Error - API failure (500)", func(t *testing.T) {
+		mockNamespaces.EXPECT().
+			ListNamespaces(gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 500}}, errors.New("gitlab: 500 Internal Server Error"))
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{}))
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Error - Invalid owned type", func(t *testing.T) {
+		result, err := handler(ctx, *createMCPRequest(map[string]any{"owned": 3.0}))
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error:")
+	})
+}
+
+func TestGetNamespaceHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetNamespace(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockNamespaces, ctrl := setupMockClientForNamespaces(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := GetNamespace(mockGetClient, nil)
+
+	t.Run("Success - By path", func(t *testing.T) {
+		mockNamespaces.EXPECT().
+			GetNamespace("org/team", gomock.Any()).
+			Return(&gl.Namespace{ID: 7, FullPath: "org/team", Kind: "group"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{"namespaceId": "org/team"}))
+		require.NoError(t, err)
+		text := getTextResult(t, result).Text
+		assert.Contains(t, text, `"id":7`)
+		assert.Contains(t, text, `"kind":"group"`)
+	})
+
+	t.Run("Success - By ID", func(t *testing.T) {
+		mockNamespaces.EXPECT().
+			GetNamespace("7", gomock.Any()).
+			Return(&gl.Namespace{ID: 7}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{"namespaceId": "7"}))
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"id":7`)
+	})
+
+	t.Run("Error - Not Found (404)", func(t *testing.T) {
+		mockNamespaces.EXPECT().
+			GetNamespace("missing", gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{"namespaceId": "missing"}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, `namespace "missing" not found`)
+	})
+
+	t.Run("Error - Missing namespaceId", func(t *testing.T) {
+		result, err := handler(ctx, *createMCPRequest(map[string]any{}))
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: namespaceId")
+	})
+}
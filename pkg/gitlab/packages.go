@@ -0,0 +1,279 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gl "gitlab.com/gitlab-org/api/client-go"
+)
+
+// ListPackages defines the MCP tool for listing the packages in a GitLab project's package registry.
+func ListPackages(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listPackages",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_PACKAGES_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Packages",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			// Optional filtering parameters
+			mcp.WithString("packageType",
+				mcp.Description("Return only packages of this type."),
+				mcp.Enum("conan", "maven", "npm", "pypi", "composer", "nuget", "helm", "go", "generic"),
+			),
+			mcp.WithString("packageName",
+				mcp.Description("Return packages whose name matches this value (fuzzy search)."),
+			),
+			mcp.WithString("status",
+				mcp.Description("Return only packages with this status."),
+				mcp.Enum("default", "hidden", "error"),
+			),
+			mcp.WithString("orderBy",
+				mcp.Description("Return packages ordered by field (GitLab default: created_at)."),
+				mcp.Enum("created_at", "name", "version", "type"),
+			),
+			mcp.WithString("sort",
+				mcp.Description("Return packages sorted in asc or desc order (GitLab default: asc)."),
+				mcp.Enum("asc", "desc"),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional filtering parameters
+			packageType, err := OptionalParam[string](&request, "packageType")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			packageName, err := OptionalParam[string](&request, "packageName")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			status, err := OptionalParam[string](&request, "status")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			orderBy, err := OptionalParam[string](&request, "orderBy")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			sort, err := OptionalParam[string](&request, "sort")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.ListProjectPackagesOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+			}
+
+			if packageType != "" {
+				opts.PackageType = &packageType
+			}
+
+			if packageName != "" {
+				opts.PackageName = &packageName
+			}
+
+			if status != "" {
+				opts.Status = &status
+			}
+
+			if orderBy != "" {
+				opts.OrderBy = &orderBy
+			}
+
+			if sort != "" {
+				opts.Sort = &sort
+			}
+
+			// --- Call GitLab API
+			packages, resp, err := glClient.Packages.ListProjectPackages(projectID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, fmt.Sprintf("packages from project %q", projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(packages) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(packages)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal packages list: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// GetPackage defines the MCP tool for retrieving a single package from a GitLab project's package registry.
+func GetPackage(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"getPackage",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_PACKAGE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "Get GitLab Package",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("packageId",
+				mcp.Description("The ID of the package."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			packageIDFloat, err := requiredParam[float64](&request, "packageId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			packageID := int64(packageIDFloat)
+			if float64(packageID) != packageIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: packageId %v is not a valid integer", packageIDFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			// The client library has no wrapper for the single-package endpoint,
+			// so the request is built directly against the REST path.
+			u := fmt.Sprintf("projects/%s/packages/%d", gl.PathEscape(projectID), packageID)
+			req, err := glClient.NewRequest(http.MethodGet, u, nil, []gl.RequestOptionFunc{gl.WithContext(ctx)})
+			if err != nil {
+				return nil, fmt.Errorf("failed to build package request: %w", err)
+			}
+
+			pkg := new(gl.Package)
+			resp, err := glClient.Do(req, pkg)
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("package %d in project %q", packageID, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(pkg)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal package data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// DeletePackage defines the MCP tool for deleting a package from a GitLab project's package registry.
+func DeletePackage(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"deletePackage",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_DELETE_PACKAGE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Delete GitLab Package",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("packageId",
+				mcp.Description("The ID of the package to delete."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			packageIDFloat, err := requiredParam[float64](&request, "packageId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			packageID := int64(packageIDFloat)
+			if float64(packageID) != packageIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: packageId %v is not a valid integer", packageIDFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			resp, err := glClient.Packages.DeleteProjectPackage(projectID, packageID, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("package %d in project %q", packageID, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf(`{"message":"Package %d successfully deleted from project %q"}`, packageID, projectID)), nil
+		}
+}
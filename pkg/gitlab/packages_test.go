@@ -0,0 +1,241 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+func TestListPackagesHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListPackages(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockPackages, ctrl := setupMockClientForPackages(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListPackages(mockGetClient, nil)
+
+	tests := []struct {
+		name               string
+		inputArgs          map[string]any
+		mockSetup          func()
+		expectedText       string
+		expectHandlerError bool
+		expectResultError  bool
+		errorContains      string
+	}{
+		{
+			name:      "Success - List packages",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockPackages.EXPECT().
+					ListProjectPackages("group/project", gomock.Any(), gomock.Any()).
+					Return([]*gl.Package{{ID: 1, Name: "@group/lib", Version: "1.2.0", PackageType: "npm"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: `"name":"@group/lib"`,
+		},
+		{
+			name: "Success - With filters",
+			inputArgs: map[string]any{
+				"projectId":   "group/project",
+				"packageType": "maven",
+				"packageName": "core",
+				"status":      "hidden",
+				"orderBy":     "version",
+				"sort":        "desc",
+				"page":        2,
+			},
+			mockSetup: func() {
+				mockPackages.EXPECT().
+					ListProjectPackages("group/project", gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, opts *gl.ListProjectPackagesOptions, _ ...gl.RequestOptionFunc) ([]*gl.Package, *gl.Response, error) {
+						assert.Equal(t, "maven", *opts.PackageType)
+						assert.Equal(t, "core", *opts.PackageName)
+						assert.Equal(t, "hidden", *opts.Status)
+						assert.Equal(t, "version", *opts.OrderBy)
+						assert.Equal(t, "desc", *opts.Sort)
+						assert.Equal(t, gl.ListOptions{Page: 2, PerPage: 20}, opts.ListOptions)
+						return []*gl.Package{{ID: 2, Name: "core", PackageType: "maven"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+					})
+			},
+			expectedText: `"package_type":"maven"`,
+		},
+		{
+			name:      "Success - Empty list",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockPackages.EXPECT().
+					ListProjectPackages("group/project", gomock.Any(), gomock.Any()).
+					Return([]*gl.Package{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: "[]",
+		},
+		{
+			name:              "Error - Missing projectId",
+			inputArgs:         map[string]any{},
+			mockSetup:         func() {},
+			expectResultError: true,
+			errorContains:     "Validation Error: missing required parameter: projectId",
+		},
+		{
+			name:      "Error - Forbidden (403)",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockPackages.EXPECT().
+					ListProjectPackages("group/project", gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 403}}, errors.New("gitlab: 403 Forbidden"))
+			},
+			expectHandlerError: true,
+			errorContains:      "failed to list packages from project",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.inputArgs}})
+
+			if tc.expectHandlerError {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			textContent := getTextResult(t, result)
+			if tc.expectResultError {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.errorContains)
+				return
+			}
+			assert.Contains(t, textContent.Text, tc.expectedText)
+		})
+	}
+}
+
+func TestGetPackageHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetPackage(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+
+	// The single-package endpoint is called directly, so serve it from a fake GitLab.
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/packages/7", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":7,"name":"chart","version":"0.3.1","package_type":"helm","status":"default"}`))
+	})
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/packages/8", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"404 Package Not Found"}`))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	client, err := gl.NewClient("x", gl.WithBaseURL(srv.URL))
+	require.NoError(t, err)
+
+	_, handler := GetPackage(func(_ context.Context) (*gl.Client, error) {
+		return client, nil
+	}, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"packageId": 7.0,
+		}}})
+		require.NoError(t, err)
+		text := getTextResult(t, result).Text
+		assert.Contains(t, text, `"id":7`)
+		assert.Contains(t, text, `"package_type":"helm"`)
+	})
+
+	t.Run("Error - Not found (404)", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"packageId": 8.0,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "package 8 in project \"group/project\" not found")
+	})
+
+	t.Run("Error - Missing packageId", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: packageId")
+	})
+}
+
+func TestDeletePackageHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := DeletePackage(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockPackages, ctrl := setupMockClientForPackages(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := DeletePackage(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockPackages.EXPECT().
+			DeleteProjectPackage("group/project", int64(7), gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 204}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"packageId": 7.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Package 7 successfully deleted")
+	})
+
+	t.Run("Error - Non-integer packageId", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"packageId": 7.5,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: packageId 7.5 is not a valid integer")
+	})
+
+	t.Run("Error - Not found (404)", func(t *testing.T) {
+		mockPackages.EXPECT().
+			DeleteProjectPackage("group/project", int64(9), gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"packageId": 9.0,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
@@ -13,60 +13,74 @@ import (
 // Input: "feature,bug,high-priority"
 // Output: LabelOptions ready for GitLab API
 func ParseLabelString(labels string) (*gl.LabelOptions, error) {
-	if labels == "" {
+	filtered := ParseCommaSeparatedList(labels)
+	if len(filtered) == 0 {
 		return nil, nil
 	}
 
-	labelSlice := strings.Split(labels, ",")
-	// Trim whitespace from each label
-	for i := range labelSlice {
-		labelSlice[i] = strings.TrimSpace(labelSlice[i])
+	labelOpts := gl.LabelOptions(filtered)
+	return &labelOpts, nil
+}
+
+// ParseCommaSeparatedList splits a comma-separated string into trimmed, non-empty values
+// Input: "v1.0, v1.1,,"
+// Output: []string{"v1.0", "v1.1"} (nil when no values remain)
+func ParseCommaSeparatedList(str string) []string {
+	if str == "" {
+		return nil
 	}
 
-	// Filter out empty strings
-	filtered := make([]string, 0, len(labelSlice))
-	for _, label := range labelSlice {
-		if label != "" {
-			filtered = append(filtered, label)
+	parts := strings.Split(str, ",")
+	filtered := make([]string, 0, len(parts))
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part != "" {
+			filtered = append(filtered, part)
 		}
 	}
 
 	if len(filtered) == 0 {
-		return nil, nil
+		return nil
 	}
 
-	labelOpts := gl.LabelOptions(filtered)
-	return &labelOpts, nil
+	return filtered
 }
 
 // ParseAssigneeIDsString parses a comma-separated string of user IDs
 // Input: "1,2,3"
 // Output: []int{1, 2, 3} wrapped as pointer
 func ParseAssigneeIDsString(assigneeIdsStr string) (*[]int64, error) {
-	if assigneeIdsStr == "" {
+	return ParseIDListString(assigneeIdsStr, "assignee")
+}
+
+// ParseIDListString parses a comma-separated string of integer IDs.
+// kind names the ID in error messages (e.g. "assignee", "milestone IID").
+// Returns nil when the string contains no IDs.
+func ParseIDListString(idsStr, kind string) (*[]int64, error) {
+	if idsStr == "" {
 		return nil, nil
 	}
 
-	assigneeIdsList := strings.Split(assigneeIdsStr, ",")
-	assigneeIds := make([]int64, 0, len(assigneeIdsList))
+	idsList := strings.Split(idsStr, ",")
+	ids := make([]int64, 0, len(idsList))
 
-	for _, idStr := range assigneeIdsList {
+	for _, idStr := range idsList {
 		idStr = strings.TrimSpace(idStr)
 		if idStr == "" {
 			continue
 		}
 		id, err := strconv.ParseInt(idStr, 10, 64)
 		if err != nil {
-			return nil, fmt.Errorf("invalid assignee ID %q: %w", idStr, err)
+			return nil, fmt.Errorf("invalid %s ID %q: %w", kind, idStr, err)
 		}
-		assigneeIds = append(assigneeIds, id)
+		ids = append(ids, id)
 	}
 
-	if len(assigneeIds) == 0 {
+	if len(ids) == 0 {
 		return nil, nil
 	}
 
-	return &assigneeIds, nil
+	return &ids, nil
 }
 
 // ValidateAndConvertMilestoneID validates a float64 milestone ID and converts to int
@@ -193,3 +207,26 @@ func ApplyAssigneeIDsWithString(opts interface{}, assigneeIdsStr string) error {
 
 	return nil
 }
+
+// ApplyReviewerIDsWithString sets reviewer IDs on CreateMergeRequestOptions or UpdateMergeRequestOptions
+func ApplyReviewerIDsWithString(opts interface{}, reviewerIdsStr string) error {
+	reviewerIds, err := ParseIDListString(reviewerIdsStr, "reviewer")
+	if err != nil {
+		return err
+	}
+
+	if reviewerIds == nil {
+		return nil
+	}
+
+	switch o := opts.(type) {
+	case *gl.CreateMergeRequestOptions:
+		o.ReviewerIDs = reviewerIds
+	case *gl.UpdateMergeRequestOptions:
+		o.ReviewerIDs = reviewerIds
+	default:
+		return fmt.Errorf("unsupported options type for ApplyReviewerIDsWithString")
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+func TestGetMergeRequestParticipantsHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetMergeRequestParticipants(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockMRs, ctrl := setupMockClientForMergeRequests(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := GetMergeRequestParticipants(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockMRs.EXPECT().
+			GetMergeRequestParticipants("group/project", int64(1), gomock.Any()).
+			Return([]*gl.BasicUser{{ID: 1, Username: "alice"}, {ID: 2, Username: "bob"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 1.0,
+		}))
+		require.NoError(t, err)
+		text := getTextResult(t, result).Text
+		assert.Contains(t, text, `"username":"alice"`)
+		assert.Contains(t, text, `"username":"bob"`)
+	})
+
+	t.Run("Success - No participants", func(t *testing.T) {
+		mockMRs.EXPECT().
+			GetMergeRequestParticipants("group/project", int64(1), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 1.0,
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, "[]", getTextResult(t, result).Text)
+	})
+
+	t.Run("Error - Not Found (404)", func(t *testing.T) {
+		mockMRs.EXPECT().
+			GetMergeRequestParticipants("group/project", int64(99), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 99.0,
+		}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "not found")
+	})
+
+	t.Run("Error - Non-integer mergeRequestIid", func(t *testing.T) {
+		result, err := handler(ctx, *createMCPRequest(map[string]any{
+			"projectId":       "group/project",
+			"mergeRequestIid": 1.5,
+		}))
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: mergeRequestIid 1.5 is not a valid integer")
+	})
+}
+
+func TestGetIssueParticipantsHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetIssueParticipants(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockIssues, ctrl := setupMockClientForIssues(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := GetIssueParticipants(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockIssues.EXPECT().
+			GetParticipants("group/project", int64(5), gomock.Any()).
+			Return([]*gl.BasicUser{{ID: 1, Username: "alice"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{
+			"projectId": "group/project",
+			"issueIid":  5.0,
+		}))
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"username":"alice"`)
+	})
+
+	t.Run("Success - No participants", func(t *testing.T) {
+		mockIssues.EXPECT().
+			GetParticipants("group/project", int64(5), gomock.Any()).
+			Return([]*gl.BasicUser{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{
+			"projectId": "group/project",
+			"issueIid":  5.0,
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, "[]", getTextResult(t, result).Text)
+	})
+
+	t.Run("Error - Not Found (404)", func(t *testing.T) {
+		mockIssues.EXPECT().
+			GetParticipants("group/project", int64(99), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{
+			"projectId": "group/project",
+			"issueIid":  99.0,
+		}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "issue 99 in project \"group/project\" not found")
+	})
+
+	t.Run("Error - Missing issueIid", func(t *testing.T) {
+		result, err := handler(ctx, *createMCPRequest(map[string]any{
+			"projectId": "group/project",
+		}))
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error:")
+	})
+}
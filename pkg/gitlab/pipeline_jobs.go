@@ -1,10 +1,15 @@
 package gitlab
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net/http"
+	"time"
 
 	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -17,6 +22,7 @@ func PipelineJob(getClient GetClientFn, t map[string]string) (tool mcp.Tool, han
 	return mcp.NewTool(
 			"pipelineJob",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_PIPELINE_JOB_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title: "Manage Pipeline Job",
 			}),
@@ -153,6 +159,7 @@ func Pipeline(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handle
 	return mcp.NewTool(
 			"pipeline",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_PIPELINE_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title: "Control Pipeline",
 			}),
@@ -237,6 +244,7 @@ func RetryPipelineJob(getClient GetClientFn, t map[string]string) (tool mcp.Tool
 	return mcp.NewTool(
 			"retryPipelineJob",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_RETRY_PIPELINE_JOB_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        "Retry Pipeline Job",
 				ReadOnlyHint: boolPtr(false),
@@ -296,6 +304,7 @@ func PlayPipelineJob(getClient GetClientFn, t map[string]string) (tool mcp.Tool,
 	return mcp.NewTool(
 			"playPipelineJob",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_PLAY_PIPELINE_JOB_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        "Play Pipeline Job",
 				ReadOnlyHint: boolPtr(false),
@@ -350,4 +359,230 @@ func PlayPipelineJob(getClient GetClientFn, t map[string]string) (tool mcp.Tool,
 		}
 }
 
+// maxArtifactDownloadSize is the largest artifact file downloadJobArtifact returns inline.
+const maxArtifactDownloadSize = 5 << 20
+
+// errArtifactTooLarge reports an artifact file above maxArtifactDownloadSize.
+var errArtifactTooLarge = errors.New("artifact file exceeds the download limit")
+
+// rejectLargeArtifact refuses artifact files whose Content-Length is above the download
+// limit. It is passed as a per-request retry check, the only hook that sees the response
+// before the client reads its body.
+func rejectLargeArtifact(_ context.Context, resp *http.Response, err error) (bool, error) {
+	if err == nil && resp != nil && resp.StatusCode == http.StatusOK && resp.ContentLength > maxArtifactDownloadSize {
+		return false, errArtifactTooLarge
+	}
+	return false, nil
+}
+
+// artifactWriter collects a downloaded artifact file up to maxArtifactDownloadSize.
+// Beyond that it fails and cancels the download, so that the rest of the body is
+// neither kept in memory nor transferred.
+type artifactWriter struct {
+	buf    bytes.Buffer
+	cancel context.CancelFunc
+}
+
+// Write implements io.Writer.
+func (w *artifactWriter) Write(p []byte) (int, error) {
+	if w.buf.Len()+len(p) > maxArtifactDownloadSize {
+		w.cancel()
+		return 0, errArtifactTooLarge
+	}
+	return w.buf.Write(p)
+}
+
+// jobArtifacts is the artifact metadata of a job, without the rest of the job details.
+type jobArtifacts struct {
+	JobID             int64               `json:"job_id"`
+	Artifacts         []gl.JobArtifact    `json:"artifacts"`
+	ArtifactsFile     gl.JobArtifactsFile `json:"artifacts_file"`
+	ArtifactsExpireAt *time.Time          `json:"artifacts_expire_at"`
+}
+
+// jobArtifactFile is a single file downloaded from a job's artifacts archive.
+type jobArtifactFile struct {
+	ArtifactPath string `json:"artifact_path"`
+	Size         int    `json:"size"`
+	ContentType  string `json:"content_type"`
+	Content      string `json:"content"`
+}
+
+// ListJobArtifacts defines the MCP tool for listing the artifacts of a job.
+func ListJobArtifacts(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listJobArtifacts",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_JOB_ARTIFACTS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Job Artifacts",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("jobId",
+				mcp.Description("The ID of the job."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			jobIDFloat, err := requiredParam[float64](&request, "jobId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			jobID := int64(jobIDFloat)
+			if float64(jobID) != jobIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: jobId %v is not a valid integer", jobIDFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			// Jobs.GetJobArtifacts downloads the whole archive; the metadata lives on the job itself
+			job, resp, err := glClient.Jobs.GetJob(projectID, jobID, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("job %d in project %q", jobID, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			artifacts := jobArtifacts{
+				JobID:             job.ID,
+				Artifacts:         job.Artifacts,
+				ArtifactsFile:     job.ArtifactsFile,
+				ArtifactsExpireAt: job.ArtifactsExpireAt,
+			}
+			if artifacts.Artifacts == nil {
+				artifacts.Artifacts = []gl.JobArtifact{}
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(artifacts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal job artifacts data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// DownloadJobArtifact defines the MCP tool for downloading a single file from a job's artifacts archive.
+func DownloadJobArtifact(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"downloadJobArtifact",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_DOWNLOAD_JOB_ARTIFACT_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "Download GitLab Job Artifact File",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("jobId",
+				mcp.Description("The ID of the job."),
+				mcp.Required(),
+			),
+			mcp.WithString("artifactPath",
+				mcp.Description("Path of the file inside the artifacts archive, e.g. 'coverage/index.html'."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			jobIDFloat, err := requiredParam[float64](&request, "jobId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			jobID := int64(jobIDFloat)
+			if float64(jobID) != jobIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: jobId %v is not a valid integer", jobIDFloat)), nil
+			}
+
+			artifactPath, err := requiredParam[string](&request, "artifactPath")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			// Jobs.DownloadSingleArtifactsFile buffers the whole file before returning,
+			// so the file is streamed into a size-limited writer instead.
+			downloadCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			u := fmt.Sprintf("projects/%s/jobs/%d/artifacts/%s", gl.PathEscape(projectID), jobID, artifactPath)
+			req, err := glClient.NewRequest(http.MethodGet, u, nil, []gl.RequestOptionFunc{
+				gl.WithContext(downloadCtx),
+				gl.WithRequestRetry(rejectLargeArtifact),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to build artifact request: %w", err)
+			}
+
+			w := &artifactWriter{cancel: cancel}
+			resp, err := glClient.Do(req, w)
+
+			// --- Handle API errors
+			if errors.Is(err, errArtifactTooLarge) {
+				return mcp.NewToolResultError(fmt.Sprintf("artifact %q is larger than the 5 MB download limit; download it from the GitLab UI or API directly", artifactPath)), nil
+			}
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("artifact %q of job %d in project %q", artifactPath, jobID, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+			content := w.buf.Bytes()
+
+			contentType := ""
+			if resp != nil && resp.Response != nil {
+				contentType = resp.Header.Get("Content-Type")
+			}
+			if contentType == "" {
+				contentType = http.DetectContentType(content)
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(jobArtifactFile{
+				ArtifactPath: artifactPath,
+				Size:         len(content),
+				ContentType:  contentType,
+				Content:      base64.StdEncoding.EncodeToString(content),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal artifact data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
 // CancelPipeline defines the MCP tool for canceling a running pipeline.
@@ -6,6 +6,8 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"net/http/httptest"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -1026,3 +1028,194 @@ func TestPipelineHandler_Retry(t *testing.T) {
 		})
 	}
 }
+
+func TestListJobArtifactsHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListJobArtifacts(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockJobs, ctrl := setupMockClientForJobs(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListJobArtifacts(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockJobs.EXPECT().
+			GetJob("group/project", int64(42), gomock.Any()).
+			Return(&gl.Job{
+				ID:   42,
+				Name: "build",
+				Artifacts: []gl.JobArtifact{
+					{FileType: "archive", Filename: "artifacts.zip", Size: 1024},
+				},
+				ArtifactsFile: gl.JobArtifactsFile{Filename: "artifacts.zip", Size: 1024},
+			}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"jobId":     42.0,
+		}}})
+		require.NoError(t, err)
+		text := getTextResult(t, result).Text
+		assert.Contains(t, text, `"job_id":42`)
+		assert.Contains(t, text, `"filename":"artifacts.zip"`)
+		assert.NotContains(t, text, `"name":"build"`)
+	})
+
+	t.Run("Success - Job without artifacts", func(t *testing.T) {
+		mockJobs.EXPECT().
+			GetJob("group/project", int64(43), gomock.Any()).
+			Return(&gl.Job{ID: 43}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"jobId":     43.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"artifacts":[]`)
+	})
+
+	t.Run("Error - Job not found (404)", func(t *testing.T) {
+		mockJobs.EXPECT().
+			GetJob("group/project", int64(99), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"jobId":     99.0,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "job 99 in project")
+	})
+}
+
+func TestDownloadJobArtifactHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := DownloadJobArtifact(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+
+	// The artifact is streamed with a raw request, so serve it from a fake GitLab.
+	// Closed when the test ends; until then the oversized file's body is withheld
+	release := make(chan struct{})
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/jobs/42/artifacts/reports/out.txt", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte("hello"))
+	})
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/jobs/42/artifacts/image.png", func(w http.ResponseWriter, _ *http.Request) {
+		// Suppress the Content-Type the test server would otherwise detect
+		w.Header()["Content-Type"] = nil
+		_, _ = w.Write([]byte("\x89PNG\r\n\x1a\n"))
+	})
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/jobs/42/artifacts/big.bin", func(w http.ResponseWriter, r *http.Request) {
+		// Announce an oversized file but withhold its body: reading it would block
+		w.Header().Set("Content-Length", strconv.Itoa(maxArtifactDownloadSize+1))
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	})
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/jobs/42/artifacts/chunked.bin", func(w http.ResponseWriter, _ *http.Request) {
+		// Without Content-Length, the size is only known while reading
+		chunk := make([]byte, 1<<20)
+		for i := 0; i < 6; i++ {
+			if _, err := w.Write(chunk); err != nil {
+				return
+			}
+			w.(http.Flusher).Flush()
+		}
+	})
+	mux.HandleFunc("/api/v4/projects/group%2Fproject/jobs/42/artifacts/missing.txt", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"404 Not Found"}`))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	defer close(release)
+
+	client, err := gl.NewClient("x", gl.WithBaseURL(srv.URL))
+	require.NoError(t, err)
+
+	_, handler := DownloadJobArtifact(func(_ context.Context) (*gl.Client, error) {
+		return client, nil
+	}, nil)
+
+	t.Run("Success - Content is base64-encoded", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":    "group/project",
+			"jobId":        42.0,
+			"artifactPath": "reports/out.txt",
+		}}})
+		require.NoError(t, err)
+
+		var file jobArtifactFile
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &file))
+		assert.Equal(t, "reports/out.txt", file.ArtifactPath)
+		assert.Equal(t, 5, file.Size)
+		assert.Equal(t, "text/plain", file.ContentType)
+		assert.Equal(t, "aGVsbG8=", file.Content)
+	})
+
+	t.Run("Success - Content type is detected when missing", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":    "group/project",
+			"jobId":        42.0,
+			"artifactPath": "image.png",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"content_type":"image/png"`)
+	})
+
+	t.Run("Error - Content-Length exceeds size limit", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":    "group/project",
+			"jobId":        42.0,
+			"artifactPath": "big.bin",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "larger than the 5 MB download limit")
+	})
+
+	t.Run("Error - Streamed file exceeds size limit", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":    "group/project",
+			"jobId":        42.0,
+			"artifactPath": "chunked.bin",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "larger than the 5 MB download limit")
+	})
+
+	t.Run("Error - Missing artifactPath", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"jobId":     42.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: artifactPath")
+	})
+
+	t.Run("Error - File not found (404)", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":    "group/project",
+			"jobId":        42.0,
+			"artifactPath": "missing.txt",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "not found or access denied (404)")
+	})
+}
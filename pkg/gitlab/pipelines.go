@@ -0,0 +1,687 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gl "gitlab.com/gitlab-org/api/client-go"
+)
+
+// testReportWithFailures is a pipeline test report together with the names of its
+// failed and errored test cases, so callers don't have to walk every suite.
+type testReportWithFailures struct {
+	*gl.PipelineTestReport
+	FailedTests []string `json:"failed_tests"`
+}
+
+// failedTestNames collects "classname.name" for every failed or errored test case in the report.
+func failedTestNames(report *gl.PipelineTestReport) []string {
+	names := []string{}
+	for _, suite := range report.TestSuites {
+		if suite == nil {
+			continue
+		}
+		for _, tc := range suite.TestCases {
+			if tc == nil || (tc.Status != "failed" && tc.Status != "error") {
+				continue
+			}
+			if tc.Classname != "" {
+				names = append(names, tc.Classname+"."+tc.Name)
+			} else {
+				names = append(names, tc.Name)
+			}
+		}
+	}
+	return names
+}
+
+// ListScheduledPipelines defines the MCP tool for listing the pipeline schedules of a GitLab project.
+func ListScheduledPipelines(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listScheduledPipelines",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_SCHEDULED_PIPELINES_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Pipeline Schedules",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.ListPipelineSchedulesOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+			}
+
+			// --- Call GitLab API
+			schedules, resp, err := glClient.PipelineSchedules.ListPipelineSchedules(projectID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, fmt.Sprintf("pipeline schedules from project %q", projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(schedules) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(schedules)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal pipeline schedules list: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// CreateScheduledPipeline defines the MCP tool for creating a pipeline schedule in a GitLab project.
+func CreateScheduledPipeline(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"createScheduledPipeline",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_CREATE_SCHEDULED_PIPELINE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Create GitLab Pipeline Schedule",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("description",
+				mcp.Description("The description of the pipeline schedule."),
+				mcp.Required(),
+			),
+			mcp.WithString("ref",
+				mcp.Description("The branch or tag name the pipeline runs on."),
+				mcp.Required(),
+			),
+			mcp.WithString("cron",
+				mcp.Description("The cron schedule, e.g. '0 1 * * *' for every night at 01:00."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithString("cronTimezone",
+				mcp.Description("The timezone of the cron schedule, e.g. 'Europe/Berlin' (GitLab default: UTC)."),
+			),
+			mcp.WithBoolean("active",
+				mcp.Description("Whether the schedule is active (GitLab default: true)."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			description, err := requiredParam[string](&request, "description")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			ref, err := requiredParam[string](&request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			cron, err := requiredParam[string](&request, "cron")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional parameters
+			cronTimezone, err := OptionalParam[string](&request, "cronTimezone")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			active, err := OptionalBoolParam(&request, "active")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.CreatePipelineScheduleOptions{
+				Description: &description,
+				Ref:         &ref,
+				Cron:        &cron,
+				Active:      active,
+			}
+
+			if cronTimezone != "" {
+				opts.CronTimezone = &cronTimezone
+			}
+
+			// --- Call GitLab API
+			schedule, resp, err := glClient.PipelineSchedules.CreatePipelineSchedule(projectID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("project %q", projectID), "create pipeline schedule")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(schedule)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal pipeline schedule data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// UpdateScheduledPipeline defines the MCP tool for editing an existing pipeline schedule.
+func UpdateScheduledPipeline(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"updateScheduledPipeline",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_UPDATE_SCHEDULED_PIPELINE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Update GitLab Pipeline Schedule",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("pipelineScheduleId",
+				mcp.Description("The ID of the pipeline schedule."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithString("description",
+				mcp.Description("The new description of the pipeline schedule."),
+			),
+			mcp.WithString("ref",
+				mcp.Description("The new branch or tag name the pipeline runs on."),
+			),
+			mcp.WithString("cron",
+				mcp.Description("The new cron schedule, e.g. '0 1 * * *'."),
+			),
+			mcp.WithString("cronTimezone",
+				mcp.Description("The new timezone of the cron schedule, e.g. 'Europe/Berlin'."),
+			),
+			mcp.WithBoolean("active",
+				mcp.Description("Activate or deactivate the schedule."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			scheduleIDFloat, err := requiredParam[float64](&request, "pipelineScheduleId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			scheduleID := int64(scheduleIDFloat)
+			if float64(scheduleID) != scheduleIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: pipelineScheduleId %v is not a valid integer", scheduleIDFloat)), nil
+			}
+
+			// --- Parse optional parameters
+			description, err := OptionalParam[string](&request, "description")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			ref, err := OptionalParam[string](&request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			cron, err := OptionalParam[string](&request, "cron")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			cronTimezone, err := OptionalParam[string](&request, "cronTimezone")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			active, err := OptionalBoolParam(&request, "active")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			if description == "" && ref == "" && cron == "" && cronTimezone == "" && active == nil {
+				return mcp.NewToolResultError("Validation Error: at least one of description, ref, cron, cronTimezone or active must be provided"), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.EditPipelineScheduleOptions{
+				Active: active,
+			}
+
+			if description != "" {
+				opts.Description = &description
+			}
+
+			if ref != "" {
+				opts.Ref = &ref
+			}
+
+			if cron != "" {
+				opts.Cron = &cron
+			}
+
+			if cronTimezone != "" {
+				opts.CronTimezone = &cronTimezone
+			}
+
+			// --- Call GitLab API
+			schedule, resp, err := glClient.PipelineSchedules.EditPipelineSchedule(projectID, scheduleID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("pipeline schedule %d in project %q", scheduleID, projectID), "update pipeline schedule")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(schedule)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal pipeline schedule data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// DeleteScheduledPipeline defines the MCP tool for deleting a pipeline schedule.
+func DeleteScheduledPipeline(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"deleteScheduledPipeline",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_DELETE_SCHEDULED_PIPELINE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Delete GitLab Pipeline Schedule",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("pipelineScheduleId",
+				mcp.Description("The ID of the pipeline schedule to delete."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			scheduleIDFloat, err := requiredParam[float64](&request, "pipelineScheduleId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			scheduleID := int64(scheduleIDFloat)
+			if float64(scheduleID) != scheduleIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: pipelineScheduleId %v is not a valid integer", scheduleIDFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			resp, err := glClient.PipelineSchedules.DeletePipelineSchedule(projectID, scheduleID, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("pipeline schedule %d in project %q", scheduleID, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf(`{"message":"Pipeline schedule %d successfully deleted from project %q"}`, scheduleID, projectID)), nil
+		}
+}
+
+// RunScheduledPipeline defines the MCP tool for triggering a pipeline schedule immediately.
+func RunScheduledPipeline(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"runScheduledPipeline",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_RUN_SCHEDULED_PIPELINE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Run GitLab Pipeline Schedule",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("pipelineScheduleId",
+				mcp.Description("The ID of the pipeline schedule to run."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			scheduleIDFloat, err := requiredParam[float64](&request, "pipelineScheduleId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			scheduleID := int64(scheduleIDFloat)
+			if float64(scheduleID) != scheduleIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: pipelineScheduleId %v is not a valid integer", scheduleIDFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			resp, err := glClient.PipelineSchedules.RunPipelineSchedule(projectID, scheduleID, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("pipeline schedule %d in project %q", scheduleID, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// GitLab only acknowledges the request; the pipeline itself is created asynchronously
+			return mcp.NewToolResultText(fmt.Sprintf(`{"message":"Pipeline schedule %d in project %q triggered"}`, scheduleID, projectID)), nil
+		}
+}
+
+// GetTestReport defines the MCP tool for retrieving the full test report of a pipeline.
+func GetTestReport(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"getTestReport",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_TEST_REPORT_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "Get GitLab Pipeline Test Report",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("pipelineId",
+				mcp.Description("The ID of the pipeline."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			pipelineIDFloat, err := requiredParam[float64](&request, "pipelineId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			pipelineID := int64(pipelineIDFloat)
+			if float64(pipelineID) != pipelineIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: pipelineId %v is not a valid integer", pipelineIDFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			report, resp, err := glClient.Pipelines.GetPipelineTestReport(projectID, pipelineID, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("test report for pipeline %d in project %q", pipelineID, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(testReportWithFailures{PipelineTestReport: report, FailedTests: failedTestNames(report)})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal test report data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// GetPipelineTestSummary defines the MCP tool for retrieving the per-suite test counts of a pipeline.
+func GetPipelineTestSummary(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"getPipelineTestSummary",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_PIPELINE_TEST_SUMMARY_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "Get GitLab Pipeline Test Summary",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("pipelineId",
+				mcp.Description("The ID of the pipeline."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			pipelineIDFloat, err := requiredParam[float64](&request, "pipelineId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			pipelineID := int64(pipelineIDFloat)
+			if float64(pipelineID) != pipelineIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: pipelineId %v is not a valid integer", pipelineIDFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			summary, resp, err := glClient.Pipelines.GetPipelineTestReportSummary(projectID, pipelineID, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("test report summary for pipeline %d in project %q", pipelineID, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(summary)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal test report summary data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// GetPipelineBridges defines the MCP tool for listing the bridge (trigger) jobs of a pipeline,
+// which link a pipeline to its downstream child or multi-project pipelines.
+func GetPipelineBridges(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"getPipelineBridges",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_PIPELINE_BRIDGES_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "Get GitLab Pipeline Bridges",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("pipelineId",
+				mcp.Description("The ID of the pipeline."),
+				mcp.Required(),
+			),
+			// Optional filtering parameters
+			mcp.WithString("scope",
+				mcp.Description("Return only bridge jobs with this status."),
+				mcp.Enum("created", "pending", "running", "failed", "success", "canceled", "skipped", "manual"),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			pipelineIDFloat, err := requiredParam[float64](&request, "pipelineId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			pipelineID := int64(pipelineIDFloat)
+			if float64(pipelineID) != pipelineIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: pipelineId %v is not a valid integer", pipelineIDFloat)), nil
+			}
+
+			// --- Parse optional filtering parameters
+			scope, err := OptionalParam[string](&request, "scope")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.ListJobsOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+			}
+
+			if scope != "" {
+				opts.Scope = &[]gl.BuildStateValue{gl.BuildStateValue(scope)}
+			}
+
+			// --- Call GitLab API
+			bridges, resp, err := glClient.Jobs.ListPipelineBridges(projectID, pipelineID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, fmt.Sprintf("bridges for pipeline %d in project %q", pipelineID, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(bridges) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(bridges)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal pipeline bridges list: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
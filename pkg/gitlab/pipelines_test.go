@@ -0,0 +1,524 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+func TestListScheduledPipelinesHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListScheduledPipelines(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockSchedules, ctrl := setupMockClientForPipelineSchedules(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListScheduledPipelines(mockGetClient, nil)
+
+	tests := []struct {
+		name               string
+		inputArgs          map[string]any
+		mockSetup          func()
+		expectedText       string
+		expectHandlerError bool
+		expectResultError  bool
+		errorContains      string
+	}{
+		{
+			name:      "Success - List schedules",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockSchedules.EXPECT().
+					ListPipelineSchedules("group/project", gomock.Any(), gomock.Any()).
+					Return([]*gl.PipelineSchedule{{ID: 1, Description: "Nightly", Ref: "main", Cron: "0 1 * * *"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: `"cron":"0 1 * * *"`,
+		},
+		{
+			name:      "Success - Empty list",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockSchedules.EXPECT().
+					ListPipelineSchedules("group/project", gomock.Any(), gomock.Any()).
+					Return([]*gl.PipelineSchedule{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: "[]",
+		},
+		{
+			name:              "Error - Missing projectId",
+			inputArgs:         map[string]any{},
+			mockSetup:         func() {},
+			expectResultError: true,
+			errorContains:     "Validation Error: missing required parameter: projectId",
+		},
+		{
+			name:      "Error - Forbidden (403)",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockSchedules.EXPECT().
+					ListPipelineSchedules("group/project", gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 403}}, errors.New("gitlab: 403 Forbidden"))
+			},
+			expectHandlerError: true,
+			errorContains:      "failed to list pipeline schedules from project",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.inputArgs}})
+
+			if tc.expectHandlerError {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			textContent := getTextResult(t, result)
+			if tc.expectResultError {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.errorContains)
+				return
+			}
+			assert.Contains(t, textContent.Text, tc.expectedText)
+		})
+	}
+}
+
+func TestCreateScheduledPipelineHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := CreateScheduledPipeline(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockSchedules, ctrl := setupMockClientForPipelineSchedules(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := CreateScheduledPipeline(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockSchedules.EXPECT().
+			CreatePipelineSchedule("group/project", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.CreatePipelineScheduleOptions, _ ...gl.RequestOptionFunc) (*gl.PipelineSchedule, *gl.Response, error) {
+				assert.Equal(t, "Nightly", *opts.Description)
+				assert.Equal(t, "main", *opts.Ref)
+				assert.Equal(t, "0 1 * * *", *opts.Cron)
+				assert.Equal(t, "Europe/Berlin", *opts.CronTimezone)
+				assert.False(t, *opts.Active)
+				return &gl.PipelineSchedule{ID: 4, Description: "Nightly"}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":    "group/project",
+			"description":  "Nightly",
+			"ref":          "main",
+			"cron":         "0 1 * * *",
+			"cronTimezone": "Europe/Berlin",
+			"active":       false,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"id":4`)
+	})
+
+	t.Run("Error - Missing cron", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":   "group/project",
+			"description": "Nightly",
+			"ref":         "main",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: cron")
+	})
+
+	t.Run("Error - Invalid cron (400)", func(t *testing.T) {
+		mockSchedules.EXPECT().
+			CreatePipelineSchedule("group/project", gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 400}}, errors.New("gitlab: 400 cron is invalid syntax"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":   "group/project",
+			"description": "Nightly",
+			"ref":         "main",
+			"cron":        "every night",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "failed to create pipeline schedule")
+	})
+}
+
+func TestUpdateScheduledPipelineHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := UpdateScheduledPipeline(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockSchedules, ctrl := setupMockClientForPipelineSchedules(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := UpdateScheduledPipeline(mockGetClient, nil)
+
+	t.Run("Success - Only given fields are sent", func(t *testing.T) {
+		mockSchedules.EXPECT().
+			EditPipelineSchedule("group/project", int64(4), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ int64, opts *gl.EditPipelineScheduleOptions, _ ...gl.RequestOptionFunc) (*gl.PipelineSchedule, *gl.Response, error) {
+				assert.Equal(t, "30 2 * * *", *opts.Cron)
+				assert.True(t, *opts.Active)
+				assert.Nil(t, opts.Description)
+				assert.Nil(t, opts.Ref)
+				assert.Nil(t, opts.CronTimezone)
+				return &gl.PipelineSchedule{ID: 4, Cron: "30 2 * * *", Active: true}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":          "group/project",
+			"pipelineScheduleId": 4.0,
+			"cron":               "30 2 * * *",
+			"active":             true,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"cron":"30 2 * * *"`)
+	})
+
+	t.Run("Error - No fields to update", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":          "group/project",
+			"pipelineScheduleId": 4.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: at least one of description, ref, cron, cronTimezone or active must be provided")
+	})
+
+	t.Run("Error - Non-integer pipelineScheduleId", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":          "group/project",
+			"pipelineScheduleId": 4.5,
+			"cron":               "30 2 * * *",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: pipelineScheduleId 4.5 is not a valid integer")
+	})
+}
+
+func TestDeleteScheduledPipelineHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := DeleteScheduledPipeline(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockSchedules, ctrl := setupMockClientForPipelineSchedules(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := DeleteScheduledPipeline(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockSchedules.EXPECT().
+			DeletePipelineSchedule("group/project", int64(4), gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 204}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":          "group/project",
+			"pipelineScheduleId": 4.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Pipeline schedule 4 successfully deleted")
+	})
+
+	t.Run("Error - Not found (404)", func(t *testing.T) {
+		mockSchedules.EXPECT().
+			DeletePipelineSchedule("group/project", int64(99), gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":          "group/project",
+			"pipelineScheduleId": 99.0,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "pipeline schedule 99 in project \"group/project\" not found")
+	})
+}
+
+func TestRunScheduledPipelineHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := RunScheduledPipeline(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockSchedules, ctrl := setupMockClientForPipelineSchedules(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := RunScheduledPipeline(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockSchedules.EXPECT().
+			RunPipelineSchedule("group/project", int64(4), gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 201}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":          "group/project",
+			"pipelineScheduleId": 4.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Pipeline schedule 4 in project")
+	})
+
+	t.Run("Error - Missing pipelineScheduleId", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: pipelineScheduleId")
+	})
+}
+
+func TestGetTestReportHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetTestReport(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockPipelines, ctrl := setupMockClientForPipelines(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := GetTestReport(mockGetClient, nil)
+
+	t.Run("Success - Failed test names are collected", func(t *testing.T) {
+		mockPipelines.EXPECT().
+			GetPipelineTestReport("group/project", int64(12), gomock.Any()).
+			Return(&gl.PipelineTestReport{
+				TotalCount:   3,
+				SuccessCount: 1,
+				FailedCount:  1,
+				ErrorCount:   1,
+				TestSuites: []*gl.PipelineTestSuites{{
+					Name: "rspec",
+					TestCases: []*gl.PipelineTestCases{
+						{Status: "success", Name: "passes", Classname: "spec.user"},
+						{Status: "failed", Name: "validates email", Classname: "spec.user"},
+						{Status: "error", Name: "boots"},
+					},
+				}},
+			}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":  "group/project",
+			"pipelineId": 12.0,
+		}}})
+		require.NoError(t, err)
+		text := getTextResult(t, result).Text
+		assert.Contains(t, text, `"total_count":3`)
+		assert.Contains(t, text, `"failed_tests":["spec.user.validates email","boots"]`)
+	})
+
+	t.Run("Success - No failures", func(t *testing.T) {
+		mockPipelines.EXPECT().
+			GetPipelineTestReport("group/project", int64(13), gomock.Any()).
+			Return(&gl.PipelineTestReport{TotalCount: 0}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":  "group/project",
+			"pipelineId": 13.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"failed_tests":[]`)
+	})
+
+	t.Run("Error - Non-integer pipelineId", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":  "group/project",
+			"pipelineId": 1.5,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: pipelineId 1.5 is not a valid integer")
+	})
+
+	t.Run("Error - Pipeline not found (404)", func(t *testing.T) {
+		mockPipelines.EXPECT().
+			GetPipelineTestReport("group/project", int64(99), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":  "group/project",
+			"pipelineId": 99.0,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "test report for pipeline 99")
+	})
+}
+
+func TestGetPipelineTestSummaryHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetPipelineTestSummary(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockPipelines, ctrl := setupMockClientForPipelines(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := GetPipelineTestSummary(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockPipelines.EXPECT().
+			GetPipelineTestReportSummary("group/project", int64(12), gomock.Any()).
+			Return(&gl.PipelineTestReportSummary{
+				Total: gl.PipelineTotalSummary{Count: 10, Success: 9, Failed: 1},
+			}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":  "group/project",
+			"pipelineId": 12.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"failed":1`)
+	})
+
+	t.Run("Error - Missing pipelineId", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: pipelineId")
+	})
+}
+
+func TestGetPipelineBridgesHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetPipelineBridges(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockJobs, ctrl := setupMockClientForJobs(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := GetPipelineBridges(mockGetClient, nil)
+
+	tests := []struct {
+		name               string
+		inputArgs          map[string]any
+		mockSetup          func()
+		expectedText       string
+		expectHandlerError bool
+		expectResultError  bool
+		errorContains      string
+	}{
+		{
+			name:      "Success - List bridges with scope",
+			inputArgs: map[string]any{"projectId": "group/project", "pipelineId": 12.0, "scope": "failed"},
+			mockSetup: func() {
+				mockJobs.EXPECT().
+					ListPipelineBridges("group/project", int64(12), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, _ int64, opts *gl.ListJobsOptions, _ ...gl.RequestOptionFunc) ([]*gl.Bridge, *gl.Response, error) {
+						assert.Equal(t, []gl.BuildStateValue{gl.Failed}, *opts.Scope)
+						return []*gl.Bridge{{
+							ID:                 30,
+							Name:               "trigger-child",
+							Status:             "failed",
+							DownstreamPipeline: &gl.PipelineInfo{ID: 13, Status: "failed"},
+						}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+					})
+			},
+			expectedText: `"downstream_pipeline":{"id":13`,
+		},
+		{
+			name:      "Success - Empty list",
+			inputArgs: map[string]any{"projectId": "group/project", "pipelineId": 12.0},
+			mockSetup: func() {
+				mockJobs.EXPECT().
+					ListPipelineBridges("group/project", int64(12), &gl.ListJobsOptions{ListOptions: gl.ListOptions{Page: 1, PerPage: 20}}, gomock.Any()).
+					Return([]*gl.Bridge{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: "[]",
+		},
+		{
+			name:              "Error - Non-integer pipelineId",
+			inputArgs:         map[string]any{"projectId": "group/project", "pipelineId": 12.5},
+			mockSetup:         func() {},
+			expectResultError: true,
+			errorContains:     "Validation Error: pipelineId 12.5 is not a valid integer",
+		},
+		{
+			name:      "Error - Forbidden (403)",
+			inputArgs: map[string]any{"projectId": "group/project", "pipelineId": 12.0},
+			mockSetup: func() {
+				mockJobs.EXPECT().
+					ListPipelineBridges("group/project", int64(12), gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 403}}, errors.New("gitlab: 403 Forbidden"))
+			},
+			expectHandlerError: true,
+			errorContains:      "failed to list bridges for pipeline 12",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.inputArgs}})
+
+			if tc.expectHandlerError {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			textContent := getTextResult(t, result)
+			if tc.expectResultError {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.errorContains)
+				return
+			}
+			assert.Contains(t, textContent.Text, tc.expectedText)
+		})
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -19,6 +21,7 @@ func GetProject(getClient GetClientFn, t map[string]string) (tool mcp.Tool, hand
 	return mcp.NewTool(
 			"getProject",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_PROJECT_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        "Get Project Details",
 				ReadOnlyHint: boolPtr(true),
@@ -27,6 +30,7 @@ func GetProject(getClient GetClientFn, t map[string]string) (tool mcp.Tool, hand
 				mcp.Required(),
 				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
 			),
+			WithFields(),
 		),
 
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -35,6 +39,10 @@ func GetProject(getClient GetClientFn, t map[string]string) (tool mcp.Tool, hand
 			if err != nil {
 				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
 			}
+			fields, err := OptionalFieldsParam(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
 			// --- Obtain GitLab client
 			glClient, err := getClient(ctx)
 			if err != nil {
@@ -59,6 +67,10 @@ func GetProject(getClient GetClientFn, t map[string]string) (tool mcp.Tool, hand
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal project data: %w", err)
 			}
+			data, err = filterFields(data, fields)
+			if err != nil {
+				return nil, fmt.Errorf("failed to filter project fields: %w", err)
+			}
 			return mcp.NewToolResultText(string(data)), nil
 		} // End handler func assignment
 }
@@ -68,6 +80,7 @@ func ListProjects(getClient GetClientFn, t map[string]string) (tool mcp.Tool, ha
 	return mcp.NewTool(
 			"listProjects",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_PROJECTS_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        "List Projects",
 				ReadOnlyHint: boolPtr(true),
@@ -89,8 +102,10 @@ func ListProjects(getClient GetClientFn, t map[string]string) (tool mcp.Tool, ha
 				mcp.Description("Return projects sorted in asc or desc order."),
 				mcp.Enum("asc", "desc"),
 			),
+			WithFields(),
 			// Add standard MCP pagination parameters
 			WithPagination(),
+			WithKeysetPagination(),
 		),
 		// Handler function implementation
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -123,10 +138,18 @@ func ListProjects(getClient GetClientFn, t map[string]string) (tool mcp.Tool, ha
 			if err != nil {
 				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
 			}
+			fields, err := OptionalFieldsParam(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
 			page, perPage, err := OptionalPaginationParams(&request)
 			if err != nil {
 				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
 			}
+			paginationMode, cursor, err := OptionalPaginationModeParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
 
 			// --- Construct GitLab API options
 			opts := &gl.ListProjectsOptions{
@@ -154,6 +177,22 @@ func ListProjects(getClient GetClientFn, t map[string]string) (tool mcp.Tool, ha
 				opts.Sort = &sortVal
 			}
 
+			reqOpts := []gl.RequestOptionFunc{gl.WithContext(ctx)}
+			if paginationMode == PaginationModeKeyset {
+				// Keyset pagination requires an explicit ordering
+				if opts.OrderBy == nil {
+					opts.OrderBy = gl.Ptr("id")
+				}
+				if opts.Sort == nil {
+					opts.Sort = gl.Ptr("asc")
+				}
+				cursorOpts, err := applyKeysetPagination(&opts.ListOptions, cursor)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+				}
+				reqOpts = append(reqOpts, cursorOpts...)
+			}
+
 			// --- Obtain GitLab client
 			glClient, err := getClient(ctx)
 			if err != nil {
@@ -161,7 +200,7 @@ func ListProjects(getClient GetClientFn, t map[string]string) (tool mcp.Tool, ha
 			}
 
 			// --- Call GitLab API
-			projects, resp, err := glClient.Projects.ListProjects(opts, gl.WithContext(ctx))
+			projects, resp, err := glClient.Projects.ListProjects(opts, reqOpts...)
 
 			// --- Handle API errors
 			if err != nil {
@@ -184,6 +223,12 @@ func ListProjects(getClient GetClientFn, t map[string]string) (tool mcp.Tool, ha
 			if err != nil {
 				return nil, fmt.Errorf("failed to optimize projects response: %w", err)
 			}
+			if paginationMode == PaginationModeKeyset {
+				optimized.NextCursor = ExtractNextCursor(resp)
+			}
+			if err := optimized.SelectFields(fields); err != nil {
+				return nil, fmt.Errorf("failed to filter project fields: %w", err)
+			}
 
 			// --- Handle empty result gracefully
 			if len(projects) == 0 {
@@ -206,3 +251,303 @@ func ListProjects(getClient GetClientFn, t map[string]string) (tool mcp.Tool, ha
 			return mcp.NewToolResultText(string(data)), nil
 		}
 }
+
+// GetProjectStatistics defines the MCP tool for retrieving the commit count and storage usage of a GitLab project.
+func GetProjectStatistics(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"getProjectStatistics",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_PROJECT_STATISTICS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "Get Project Statistics",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			mcp.WithString("projectId",
+				mcp.Required(),
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			// The REST API has no dedicated statistics endpoint; they are embedded in the project on request
+			opts := &gl.GetProjectOptions{
+				Statistics: gl.Ptr(true),
+			}
+			project, resp, err := glClient.Projects.GetProject(projectID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("project %q", projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// GitLab omits statistics for users below the Reporter role
+			if project.Statistics == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("statistics for project %q are not available; at least the Reporter role is required", projectID)), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(project.Statistics)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal project statistics: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// GetProjectLanguages defines the MCP tool for retrieving the language breakdown of a GitLab project.
+func GetProjectLanguages(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"getProjectLanguages",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_PROJECT_LANGUAGES_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "Get Project Languages",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			mcp.WithString("projectId",
+				mcp.Required(),
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			languages, resp, err := glClient.Projects.GetProjectLanguages(projectID, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("project %q", projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if languages == nil || len(*languages) == 0 {
+				return mcp.NewToolResultText("{}"), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(languages)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal project languages: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// projectInsightsCacheTTL is how long getProjectInsights reuses a computed result.
+const projectInsightsCacheTTL = 60 * time.Second
+
+// projectInsights is the response of the getProjectInsights tool. Counts that are not
+// visible to the current user, or that GitLab does not report, are null.
+type projectInsights struct {
+	ContributorsCount      *int64 `json:"contributors_count"`
+	TotalCommits           *int64 `json:"total_commits"`
+	OpenIssuesCount        int64  `json:"open_issues_count"`
+	OpenMergeRequestsCount *int64 `json:"open_merge_requests_count"`
+	StorageSizeBytes       *int64 `json:"storage_size_bytes"`
+}
+
+// projectInsightsCacheKey identifies a cached getProjectInsights result. The client
+// stands for the server and token it was resolved for, so that counts visible to one
+// user are not served to another.
+type projectInsightsCacheKey struct {
+	client    *gl.Client
+	projectID string
+	ref       string
+}
+
+// projectInsightsCacheEntry is a cached getProjectInsights result.
+type projectInsightsCacheEntry struct {
+	data      string
+	expiresAt time.Time
+}
+
+// projectInsightsCache holds getProjectInsights results for projectInsightsCacheTTL.
+// Expired entries are removed whenever a result is stored, so that entries of clients
+// that were replaced, e.g. after a token refresh, do not keep those clients alive.
+type projectInsightsCache struct {
+	entries sync.Map // projectInsightsCacheKey → projectInsightsCacheEntry
+}
+
+// load returns the cached result for key if it has not expired at now.
+func (c *projectInsightsCache) load(key projectInsightsCacheKey, now time.Time) (string, bool) {
+	cached, ok := c.entries.Load(key)
+	if !ok {
+		return "", false
+	}
+	entry := cached.(projectInsightsCacheEntry)
+	if !now.Before(entry.expiresAt) {
+		c.entries.Delete(key)
+		return "", false
+	}
+	return entry.data, true
+}
+
+// store caches data for key from now on and removes all entries expired at now.
+func (c *projectInsightsCache) store(key projectInsightsCacheKey, data string, now time.Time) {
+	c.entries.Range(func(k, v any) bool {
+		if !now.Before(v.(projectInsightsCacheEntry).expiresAt) {
+			c.entries.Delete(k)
+		}
+		return true
+	})
+	c.entries.Store(key, projectInsightsCacheEntry{
+		data:      data,
+		expiresAt: now.Add(projectInsightsCacheTTL),
+	})
+}
+
+// totalItems returns the item count of a paginated response from its X-Total header.
+// GitLab omits the header for very large lists; the count is then only known if there
+// is no next page, and nil otherwise.
+func totalItems(resp *gl.Response, received int) *int64 {
+	switch {
+	case resp == nil:
+		return nil
+	case resp.TotalItems > 0 || (resp.Response != nil && resp.Header.Get("X-Total") != ""):
+		return gl.Ptr(resp.TotalItems)
+	case resp.NextPage == 0:
+		return gl.Ptr(int64(received))
+	}
+	return nil
+}
+
+// GetProjectInsights defines the MCP tool for retrieving summary analytics of a GitLab project.
+// Results are cached per client, project and ref for projectInsightsCacheTTL.
+//
+// The counts come from GetProject with statistics (open issues, commits, storage size),
+// Repositories.Contributors and ListProjectMergeRequests, whose X-Total headers give the
+// totals. The approval rules and the statistics endpoint are not used: approval rules
+// carry none of the returned counts, and GetProject already includes the statistics.
+func GetProjectInsights(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	var cache projectInsightsCache
+
+	return mcp.NewTool(
+			"getProjectInsights",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_PROJECT_INSIGHTS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "Get Project Insights",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			mcp.WithString("projectId",
+				mcp.Required(),
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Optional branch or tag to count contributors on. Defaults to the default branch."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			ref, err := OptionalParam[string](&request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			cacheKey := projectInsightsCacheKey{client: glClient, projectID: projectID, ref: ref}
+			if data, ok := cache.load(cacheKey, time.Now()); ok {
+				return mcp.NewToolResultText(data), nil
+			}
+
+			// --- Project with statistics: open issues, commit count and storage size
+			project, resp, err := glClient.Projects.GetProject(projectID, &gl.GetProjectOptions{
+				Statistics: gl.Ptr(true),
+			}, gl.WithContext(ctx))
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("project %q", projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			insights := projectInsights{
+				OpenIssuesCount: project.OpenIssuesCount,
+			}
+			// GitLab omits statistics for users below the Reporter role
+			if project.Statistics != nil {
+				insights.TotalCommits = gl.Ptr(project.Statistics.CommitCount)
+				insights.StorageSizeBytes = gl.Ptr(project.Statistics.StorageSize)
+			}
+
+			// --- Contributors: only the total is needed
+			contributorOpts := []gl.RequestOptionFunc{gl.WithContext(ctx)}
+			if ref != "" {
+				contributorOpts = append(contributorOpts, withQueryParam("ref", ref))
+			}
+			contributors, resp, err := glClient.Repositories.Contributors(projectID, &gl.ListContributorsOptions{
+				ListOptions: gl.ListOptions{PerPage: 1},
+			}, contributorOpts...)
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("contributors of project %q", projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+			insights.ContributorsCount = totalItems(resp, len(contributors))
+
+			// --- Open merge requests: only the total is needed
+			mrs, resp, err := glClient.MergeRequests.ListProjectMergeRequests(projectID, &gl.ListProjectMergeRequestsOptions{
+				State:       gl.Ptr("opened"),
+				ListOptions: gl.ListOptions{PerPage: 1},
+			}, gl.WithContext(ctx))
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("merge requests of project %q", projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+			insights.OpenMergeRequestsCount = totalItems(resp, len(mrs))
+
+			// --- Marshal, cache and return success
+			data, err := json.Marshal(insights)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal project insights: %w", err)
+			}
+			cache.store(cacheKey, string(data), time.Now())
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
@@ -6,8 +6,10 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -15,6 +17,7 @@ import (
 
 	"github.com/mark3labs/mcp-go/mcp"
 	gl "gitlab.com/gitlab-org/api/client-go" // GitLab client library
+	mock_gitlab "gitlab.com/gitlab-org/api/client-go/testing"
 
 	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
 )
@@ -202,6 +205,31 @@ func TestGetProjectHandler(t *testing.T) {
 	}
 }
 
+func TestGetProjectHandler_Fields(t *testing.T) {
+	ctx := context.Background()
+	mockClient, mockProjects, ctrl := setupMockClient(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+	_, handler := GetProject(mockGetClient, nil)
+
+	mockProjects.EXPECT().
+		GetProject("group/project", gomock.Any(), gomock.Any()).
+		Return(&gl.Project{ID: 123, Name: "Test Project", Description: "A long description", DefaultBranch: "main"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+	result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"projectId": "group/project",
+		"fields":    "id, name,unknown",
+	}}})
+	require.NoError(t, err)
+
+	var project map[string]any
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &project))
+	assert.Equal(t, map[string]any{"id": float64(123), "name": "Test Project"}, project)
+}
+
 // Add tests for ListProjects here later (Subtask 7.1)
 func TestListProjectsHandler(t *testing.T) {
 	// Tool schema snapshot test
@@ -519,3 +547,359 @@ func TestListProjectsHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestListProjectsHandler_KeysetPagination(t *testing.T) {
+	var srvURL string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		assert.Equal(t, "keyset", query.Get("pagination"))
+		assert.Equal(t, "id", query.Get("order_by"))
+		assert.Equal(t, "asc", query.Get("sort"))
+		assert.Empty(t, query.Get("page"), "page is not sent in keyset mode")
+
+		w.Header().Set("Content-Type", "application/json")
+		if query.Get("id_after") == "" {
+			w.Header().Set("Link", fmt.Sprintf(`<%s/api/v4/projects?id_after=2&order_by=id&pagination=keyset&per_page=2&sort=asc>; rel="next"`, srvURL))
+			_, _ = w.Write([]byte(`[{"id":1,"name":"one"},{"id":2,"name":"two"}]`))
+			return
+		}
+		assert.Equal(t, "2", query.Get("id_after"))
+		_, _ = w.Write([]byte(`[{"id":3,"name":"three"}]`))
+	}))
+	defer srv.Close()
+	srvURL = srv.URL
+
+	client, err := gl.NewClient("token", gl.WithBaseURL(srv.URL), gl.WithoutRetries())
+	require.NoError(t, err)
+	_, handler := ListProjects(func(_ context.Context) (*gl.Client, error) { return client, nil }, nil)
+
+	callList := func(args map[string]any) PaginatedResponse {
+		result, err := handler(context.Background(), *createMCPRequest(args))
+		require.NoError(t, err)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+		var response PaginatedResponse
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &response))
+		return response
+	}
+
+	first := callList(map[string]any{"pagination": "keyset", "per_page": 2})
+	assert.Len(t, first.Items, 2)
+	require.NotEmpty(t, first.NextCursor)
+
+	last := callList(map[string]any{"cursor": first.NextCursor, "per_page": 2})
+	assert.Len(t, last.Items, 1)
+	assert.Empty(t, last.NextCursor, "last page has no cursor")
+
+	result, err := handler(context.Background(), *createMCPRequest(map[string]any{"cursor": "not a cursor!"}))
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, getTextResult(t, result).Text, "Validation Error: invalid cursor")
+}
+
+func TestGetProjectStatisticsHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetProjectStatistics(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockProjects, ctrl := setupMockClient(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := GetProjectStatistics(mockGetClient, nil)
+
+	t.Run("Success - Statistics requested and returned", func(t *testing.T) {
+		mockProjects.EXPECT().
+			GetProject("group/project", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.GetProjectOptions, _ ...gl.RequestOptionFunc) (*gl.Project, *gl.Response, error) {
+				assert.True(t, *opts.Statistics)
+				return &gl.Project{ID: 1, Statistics: &gl.Statistics{CommitCount: 42, RepositorySize: 1024}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+		}}})
+		require.NoError(t, err)
+		text := getTextResult(t, result).Text
+		assert.Contains(t, text, `"commit_count":42`)
+		assert.Contains(t, text, `"repository_size":1024`)
+	})
+
+	t.Run("Error - Statistics not visible", func(t *testing.T) {
+		mockProjects.EXPECT().
+			GetProject("group/project", gomock.Any(), gomock.Any()).
+			Return(&gl.Project{ID: 1}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "Reporter role")
+	})
+
+	t.Run("Error - Project not found (404)", func(t *testing.T) {
+		mockProjects.EXPECT().
+			GetProject("missing", gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Project Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "missing",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "not found or access denied")
+	})
+}
+
+func TestGetProjectLanguagesHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetProjectLanguages(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockProjects, ctrl := setupMockClient(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := GetProjectLanguages(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockProjects.EXPECT().
+			GetProjectLanguages("group/project", gomock.Any()).
+			Return(&gl.ProjectLanguages{"Go": 87.5, "Shell": 12.5}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+		}}})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"Go":87.5,"Shell":12.5}`, getTextResult(t, result).Text)
+	})
+
+	t.Run("Success - Empty repository", func(t *testing.T) {
+		mockProjects.EXPECT().
+			GetProjectLanguages("group/empty", gomock.Any()).
+			Return(&gl.ProjectLanguages{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/empty",
+		}}})
+		require.NoError(t, err)
+		assert.Equal(t, "{}", getTextResult(t, result).Text)
+	})
+
+	t.Run("Error - Missing projectId", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: projectId")
+	})
+}
+
+func TestGetProjectInsightsHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetProjectInsights(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockProjects, ctrl := setupMockClient(t)
+	defer ctrl.Finish()
+	mockRepos := mock_gitlab.NewMockRepositoriesServiceInterface(ctrl)
+	mockMRs := mock_gitlab.NewMockMergeRequestsServiceInterface(ctrl)
+	mockClient.Repositories = mockRepos
+	mockClient.MergeRequests = mockMRs
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	t.Run("Success - Composes the three calls and caches the result", func(t *testing.T) {
+		_, handler := GetProjectInsights(mockGetClient, nil)
+
+		mockProjects.EXPECT().
+			GetProject("group/project", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.GetProjectOptions, _ ...gl.RequestOptionFunc) (*gl.Project, *gl.Response, error) {
+				assert.True(t, *opts.Statistics)
+				return &gl.Project{ID: 1, OpenIssuesCount: 7, Statistics: &gl.Statistics{CommitCount: 420, StorageSize: 2048}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+			}).
+			Times(1)
+		mockRepos.EXPECT().
+			Contributors("group/project", gomock.Any(), gomock.Any()).
+			Return([]*gl.Contributor{{Name: "alice"}}, &gl.Response{Response: &http.Response{StatusCode: 200}, TotalItems: 12}, nil).
+			Times(1)
+		mockMRs.EXPECT().
+			ListProjectMergeRequests("group/project", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.ListProjectMergeRequestsOptions, _ ...gl.RequestOptionFunc) ([]*gl.BasicMergeRequest, *gl.Response, error) {
+				assert.Equal(t, "opened", *opts.State)
+				return []*gl.BasicMergeRequest{{IID: 1}}, &gl.Response{Response: &http.Response{StatusCode: 200}, TotalItems: 3}, nil
+			}).
+			Times(1)
+
+		expected := `{"contributors_count":12,"total_commits":420,"open_issues_count":7,"open_merge_requests_count":3,"storage_size_bytes":2048}`
+		for range 2 {
+			result, err := handler(ctx, *createMCPRequest(map[string]any{"projectId": "group/project"}))
+			require.NoError(t, err)
+			assert.JSONEq(t, expected, getTextResult(t, result).Text)
+		}
+	})
+
+	t.Run("Success - Statistics not visible and no X-Total header", func(t *testing.T) {
+		_, handler := GetProjectInsights(mockGetClient, nil)
+
+		mockProjects.EXPECT().
+			GetProject("group/project", gomock.Any(), gomock.Any()).
+			Return(&gl.Project{ID: 1, OpenIssuesCount: 2}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+		mockRepos.EXPECT().
+			Contributors("group/project", gomock.Any(), gomock.Any()).
+			Return([]*gl.Contributor{{Name: "alice"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+		mockMRs.EXPECT().
+			ListProjectMergeRequests("group/project", gomock.Any(), gomock.Any()).
+			Return([]*gl.BasicMergeRequest{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{"projectId": "group/project", "ref": "develop"}))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"contributors_count":1,"total_commits":null,"open_issues_count":2,"open_merge_requests_count":0,"storage_size_bytes":null}`, getTextResult(t, result).Text)
+	})
+
+	t.Run("Success - Counts unknown without X-Total header are null", func(t *testing.T) {
+		_, handler := GetProjectInsights(mockGetClient, nil)
+
+		// GitLab omits X-Total for very large lists but still reports the next page
+		mockProjects.EXPECT().
+			GetProject("group/huge", gomock.Any(), gomock.Any()).
+			Return(&gl.Project{ID: 2, OpenIssuesCount: 2}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+		mockRepos.EXPECT().
+			Contributors("group/huge", gomock.Any(), gomock.Any()).
+			Return([]*gl.Contributor{{Name: "alice"}}, &gl.Response{Response: &http.Response{StatusCode: 200}, NextPage: 2}, nil)
+		mockMRs.EXPECT().
+			ListProjectMergeRequests("group/huge", gomock.Any(), gomock.Any()).
+			Return([]*gl.BasicMergeRequest{{IID: 1}}, &gl.Response{Response: &http.Response{StatusCode: 200}, NextPage: 2}, nil)
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{"projectId": "group/huge"}))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"contributors_count":null,"total_commits":null,"open_issues_count":2,"open_merge_requests_count":null,"storage_size_bytes":null}`, getTextResult(t, result).Text)
+	})
+
+	t.Run("Success - Cache is not shared between clients", func(t *testing.T) {
+		otherClient, otherProjects, otherCtrl := setupMockClient(t)
+		defer otherCtrl.Finish()
+		otherRepos := mock_gitlab.NewMockRepositoriesServiceInterface(otherCtrl)
+		otherMRs := mock_gitlab.NewMockMergeRequestsServiceInterface(otherCtrl)
+		otherClient.Repositories = otherRepos
+		otherClient.MergeRequests = otherMRs
+
+		// The client is resolved per call, e.g. from the gitlabServer argument
+		useOther := false
+		_, handler := GetProjectInsights(func(_ context.Context) (*gl.Client, error) {
+			if useOther {
+				return otherClient, nil
+			}
+			return mockClient, nil
+		}, nil)
+
+		mockProjects.EXPECT().
+			GetProject("group/shared", gomock.Any(), gomock.Any()).
+			Return(&gl.Project{ID: 3, OpenIssuesCount: 1, Statistics: &gl.Statistics{CommitCount: 5, StorageSize: 100}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+		mockRepos.EXPECT().
+			Contributors("group/shared", gomock.Any(), gomock.Any()).
+			Return([]*gl.Contributor{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+		mockMRs.EXPECT().
+			ListProjectMergeRequests("group/shared", gomock.Any(), gomock.Any()).
+			Return([]*gl.BasicMergeRequest{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+		otherProjects.EXPECT().
+			GetProject("group/shared", gomock.Any(), gomock.Any()).
+			Return(&gl.Project{ID: 3, OpenIssuesCount: 1}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+		otherRepos.EXPECT().
+			Contributors("group/shared", gomock.Any(), gomock.Any()).
+			Return([]*gl.Contributor{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+		otherMRs.EXPECT().
+			ListProjectMergeRequests("group/shared", gomock.Any(), gomock.Any()).
+			Return([]*gl.BasicMergeRequest{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{"projectId": "group/shared"}))
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"storage_size_bytes":100`)
+
+		useOther = true
+		result, err = handler(ctx, *createMCPRequest(map[string]any{"projectId": "group/shared"}))
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"storage_size_bytes":null`)
+	})
+
+	t.Run("Error - Project not found is not cached", func(t *testing.T) {
+		_, handler := GetProjectInsights(mockGetClient, nil)
+
+		mockProjects.EXPECT().
+			GetProject("missing", gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Project Not Found")).
+			Times(2)
+
+		for range 2 {
+			result, err := handler(ctx, *createMCPRequest(map[string]any{"projectId": "missing"}))
+			require.NoError(t, err)
+			assert.True(t, result.IsError)
+			assert.Contains(t, getTextResult(t, result).Text, "not found or access denied")
+		}
+	})
+
+	t.Run("Error - Missing projectId", func(t *testing.T) {
+		_, handler := GetProjectInsights(mockGetClient, nil)
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{}))
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: projectId")
+	})
+}
+
+func TestProjectInsightsCache(t *testing.T) {
+	now := time.Now()
+	oldClient, newClient := &gl.Client{}, &gl.Client{}
+	oldKey := projectInsightsCacheKey{client: oldClient, projectID: "group/proj"}
+	newKey := projectInsightsCacheKey{client: newClient, projectID: "group/proj"}
+
+	countEntries := func(c *projectInsightsCache) int {
+		n := 0
+		c.entries.Range(func(_, _ any) bool {
+			n++
+			return true
+		})
+		return n
+	}
+
+	t.Run("Entries expire after the TTL", func(t *testing.T) {
+		var c projectInsightsCache
+		c.store(oldKey, "old", now)
+
+		data, ok := c.load(oldKey, now.Add(projectInsightsCacheTTL-time.Second))
+		require.True(t, ok)
+		assert.Equal(t, "old", data)
+
+		_, ok = c.load(oldKey, now.Add(projectInsightsCacheTTL))
+		assert.False(t, ok)
+		assert.Equal(t, 0, countEntries(&c))
+	})
+
+	t.Run("Storing removes expired entries of other clients", func(t *testing.T) {
+		var c projectInsightsCache
+		c.store(oldKey, "old", now)
+		c.store(newKey, "new", now.Add(projectInsightsCacheTTL))
+
+		assert.Equal(t, 1, countEntries(&c))
+		data, ok := c.load(newKey, now.Add(projectInsightsCacheTTL))
+		require.True(t, ok)
+		assert.Equal(t, "new", data)
+	})
+
+	t.Run("Storing keeps live entries", func(t *testing.T) {
+		var c projectInsightsCache
+		c.store(oldKey, "old", now)
+		c.store(newKey, "new", now.Add(time.Second))
+
+		assert.Equal(t, 2, countEntries(&c))
+	})
+}
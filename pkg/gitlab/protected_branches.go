@@ -0,0 +1,335 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gl "gitlab.com/gitlab-org/api/client-go"
+)
+
+// protectionAccessLevelDescription documents the access levels accepted by branch and tag protection
+const protectionAccessLevelDescription = "0 (no one), 30 (developers and maintainers), 40 (maintainers), 60 (administrators)."
+
+// optionalProtectionAccessLevel reads an optional protection access level parameter.
+// A level of 0 (no one) is meaningful, so only presence decides whether it is returned.
+func optionalProtectionAccessLevel(r *mcp.CallToolRequest, p string) (*gl.AccessLevelValue, error) {
+	if _, ok := r.GetArguments()[p]; !ok {
+		return nil, nil
+	}
+
+	levelFloat, err := OptionalParam[float64](r, p)
+	if err != nil {
+		return nil, err
+	}
+
+	level := gl.AccessLevelValue(levelFloat)
+	switch level {
+	case gl.NoPermissions, gl.DeveloperPermissions, gl.MaintainerPermissions, gl.AdminPermissions:
+		if float64(level) == levelFloat {
+			return &level, nil
+		}
+	}
+	return nil, fmt.Errorf("%s %v is not a valid protection access level; use %s", p, levelFloat, protectionAccessLevelDescription)
+}
+
+// ListProtectedBranches defines the MCP tool for listing the protected branches of a GitLab project.
+func ListProtectedBranches(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listProtectedBranches",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_PROTECTED_BRANCHES_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Protected Branches",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			// Optional filtering parameters
+			mcp.WithString("search",
+				mcp.Description("Return protected branches whose name matches the search term."),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional filtering parameters
+			search, err := OptionalParam[string](&request, "search")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.ListProtectedBranchesOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+			}
+
+			if search != "" {
+				opts.Search = &search
+			}
+
+			// --- Call GitLab API
+			branches, resp, err := glClient.ProtectedBranches.ListProtectedBranches(projectID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, fmt.Sprintf("protected branches from project %q", projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(branches) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(branches)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal protected branches list: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// GetProtectedBranch defines the MCP tool for retrieving the protection rules of a single branch.
+func GetProtectedBranch(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"getProtectedBranch",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_PROTECTED_BRANCH_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "Get GitLab Protected Branch",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("name",
+				mcp.Description("The name of the protected branch or wildcard (e.g. release/*)."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			name, err := requiredParam[string](&request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			branch, resp, err := glClient.ProtectedBranches.GetProtectedBranch(projectID, name, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("protected branch %q in project %q", name, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(branch)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal protected branch data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// ProtectBranch defines the MCP tool for protecting a branch or wildcard of a GitLab project.
+func ProtectBranch(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"protectBranch",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_PROTECT_BRANCH_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Protect GitLab Branch",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("name",
+				mcp.Description("The name of the branch or wildcard (e.g. release/*) to protect."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithNumber("pushAccessLevel",
+				mcp.Description("Access level allowed to push: "+protectionAccessLevelDescription+" GitLab default: 40."),
+			),
+			mcp.WithNumber("mergeAccessLevel",
+				mcp.Description("Access level allowed to merge: "+protectionAccessLevelDescription+" GitLab default: 40."),
+			),
+			mcp.WithBoolean("allowForcePush",
+				mcp.Description("Allow users with push access to force push. GitLab default: false."),
+			),
+			mcp.WithBoolean("codeOwnerApprovalRequired",
+				mcp.Description("Reject pushes to this branch that change files listed in CODEOWNERS (GitLab Premium). GitLab default: false."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			name, err := requiredParam[string](&request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional parameters
+			pushAccessLevel, err := optionalProtectionAccessLevel(&request, "pushAccessLevel")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			mergeAccessLevel, err := optionalProtectionAccessLevel(&request, "mergeAccessLevel")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			allowForcePush, err := OptionalBoolParam(&request, "allowForcePush")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			codeOwnerApprovalRequired, err := OptionalBoolParam(&request, "codeOwnerApprovalRequired")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.ProtectRepositoryBranchesOptions{
+				Name:                      &name,
+				PushAccessLevel:           pushAccessLevel,
+				MergeAccessLevel:          mergeAccessLevel,
+				AllowForcePush:            allowForcePush,
+				CodeOwnerApprovalRequired: codeOwnerApprovalRequired,
+			}
+
+			// --- Call GitLab API
+			branch, resp, err := glClient.ProtectedBranches.ProtectRepositoryBranches(projectID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("project %q", projectID), "protect branch")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(branch)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal protected branch data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// UnprotectBranch defines the MCP tool for removing the protection of a branch or wildcard.
+func UnprotectBranch(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"unprotectBranch",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_UNPROTECT_BRANCH_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Unprotect GitLab Branch",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("name",
+				mcp.Description("The name of the protected branch or wildcard to unprotect."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			name, err := requiredParam[string](&request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			resp, err := glClient.ProtectedBranches.UnprotectRepositoryBranches(projectID, name, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("protected branch %q in project %q", name, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf(`{"message":"Branch %q successfully unprotected in project %q"}`, name, projectID)), nil
+		}
+}
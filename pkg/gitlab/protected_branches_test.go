@@ -0,0 +1,268 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+func TestListProtectedBranchesHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListProtectedBranches(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockProtectedBranches, ctrl := setupMockClientForProtectedBranches(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListProtectedBranches(mockGetClient, nil)
+
+	tests := []struct {
+		name               string
+		inputArgs          map[string]any
+		mockSetup          func()
+		expectedText       string
+		expectHandlerError bool
+		expectResultError  bool
+		errorContains      string
+	}{
+		{
+			name:      "Success - Search protected branches",
+			inputArgs: map[string]any{"projectId": "group/project", "search": "release"},
+			mockSetup: func() {
+				mockProtectedBranches.EXPECT().
+					ListProtectedBranches("group/project", gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, opts *gl.ListProtectedBranchesOptions, _ ...gl.RequestOptionFunc) ([]*gl.ProtectedBranch, *gl.Response, error) {
+						assert.Equal(t, "release", *opts.Search)
+						return []*gl.ProtectedBranch{{ID: 1, Name: "release/*", AllowForcePush: false}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+					})
+			},
+			expectedText: `"name":"release/*"`,
+		},
+		{
+			name:      "Success - Empty list",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockProtectedBranches.EXPECT().
+					ListProtectedBranches("group/project", gomock.Any(), gomock.Any()).
+					Return([]*gl.ProtectedBranch{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: "[]",
+		},
+		{
+			name:              "Error - Missing projectId",
+			inputArgs:         map[string]any{},
+			mockSetup:         func() {},
+			expectResultError: true,
+			errorContains:     "Validation Error: missing required parameter: projectId",
+		},
+		{
+			name:      "Error - Forbidden (403)",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockProtectedBranches.EXPECT().
+					ListProtectedBranches("group/project", gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 403}}, errors.New("gitlab: 403 Forbidden"))
+			},
+			expectHandlerError: true,
+			errorContains:      "failed to list protected branches from project",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.inputArgs}})
+
+			if tc.expectHandlerError {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			textContent := getTextResult(t, result)
+			if tc.expectResultError {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.errorContains)
+				return
+			}
+			assert.Contains(t, textContent.Text, tc.expectedText)
+		})
+	}
+}
+
+func TestGetProtectedBranchHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetProtectedBranch(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockProtectedBranches, ctrl := setupMockClientForProtectedBranches(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := GetProtectedBranch(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockProtectedBranches.EXPECT().
+			GetProtectedBranch("group/project", "main", gomock.Any()).
+			Return(&gl.ProtectedBranch{ID: 1, Name: "main", PushAccessLevels: []*gl.BranchAccessDescription{{AccessLevel: gl.MaintainerPermissions}}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"name":      "main",
+		}}})
+		require.NoError(t, err)
+		text := getTextResult(t, result).Text
+		assert.Contains(t, text, `"name":"main"`)
+		assert.Contains(t, text, `"access_level":40`)
+	})
+
+	t.Run("Error - Branch not protected (404)", func(t *testing.T) {
+		mockProtectedBranches.EXPECT().
+			GetProtectedBranch("group/project", "feature", gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"name":      "feature",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "not found or access denied")
+	})
+}
+
+func TestProtectBranchHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ProtectBranch(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockProtectedBranches, ctrl := setupMockClientForProtectedBranches(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ProtectBranch(mockGetClient, nil)
+
+	t.Run("Success - No one may push", func(t *testing.T) {
+		mockProtectedBranches.EXPECT().
+			ProtectRepositoryBranches("group/project", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.ProtectRepositoryBranchesOptions, _ ...gl.RequestOptionFunc) (*gl.ProtectedBranch, *gl.Response, error) {
+				assert.Equal(t, "main", *opts.Name)
+				assert.Equal(t, gl.NoPermissions, *opts.PushAccessLevel)
+				assert.Equal(t, gl.DeveloperPermissions, *opts.MergeAccessLevel)
+				assert.False(t, *opts.AllowForcePush)
+				assert.Nil(t, opts.CodeOwnerApprovalRequired)
+				return &gl.ProtectedBranch{ID: 5, Name: "main"}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":        "group/project",
+			"name":             "main",
+			"pushAccessLevel":  0.0,
+			"mergeAccessLevel": 30.0,
+			"allowForcePush":   false,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"id":5`)
+	})
+
+	t.Run("Success - GitLab defaults when levels are omitted", func(t *testing.T) {
+		mockProtectedBranches.EXPECT().
+			ProtectRepositoryBranches("group/project", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.ProtectRepositoryBranchesOptions, _ ...gl.RequestOptionFunc) (*gl.ProtectedBranch, *gl.Response, error) {
+				assert.Nil(t, opts.PushAccessLevel)
+				assert.Nil(t, opts.MergeAccessLevel)
+				return &gl.ProtectedBranch{ID: 6, Name: "release/*"}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"name":      "release/*",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"id":6`)
+	})
+
+	t.Run("Error - Invalid access level", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"name":            "main",
+			"pushAccessLevel": 20.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: pushAccessLevel 20 is not a valid protection access level")
+	})
+
+	t.Run("Error - Already protected (409)", func(t *testing.T) {
+		mockProtectedBranches.EXPECT().
+			ProtectRepositoryBranches("group/project", gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 409}}, errors.New("gitlab: 409 Protected branch 'main' already exists"))
+
+		_, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"name":      "main",
+		}}})
+		require.Error(t, err)
+	})
+}
+
+func TestUnprotectBranchHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := UnprotectBranch(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockProtectedBranches, ctrl := setupMockClientForProtectedBranches(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := UnprotectBranch(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockProtectedBranches.EXPECT().
+			UnprotectRepositoryBranches("group/project", "main", gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 204}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"name":      "main",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "successfully unprotected")
+	})
+
+	t.Run("Error - Missing name", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: name")
+	})
+}
@@ -0,0 +1,260 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gl "gitlab.com/gitlab-org/api/client-go"
+)
+
+// parseTagPermissions reads an optional array of tag permission objects (or its JSON string form).
+// Returns nil if the parameter is absent.
+func parseTagPermissions(r *mcp.CallToolRequest, p string) (*[]*gl.TagsPermissionOptions, error) {
+	rawVal, ok := r.GetArguments()[p]
+	if !ok || rawVal == nil {
+		return nil, nil
+	}
+
+	var raw []byte
+	if s, isStr := rawVal.(string); isStr {
+		raw = []byte(s)
+	} else {
+		var err error
+		if raw, err = json.Marshal(rawVal); err != nil {
+			return nil, fmt.Errorf("parameter '%s' must be an array: %w", p, err)
+		}
+	}
+
+	var permissions []*gl.TagsPermissionOptions
+	if err := json.Unmarshal(raw, &permissions); err != nil {
+		return nil, fmt.Errorf("parameter '%s' must be an array of objects with user_id, group_id, deploy_key_id or access_level: %w", p, err)
+	}
+
+	for i, perm := range permissions {
+		if perm == nil || (perm.UserID == nil && perm.GroupID == nil && perm.DeployKeyID == nil && perm.AccessLevel == nil) {
+			return nil, fmt.Errorf("parameter '%s' entry %d must set one of user_id, group_id, deploy_key_id or access_level", p, i)
+		}
+	}
+
+	return &permissions, nil
+}
+
+// ListProtectedTags defines the MCP tool for listing the protected tags of a GitLab project.
+func ListProtectedTags(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listProtectedTags",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_PROTECTED_TAGS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Protected Tags",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.ListProtectedTagsOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+			}
+
+			// --- Call GitLab API
+			tags, resp, err := glClient.ProtectedTags.ListProtectedTags(projectID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, fmt.Sprintf("protected tags from project %q", projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(tags) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(tags)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal protected tags list: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// ProtectTag defines the MCP tool for protecting a tag or wildcard of a GitLab project.
+func ProtectTag(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"protectTag",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_PROTECT_TAG_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Protect GitLab Tag",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("name",
+				mcp.Description("The name of the tag or wildcard (e.g. v*) to protect."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithNumber("createAccessLevel",
+				mcp.Description("Access level allowed to create matching tags: "+protectionAccessLevelDescription+" GitLab default: 40."),
+			),
+			mcp.WithArray("allowedToCreate",
+				mcp.Description("Additional users, groups or deploy keys allowed to create matching tags (GitLab Premium), e.g. [{\"user_id\": 1}, {\"group_id\": 2}, {\"deploy_key_id\": 3}, {\"access_level\": 30}]."),
+				mcp.Items(map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"user_id":       map[string]any{"type": "number", "description": "ID of a user allowed to create tags."},
+						"group_id":      map[string]any{"type": "number", "description": "ID of a group whose members may create tags."},
+						"deploy_key_id": map[string]any{"type": "number", "description": "ID of a deploy key allowed to create tags."},
+						"access_level":  map[string]any{"type": "number", "description": "Access level allowed to create tags."},
+					},
+				}),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			name, err := requiredParam[string](&request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional parameters
+			createAccessLevel, err := optionalProtectionAccessLevel(&request, "createAccessLevel")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			allowedToCreate, err := parseTagPermissions(&request, "allowedToCreate")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.ProtectRepositoryTagsOptions{
+				Name:              &name,
+				CreateAccessLevel: createAccessLevel,
+				AllowedToCreate:   allowedToCreate,
+			}
+
+			// --- Call GitLab API
+			tag, resp, err := glClient.ProtectedTags.ProtectRepositoryTags(projectID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("project %q", projectID), "protect tag")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(tag)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal protected tag data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// UnprotectTag defines the MCP tool for removing the protection of a tag or wildcard.
+func UnprotectTag(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"unprotectTag",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_UNPROTECT_TAG_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Unprotect GitLab Tag",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("name",
+				mcp.Description("The name of the protected tag or wildcard to unprotect."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			name, err := requiredParam[string](&request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			resp, err := glClient.ProtectedTags.UnprotectRepositoryTags(projectID, name, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("protected tag %q in project %q", name, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf(`{"message":"Tag %q successfully unprotected in project %q"}`, name, projectID)), nil
+		}
+}
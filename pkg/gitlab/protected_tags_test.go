@@ -0,0 +1,225 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+func TestListProtectedTagsHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListProtectedTags(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockProtectedTags, ctrl := setupMockClientForProtectedTags(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListProtectedTags(mockGetClient, nil)
+
+	tests := []struct {
+		name               string
+		inputArgs          map[string]any
+		mockSetup          func()
+		expectedText       string
+		expectHandlerError bool
+		expectResultError  bool
+		errorContains      string
+	}{
+		{
+			name:      "Success - List protected tags",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockProtectedTags.EXPECT().
+					ListProtectedTags("group/project", gomock.Any(), gomock.Any()).
+					Return([]*gl.ProtectedTag{{Name: "v*", CreateAccessLevels: []*gl.TagAccessDescription{{AccessLevel: gl.MaintainerPermissions}}}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: `"name":"v*"`,
+		},
+		{
+			name:      "Success - Empty list",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockProtectedTags.EXPECT().
+					ListProtectedTags("group/project", gomock.Any(), gomock.Any()).
+					Return([]*gl.ProtectedTag{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: "[]",
+		},
+		{
+			name:              "Error - Missing projectId",
+			inputArgs:         map[string]any{},
+			mockSetup:         func() {},
+			expectResultError: true,
+			errorContains:     "Validation Error: missing required parameter: projectId",
+		},
+		{
+			name:      "Error - Forbidden (403)",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockProtectedTags.EXPECT().
+					ListProtectedTags("group/project", gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 403}}, errors.New("gitlab: 403 Forbidden"))
+			},
+			expectHandlerError: true,
+			errorContains:      "failed to list protected tags from project",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.inputArgs}})
+
+			if tc.expectHandlerError {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			textContent := getTextResult(t, result)
+			if tc.expectResultError {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.errorContains)
+				return
+			}
+			assert.Contains(t, textContent.Text, tc.expectedText)
+		})
+	}
+}
+
+func TestProtectTagHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ProtectTag(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockProtectedTags, ctrl := setupMockClientForProtectedTags(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ProtectTag(mockGetClient, nil)
+
+	t.Run("Success - Access level and allowed users", func(t *testing.T) {
+		mockProtectedTags.EXPECT().
+			ProtectRepositoryTags("group/project", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.ProtectRepositoryTagsOptions, _ ...gl.RequestOptionFunc) (*gl.ProtectedTag, *gl.Response, error) {
+				assert.Equal(t, "v*", *opts.Name)
+				assert.Equal(t, gl.MaintainerPermissions, *opts.CreateAccessLevel)
+				require.NotNil(t, opts.AllowedToCreate)
+				require.Len(t, *opts.AllowedToCreate, 2)
+				assert.Equal(t, int64(7), *(*opts.AllowedToCreate)[0].UserID)
+				assert.Equal(t, int64(9), *(*opts.AllowedToCreate)[1].GroupID)
+				return &gl.ProtectedTag{Name: "v*"}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":         "group/project",
+			"name":              "v*",
+			"createAccessLevel": 40.0,
+			"allowedToCreate":   []any{map[string]any{"user_id": 7.0}, map[string]any{"group_id": 9.0}},
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"name":"v*"`)
+	})
+
+	t.Run("Success - allowedToCreate as JSON string", func(t *testing.T) {
+		mockProtectedTags.EXPECT().
+			ProtectRepositoryTags("group/project", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.ProtectRepositoryTagsOptions, _ ...gl.RequestOptionFunc) (*gl.ProtectedTag, *gl.Response, error) {
+				assert.Nil(t, opts.CreateAccessLevel)
+				require.Len(t, *opts.AllowedToCreate, 1)
+				assert.Equal(t, int64(3), *(*opts.AllowedToCreate)[0].DeployKeyID)
+				return &gl.ProtectedTag{Name: "release-*"}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"name":            "release-*",
+			"allowedToCreate": `[{"deploy_key_id": 3}]`,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"name":"release-*"`)
+	})
+
+	t.Run("Error - Empty permission entry", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":       "group/project",
+			"name":            "v*",
+			"allowedToCreate": []any{map[string]any{}},
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: parameter 'allowedToCreate' entry 0 must set one of")
+	})
+
+	t.Run("Error - Invalid access level", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":         "group/project",
+			"name":              "v*",
+			"createAccessLevel": 10.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: createAccessLevel 10 is not a valid protection access level")
+	})
+}
+
+func TestUnprotectTagHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := UnprotectTag(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockProtectedTags, ctrl := setupMockClientForProtectedTags(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := UnprotectTag(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockProtectedTags.EXPECT().
+			UnprotectRepositoryTags("group/project", "v*", gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 204}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"name":      "v*",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "successfully unprotected")
+	})
+
+	t.Run("Error - Tag not protected (404)", func(t *testing.T) {
+		mockProtectedTags.EXPECT().
+			UnprotectRepositoryTags("group/project", "nope", gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"name":      "nope",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
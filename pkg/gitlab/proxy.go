@@ -0,0 +1,129 @@
+package gitlab
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ProxyConfig holds the proxies used to reach GitLab. It mirrors the HTTP_PROXY,
+// HTTPS_PROXY and NO_PROXY conventions, but is configured explicitly so that the
+// server's proxy does not have to be set for every process in the environment.
+type ProxyConfig struct {
+	HTTPProxy  string // proxy for http:// GitLab hosts
+	HTTPSProxy string // proxy for https:// GitLab hosts
+	NoProxy    string // comma-separated hosts, domains, IPs or CIDRs to reach directly
+}
+
+// IsZero reports whether no proxy is configured.
+func (c ProxyConfig) IsZero() bool {
+	return c.HTTPProxy == "" && c.HTTPSProxy == ""
+}
+
+// String describes the configuration for logging. Credentials in the proxy URLs
+// are masked.
+func (c ProxyConfig) String() string {
+	return fmt.Sprintf("HTTP: %q, HTTPS: %q, bypass: %q", redactProxyURL(c.HTTPProxy), redactProxyURL(c.HTTPSProxy), c.NoProxy)
+}
+
+// redactProxyURL returns raw with the password of its user info masked. A URL that
+// cannot be parsed is not echoed, since it may still contain credentials.
+func redactProxyURL(raw string) string {
+	if raw == "" {
+		return ""
+	}
+	if !strings.Contains(raw, "://") {
+		raw = "http://" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "<unparsable>"
+	}
+	if _, ok := u.User.Password(); !ok {
+		return raw
+	}
+	return u.Redacted()
+}
+
+// proxyFunc returns an http.Transport Proxy function for the configuration. Errors are
+// returned for proxy URLs that cannot be parsed so that misconfiguration fails at startup.
+func (c ProxyConfig) proxyFunc() (func(*http.Request) (*url.URL, error), error) {
+	httpProxy, err := parseProxyURL("HTTP", c.HTTPProxy)
+	if err != nil {
+		return nil, err
+	}
+	httpsProxy, err := parseProxyURL("HTTPS", c.HTTPSProxy)
+	if err != nil {
+		return nil, err
+	}
+	noProxy := ParseCommaSeparatedList(c.NoProxy)
+
+	return func(req *http.Request) (*url.URL, error) {
+		proxy := httpProxy
+		if req.URL.Scheme == "https" {
+			proxy = httpsProxy
+		}
+		if proxy == nil || bypassProxy(req.URL, noProxy) {
+			return nil, nil
+		}
+		return proxy, nil
+	}, nil
+}
+
+// parseProxyURL parses a proxy URL, defaulting to the http scheme when none is given.
+func parseProxyURL(kind, raw string) (*url.URL, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	if !strings.Contains(raw, "://") {
+		raw = "http://" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("invalid %s proxy URL %q", kind, redactProxyURL(raw))
+	}
+	return u, nil
+}
+
+// bypassProxy reports whether target matches one of the NO_PROXY patterns. A pattern is
+// "*" (everything), an IP or CIDR, or a host name that also matches its subdomains; a
+// leading dot is ignored. A pattern with a port only matches that port.
+func bypassProxy(target *url.URL, patterns []string) bool {
+	host := strings.ToLower(target.Hostname())
+	port := target.Port()
+	if port == "" {
+		port = "80"
+		if target.Scheme == "https" {
+			port = "443"
+		}
+	}
+	ip := net.ParseIP(host)
+
+	for _, pattern := range patterns {
+		pattern = strings.ToLower(pattern)
+		if pattern == "*" {
+			return true
+		}
+		if _, cidr, err := net.ParseCIDR(pattern); err == nil {
+			if ip != nil && cidr.Contains(ip) {
+				return true
+			}
+			continue
+		}
+
+		patternHost, patternPort := pattern, ""
+		if h, p, err := net.SplitHostPort(pattern); err == nil {
+			patternHost, patternPort = h, p
+		}
+		if patternPort != "" && patternPort != port {
+			continue
+		}
+		patternHost = strings.TrimPrefix(patternHost, ".")
+		if host == patternHost || strings.HasSuffix(host, "."+patternHost) {
+			return true
+		}
+	}
+	return false
+}
@@ -3,6 +3,8 @@ package gitlab
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"path/filepath"
 	"sync"
 	"testing"
@@ -10,6 +12,7 @@ import (
 
 	"github.com/InkyQuill/gitlab-mcp-server/pkg/config"
 	"github.com/InkyQuill/gitlab-mcp-server/pkg/toolsets"
+	"github.com/mark3labs/mcp-go/mcp"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -259,6 +262,36 @@ func TestRaceCondition_ClientPool_AddRemoveRace(t *testing.T) {
 	assert.GreaterOrEqual(t, len(names), 0, "Should have valid state")
 }
 
+// TestRaceCondition_ClientPool_ReplaceRemoveRace checks that a token refresh running
+// concurrently with RemoveClient never brings the removed client back
+func TestRaceCondition_ClientPool_ReplaceRemoveRace(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	cp := NewClientPool(NewTokenStore(), logger)
+
+	for i := 0; i < 50; i++ {
+		name := fmt.Sprintf("client-%d", i)
+		require.NoError(t, cp.AddClient(name, &gl.Client{}))
+
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			cp.replaceClient(name, TokenMetadata{Token: "refreshed"})
+		}()
+		go func() {
+			defer wg.Done()
+			_ = cp.RemoveClient(name)
+		}()
+		wg.Wait()
+
+		// Whichever ran first, the client ends up removed
+		_, err := cp.GetClient(name)
+		assert.Error(t, err, "client %s should stay removed", name)
+	}
+}
+
 // TestRaceCondition_ClientResolver_ConcurrentResolve tests for race
 // conditions when resolving clients concurrently
 func TestRaceCondition_ClientResolver_ConcurrentResolve(t *testing.T) {
@@ -292,3 +325,61 @@ func TestRaceCondition_ClientResolver_ConcurrentResolve(t *testing.T) {
 
 	// Should not panic
 }
+
+// TestRaceCondition_TokenStore_RefreshWhileReading tests for race conditions
+// between updateToken replacing a token and getTokenInfo reading it
+func TestRaceCondition_TokenStore_RefreshWhileReading(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v4/user":
+			_, _ = w.Write([]byte(`{"id":7,"username":"rotated"}`))
+		case "/api/v4/personal_access_tokens/self":
+			_, _ = w.Write([]byte(`{"id":1,"expires_at":"2030-01-01"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	store := NewTokenStore()
+	require.NoError(t, store.AddToken("default", &TokenMetadata{Token: "token-0", GitLabHost: srv.URL}))
+
+	factory := func(token, host string) (*gl.Client, error) {
+		return gl.NewClient(token, gl.WithBaseURL(host))
+	}
+	_, updateHandler := UpdateToken(factory, logger, store)
+	_, infoHandler := GetTokenInfo(store, nil)
+
+	var wg sync.WaitGroup
+	iterations := 20
+
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < iterations; i++ {
+			req := mcp.CallToolRequest{}
+			req.Params.Arguments = map[string]any{"token": fmt.Sprintf("token-%d", i+1)}
+			result, err := updateHandler(context.Background(), req)
+			assert.NoError(t, err)
+			assert.False(t, result.IsError)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < iterations*5; i++ {
+			result, err := infoHandler(context.Background(), mcp.CallToolRequest{})
+			assert.NoError(t, err)
+			assert.False(t, result.IsError)
+		}
+	}()
+
+	wg.Wait()
+
+	stored, err := store.GetDefaultToken()
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("token-%d", iterations), stored.Token)
+}
@@ -0,0 +1,701 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gl "gitlab.com/gitlab-org/api/client-go"
+)
+
+// ListReleases defines the MCP tool for listing releases in a GitLab project.
+func ListReleases(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listReleases",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_RELEASES_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Releases",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		// Handler function implementation
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.ListReleasesOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+			}
+
+			// --- Call GitLab API
+			releases, resp, err := glClient.Releases.ListReleases(projectID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, fmt.Sprintf("releases from project %q", projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(releases) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Truncate long text fields for list operations
+			truncator := NewTextTruncator(MaxFieldLength)
+			truncatedReleases, err := truncator.TruncateListResponse(releases, ReleaseFields)
+			if err != nil {
+				return nil, fmt.Errorf("failed to truncate releases: %w", err)
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(truncatedReleases)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal releases list: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// GetRelease defines the MCP tool for retrieving a single release by its tag name.
+func GetRelease(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"getRelease",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_RELEASE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "Get GitLab Release",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("tagName",
+				mcp.Description("The Git tag the release is associated with."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			tagName, err := requiredParam[string](&request, "tagName")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			release, resp, err := glClient.Releases.GetRelease(projectID, tagName, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("release %q in project %q", tagName, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(release)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal release data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// CreateRelease defines the MCP tool for creating a release in a GitLab project.
+func CreateRelease(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"createRelease",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_CREATE_RELEASE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Create GitLab Release",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("tagName",
+				mcp.Description("The tag the release is created from. If the tag does not exist, 'ref' is required."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithString("name",
+				mcp.Description("The release name."),
+			),
+			mcp.WithString("description",
+				mcp.Description("The description of the release (Markdown supported)."),
+			),
+			mcp.WithString("ref",
+				mcp.Description("The branch name or commit SHA to create the tag from, when the tag does not exist yet."),
+			),
+			mcp.WithString("milestones",
+				mcp.Description("Comma-separated list of milestone titles to associate with the release."),
+			),
+			mcp.WithString("releasedAt",
+				mcp.Description("The date the release is/was ready (ISO 8601 format, e.g. 2026-01-02T15:04:05Z). Defaults to now."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			tagName, err := requiredParam[string](&request, "tagName")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional parameters
+			name, err := OptionalParam[string](&request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			description, err := OptionalParam[string](&request, "description")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			ref, err := OptionalParam[string](&request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			milestones, err := OptionalParam[string](&request, "milestones")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			releasedAt, err := OptionalTimeParam(&request, "releasedAt")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.CreateReleaseOptions{
+				TagName:    &tagName,
+				ReleasedAt: releasedAt,
+			}
+
+			if name != "" {
+				opts.Name = &name
+			}
+
+			if description != "" {
+				opts.Description = &description
+			}
+
+			if ref != "" {
+				opts.Ref = &ref
+			}
+
+			if milestoneTitles := ParseCommaSeparatedList(milestones); milestoneTitles != nil {
+				opts.Milestones = &milestoneTitles
+			}
+
+			// --- Call GitLab API
+			release, resp, err := glClient.Releases.CreateRelease(projectID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("project %q", projectID), "create release")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(release)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal release data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// UpdateRelease defines the MCP tool for updating an existing release.
+func UpdateRelease(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"updateRelease",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_UPDATE_RELEASE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Update GitLab Release",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("tagName",
+				mcp.Description("The Git tag the release is associated with."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithString("name",
+				mcp.Description("The new release name."),
+			),
+			mcp.WithString("description",
+				mcp.Description("The new description of the release (Markdown supported)."),
+			),
+			mcp.WithString("milestones",
+				mcp.Description("Comma-separated list of milestone titles to associate with the release."),
+			),
+			mcp.WithString("releasedAt",
+				mcp.Description("The date the release is/was ready (ISO 8601 format, e.g. 2026-01-02T15:04:05Z)."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			tagName, err := requiredParam[string](&request, "tagName")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional parameters
+			name, err := OptionalParam[string](&request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			description, err := OptionalParam[string](&request, "description")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			milestones, err := OptionalParam[string](&request, "milestones")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			releasedAt, err := OptionalTimeParam(&request, "releasedAt")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			// UpdateReleaseOptions always serializes name and description, so
+			// unset values would be sent as null and clear the existing ones.
+			// Fetch the current release to preserve fields the caller omitted.
+			opts := &gl.UpdateReleaseOptions{
+				ReleasedAt: releasedAt,
+			}
+
+			if name == "" || description == "" {
+				current, resp, err := glClient.Releases.GetRelease(projectID, tagName, gl.WithContext(ctx))
+				if err != nil {
+					result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("release %q in project %q", tagName, projectID))
+					if result != nil {
+						return result, nil
+					}
+					return nil, apiErr
+				}
+				if name == "" {
+					name = current.Name
+				}
+				if description == "" {
+					description = current.Description
+				}
+			}
+			opts.Name = &name
+			opts.Description = &description
+
+			if milestoneTitles := ParseCommaSeparatedList(milestones); milestoneTitles != nil {
+				opts.Milestones = &milestoneTitles
+			}
+
+			// --- Call GitLab API
+			release, resp, err := glClient.Releases.UpdateRelease(projectID, tagName, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("release %q in project %q", tagName, projectID), "update release")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(release)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal release data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// DeleteRelease defines the MCP tool for deleting a release. The associated Git tag is kept.
+func DeleteRelease(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"deleteRelease",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_DELETE_RELEASE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Delete GitLab Release",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("tagName",
+				mcp.Description("The Git tag the release is associated with."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			tagName, err := requiredParam[string](&request, "tagName")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			_, resp, err := glClient.Releases.DeleteRelease(projectID, tagName, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("release %q in project %q", tagName, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf(`{"message":"Release %q successfully deleted from project %q"}`, tagName, projectID)), nil
+		}
+}
+
+// ListReleaseLinks defines the MCP tool for listing the asset links of a release.
+func ListReleaseLinks(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listReleaseLinks",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_RELEASE_LINKS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Release Links",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("tagName",
+				mcp.Description("The Git tag the release is associated with."),
+				mcp.Required(),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			tagName, err := requiredParam[string](&request, "tagName")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			opts := &gl.ListReleaseLinksOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+			}
+			links, resp, err := glClient.ReleaseLinks.ListReleaseLinks(projectID, tagName, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, fmt.Sprintf("links of release %q in project %q", tagName, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(links) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(links)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal release links list: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// CreateReleaseLink defines the MCP tool for adding an asset link to a release.
+func CreateReleaseLink(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"createReleaseLink",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_CREATE_RELEASE_LINK_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Create GitLab Release Link",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("tagName",
+				mcp.Description("The Git tag the release is associated with."),
+				mcp.Required(),
+			),
+			mcp.WithString("name",
+				mcp.Description("The name of the link. Must be unique within the release."),
+				mcp.Required(),
+			),
+			mcp.WithString("url",
+				mcp.Description("The URL of the link. Must be unique within the release."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithString("linkType",
+				mcp.Description("The type of the link (defaults to other)."),
+				mcp.Enum("other", "runbook", "image", "package"),
+			),
+			mcp.WithString("filePath",
+				mcp.Description("Optional path for a direct asset link (e.g. /binaries/linux-amd64)."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			tagName, err := requiredParam[string](&request, "tagName")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			name, err := requiredParam[string](&request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			url, err := requiredParam[string](&request, "url")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional parameters
+			linkType, err := OptionalParam[string](&request, "linkType")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			filePath, err := OptionalParam[string](&request, "filePath")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.CreateReleaseLinkOptions{
+				Name: &name,
+				URL:  &url,
+			}
+
+			if linkType != "" {
+				opts.LinkType = gl.Ptr(gl.LinkTypeValue(linkType))
+			}
+
+			if filePath != "" {
+				opts.FilePath = &filePath
+			}
+
+			// --- Call GitLab API
+			link, resp, err := glClient.ReleaseLinks.CreateReleaseLink(projectID, tagName, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("release %q in project %q", tagName, projectID), "create release link")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(link)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal release link data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// DeleteReleaseLink defines the MCP tool for removing an asset link from a release.
+func DeleteReleaseLink(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"deleteReleaseLink",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_DELETE_RELEASE_LINK_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Delete GitLab Release Link",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("tagName",
+				mcp.Description("The Git tag the release is associated with."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("linkId",
+				mcp.Description("The ID of the link to delete."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			tagName, err := requiredParam[string](&request, "tagName")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			linkIDFloat, err := requiredParam[float64](&request, "linkId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			linkID := int64(linkIDFloat)
+			if float64(linkID) != linkIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: linkId %v is not a valid integer", linkIDFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			_, resp, err := glClient.ReleaseLinks.DeleteReleaseLink(projectID, tagName, linkID, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("link %d of release %q in project %q", linkID, tagName, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf(`{"message":"Link %d successfully deleted from release %q"}`, linkID, tagName)), nil
+		}
+}
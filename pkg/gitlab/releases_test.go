@@ -0,0 +1,486 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+func TestListReleasesHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListReleases(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockReleases, ctrl := setupMockClientForReleases(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListReleases(mockGetClient, nil)
+
+	projectID := "group/project"
+
+	tests := []struct {
+		name               string
+		inputArgs          map[string]any
+		mockSetup          func()
+		expectedText       string
+		expectHandlerError bool
+		expectResultError  bool
+		errorContains      string
+	}{
+		{
+			name:      "Success - List releases with pagination",
+			inputArgs: map[string]any{"projectId": projectID, "page": 2.0, "per_page": 5.0},
+			mockSetup: func() {
+				mockReleases.EXPECT().
+					ListReleases(projectID, gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, opts *gl.ListReleasesOptions, _ ...gl.RequestOptionFunc) ([]*gl.Release, *gl.Response, error) {
+						assert.Equal(t, int64(2), opts.Page)
+						assert.Equal(t, int64(5), opts.PerPage)
+						return []*gl.Release{{TagName: "v1.0.0", Name: "Release 1.0.0"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+					})
+			},
+			expectedText: `"tag_name":"v1.0.0"`,
+		},
+		{
+			name:      "Success - Empty list",
+			inputArgs: map[string]any{"projectId": projectID},
+			mockSetup: func() {
+				mockReleases.EXPECT().
+					ListReleases(projectID, gomock.Any(), gomock.Any()).
+					Return([]*gl.Release{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: "[]",
+		},
+		{
+			name:              "Error - Missing projectId",
+			inputArgs:         map[string]any{},
+			mockSetup:         func() {},
+			expectResultError: true,
+			errorContains:     "Validation Error: missing required parameter: projectId",
+		},
+		{
+			name:      "Error - Project Not Found (404)",
+			inputArgs: map[string]any{"projectId": projectID},
+			mockSetup: func() {
+				mockReleases.EXPECT().
+					ListReleases(projectID, gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Project Not Found"))
+			},
+			expectHandlerError: true,
+			errorContains:      "failed to list releases from project",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.inputArgs}})
+
+			if tc.expectHandlerError {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			textContent := getTextResult(t, result)
+			if tc.expectResultError {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.errorContains)
+				return
+			}
+			assert.Contains(t, textContent.Text, tc.expectedText)
+		})
+	}
+}
+
+func TestGetReleaseHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetRelease(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockReleases, ctrl := setupMockClientForReleases(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := GetRelease(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockReleases.EXPECT().
+			GetRelease("group/project", "v1.0.0", gomock.Any()).
+			Return(&gl.Release{TagName: "v1.0.0", Name: "Release 1.0.0"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"tagName":   "v1.0.0",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"name":"Release 1.0.0"`)
+	})
+
+	t.Run("Error - Release Not Found (404)", func(t *testing.T) {
+		mockReleases.EXPECT().
+			GetRelease("group/project", "v9.9.9", gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"tagName":   "v9.9.9",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, `release "v9.9.9" in project "group/project" not found or access denied (404)`)
+	})
+}
+
+func TestCreateReleaseHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := CreateRelease(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockReleases, ctrl := setupMockClientForReleases(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := CreateRelease(mockGetClient, nil)
+
+	t.Run("Success - All options", func(t *testing.T) {
+		mockReleases.EXPECT().
+			CreateRelease("group/project", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.CreateReleaseOptions, _ ...gl.RequestOptionFunc) (*gl.Release, *gl.Response, error) {
+				assert.Equal(t, "v1.0.0", *opts.TagName)
+				assert.Equal(t, "Release 1.0.0", *opts.Name)
+				assert.Equal(t, "main", *opts.Ref)
+				assert.Equal(t, []string{"Sprint 1", "Sprint 2"}, *opts.Milestones)
+				assert.Equal(t, time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC), opts.ReleasedAt.UTC())
+				assert.Nil(t, opts.Description)
+				return &gl.Release{TagName: "v1.0.0"}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":  "group/project",
+			"tagName":    "v1.0.0",
+			"name":       "Release 1.0.0",
+			"ref":        "main",
+			"milestones": "Sprint 1, Sprint 2",
+			"releasedAt": "2026-01-02T15:04:05Z",
+		}}})
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	t.Run("Error - Invalid releasedAt", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":  "group/project",
+			"tagName":    "v1.0.0",
+			"releasedAt": "yesterday",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: parameter 'releasedAt' must be a valid ISO 8601 timestamp")
+	})
+
+	t.Run("Error - Tag conflict (409)", func(t *testing.T) {
+		mockReleases.EXPECT().
+			CreateRelease("group/project", gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 409}}, errors.New("gitlab: 409 Release already exists"))
+
+		_, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"tagName":   "v1.0.0",
+		}}})
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "failed to create release")
+	})
+
+	t.Run("Error - Client Initialization Error", func(t *testing.T) {
+		_, handler := CreateRelease(func(_ context.Context) (*gl.Client, error) {
+			return nil, fmt.Errorf("mock init error")
+		}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"tagName":   "v1.0.0",
+		}}})
+		assert.ErrorContains(t, err, "failed to initialize GitLab client")
+		assert.Nil(t, result)
+	})
+}
+
+func TestUpdateReleaseHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := UpdateRelease(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockReleases, ctrl := setupMockClientForReleases(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := UpdateRelease(mockGetClient, nil)
+
+	t.Run("Success - Preserves omitted description", func(t *testing.T) {
+		mockReleases.EXPECT().
+			GetRelease("group/project", "v1.0.0", gomock.Any()).
+			Return(&gl.Release{TagName: "v1.0.0", Name: "Old", Description: "Existing notes"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+		mockReleases.EXPECT().
+			UpdateRelease("group/project", "v1.0.0", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ string, opts *gl.UpdateReleaseOptions, _ ...gl.RequestOptionFunc) (*gl.Release, *gl.Response, error) {
+				assert.Equal(t, "New", *opts.Name)
+				assert.Equal(t, "Existing notes", *opts.Description)
+				return &gl.Release{TagName: "v1.0.0", Name: "New"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"tagName":   "v1.0.0",
+			"name":      "New",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"name":"New"`)
+	})
+
+	t.Run("Success - Name and description given skips lookup", func(t *testing.T) {
+		mockReleases.EXPECT().
+			UpdateRelease("group/project", "v1.0.0", gomock.Any(), gomock.Any()).
+			Return(&gl.Release{TagName: "v1.0.0"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":   "group/project",
+			"tagName":     "v1.0.0",
+			"name":        "New",
+			"description": "Notes",
+		}}})
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	t.Run("Error - Release Not Found (404)", func(t *testing.T) {
+		mockReleases.EXPECT().
+			GetRelease("group/project", "v9.9.9", gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"tagName":   "v9.9.9",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "not found or access denied (404)")
+	})
+}
+
+func TestDeleteReleaseHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := DeleteRelease(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockReleases, ctrl := setupMockClientForReleases(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := DeleteRelease(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockReleases.EXPECT().
+			DeleteRelease("group/project", "v1.0.0", gomock.Any()).
+			Return(&gl.Release{TagName: "v1.0.0"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"tagName":   "v1.0.0",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `Release "v1.0.0" successfully deleted from project "group/project"`)
+	})
+
+	t.Run("Error - Missing tagName", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: tagName")
+	})
+}
+
+func TestListReleaseLinksHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListReleaseLinks(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockReleaseLinks, ctrl := setupMockClientForReleaseLinks(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListReleaseLinks(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockReleaseLinks.EXPECT().
+			ListReleaseLinks("group/project", "v1.0.0", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ string, opts *gl.ListReleaseLinksOptions, _ ...gl.RequestOptionFunc) ([]*gl.ReleaseLink, *gl.Response, error) {
+				assert.Equal(t, int64(DefaultPerPage), opts.PerPage)
+				return []*gl.ReleaseLink{{ID: 1, Name: "linux-amd64", LinkType: gl.PackageLinkType}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"tagName":   "v1.0.0",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"name":"linux-amd64"`)
+	})
+
+	t.Run("Success - Empty list", func(t *testing.T) {
+		mockReleaseLinks.EXPECT().
+			ListReleaseLinks("group/project", "v1.0.0", gomock.Any(), gomock.Any()).
+			Return([]*gl.ReleaseLink{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"tagName":   "v1.0.0",
+		}}})
+		require.NoError(t, err)
+		assert.Equal(t, "[]", getTextResult(t, result).Text)
+	})
+}
+
+func TestCreateReleaseLinkHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := CreateReleaseLink(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockReleaseLinks, ctrl := setupMockClientForReleaseLinks(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := CreateReleaseLink(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockReleaseLinks.EXPECT().
+			CreateReleaseLink("group/project", "v1.0.0", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ string, opts *gl.CreateReleaseLinkOptions, _ ...gl.RequestOptionFunc) (*gl.ReleaseLink, *gl.Response, error) {
+				assert.Equal(t, "linux-amd64", *opts.Name)
+				assert.Equal(t, "https://example.com/bin", *opts.URL)
+				assert.Equal(t, gl.PackageLinkType, *opts.LinkType)
+				assert.Equal(t, "/binaries/linux-amd64", *opts.FilePath)
+				return &gl.ReleaseLink{ID: 2, Name: "linux-amd64"}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"tagName":   "v1.0.0",
+			"name":      "linux-amd64",
+			"url":       "https://example.com/bin",
+			"linkType":  "package",
+			"filePath":  "/binaries/linux-amd64",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"id":2`)
+	})
+
+	t.Run("Error - Missing url", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"tagName":   "v1.0.0",
+			"name":      "linux-amd64",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: url")
+	})
+}
+
+func TestDeleteReleaseLinkHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := DeleteReleaseLink(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockReleaseLinks, ctrl := setupMockClientForReleaseLinks(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := DeleteReleaseLink(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockReleaseLinks.EXPECT().
+			DeleteReleaseLink("group/project", "v1.0.0", int64(2), gomock.Any()).
+			Return(&gl.ReleaseLink{ID: 2}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"tagName":   "v1.0.0",
+			"linkId":    2.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `Link 2 successfully deleted from release "v1.0.0"`)
+	})
+
+	t.Run("Error - Non-integer linkId", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"tagName":   "v1.0.0",
+			"linkId":    2.5,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: linkId 2.5 is not a valid integer")
+	})
+
+	t.Run("Error - Link Not Found (404)", func(t *testing.T) {
+		mockReleaseLinks.EXPECT().
+			DeleteReleaseLink("group/project", "v1.0.0", int64(99), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"tagName":   "v1.0.0",
+			"linkId":    99.0,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "not found or access denied (404)")
+	})
+}
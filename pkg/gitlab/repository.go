@@ -0,0 +1,616 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gl "gitlab.com/gitlab-org/api/client-go"
+)
+
+// GetContributors defines the MCP tool for listing the contributors of a GitLab project's repository.
+func GetContributors(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"getContributors",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_CONTRIBUTORS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "Get Repository Contributors",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			// Optional filtering parameters
+			mcp.WithString("orderBy",
+				mcp.Description("Return contributors ordered by field (GitLab default: commits)."),
+				mcp.Enum("email", "name", "commits"),
+			),
+			mcp.WithString("sort",
+				mcp.Description("Return contributors sorted in asc or desc order (GitLab default: asc)."),
+				mcp.Enum("asc", "desc"),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional filtering parameters
+			orderBy, err := OptionalParam[string](&request, "orderBy")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			sort, err := OptionalParam[string](&request, "sort")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.ListContributorsOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+			}
+
+			if orderBy != "" {
+				opts.OrderBy = &orderBy
+			}
+
+			if sort != "" {
+				opts.Sort = &sort
+			}
+
+			// --- Call GitLab API
+			contributors, resp, err := glClient.Repositories.Contributors(projectID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, fmt.Sprintf("contributors from project %q", projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(contributors) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(contributors)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal contributors list: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// compareResult is the response of the compareRepositoryRefs tool
+type compareResult struct {
+	Commit         *gl.Commit             `json:"commit"`
+	Commits        []*gl.Commit           `json:"commits"`
+	Diffs          []mergeRequestFileDiff `json:"diffs"`
+	CompareTimeout bool                   `json:"compare_timeout"`
+	CompareSameRef bool                   `json:"compare_same_ref"`
+	WebURL         string                 `json:"web_url"`
+	Truncated      bool                   `json:"truncated"`
+	MaxDiffBytes   int                    `json:"max_diff_bytes"`
+}
+
+// withQueryParam adds a query parameter that the client library's options struct does not expose.
+func withQueryParam(name, value string) gl.RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		q := req.URL.Query()
+		q.Set(name, value)
+		req.URL.RawQuery = q.Encode()
+		return nil
+	}
+}
+
+// CompareRepositoryRefs defines the MCP tool for comparing two branches, tags or commits of a GitLab project.
+func CompareRepositoryRefs(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"compareRepositoryRefs",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_COMPARE_REPOSITORY_REFS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "Compare Repository Refs",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("from",
+				mcp.Description("The branch name, tag or commit SHA to compare from."),
+				mcp.Required(),
+			),
+			mcp.WithString("to",
+				mcp.Description("The branch name, tag or commit SHA to compare to."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithNumber("fromProjectId",
+				mcp.Description("The ID of the project to compare from, for comparisons across forks. Defaults to projectId."),
+			),
+			mcp.WithBoolean("straight",
+				mcp.Description("Compare the refs directly (from..to) instead of from their merge base (from...to). GitLab default: false."),
+			),
+			mcp.WithNumber("maxDiffBytes",
+				mcp.Description(fmt.Sprintf("Maximum combined size of all file diffs in bytes (default %d, max %d). Diffs beyond the limit are truncated.", DefaultMaxDiffBytes, MaxMaxDiffBytes)),
+				mcp.Min(1),
+				mcp.Max(MaxMaxDiffBytes),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			from, err := requiredParam[string](&request, "from")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			to, err := requiredParam[string](&request, "to")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional parameters
+			fromProjectIDFloat, err := OptionalParam[float64](&request, "fromProjectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			fromProjectID := int64(fromProjectIDFloat)
+			if float64(fromProjectID) != fromProjectIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: fromProjectId %v is not a valid integer", fromProjectIDFloat)), nil
+			}
+
+			straight, err := OptionalBoolParam(&request, "straight")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			maxDiffBytes, err := optionalMaxDiffBytes(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.CompareOptions{
+				From:     &from,
+				To:       &to,
+				Straight: straight,
+			}
+
+			requestOpts := []gl.RequestOptionFunc{gl.WithContext(ctx)}
+			if fromProjectID != 0 {
+				requestOpts = append(requestOpts, withQueryParam("from_project_id", strconv.FormatInt(fromProjectID, 10)))
+			}
+
+			// --- Call GitLab API
+			compare, resp, err := glClient.Repositories.Compare(projectID, opts, requestOpts...)
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("refs %q and %q in project %q", from, to, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			diffs, truncated := truncateDiffs(compare.Diffs, maxDiffBytes)
+
+			// --- Marshal and return success
+			data, err := json.Marshal(compareResult{
+				Commit:         compare.Commit,
+				Commits:        compare.Commits,
+				Diffs:          diffs,
+				CompareTimeout: compare.CompareTimeout,
+				CompareSameRef: compare.CompareSameRef,
+				WebURL:         compare.WebURL,
+				Truncated:      truncated,
+				MaxDiffBytes:   maxDiffBytes,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal repository comparison: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// archiveFormats lists the archive formats the repository archive endpoint accepts.
+var archiveFormats = []string{"tar.gz", "tar.bz2", "tbz", "tbz2", "tb2", "bz2", "tar", "zip"}
+
+// repositoryArchiveLink is the response of the getRepositoryArchiveLink tool
+type repositoryArchiveLink struct {
+	URL    string `json:"url"`
+	Ref    string `json:"ref"`
+	SHA    string `json:"sha"`
+	Format string `json:"format"`
+}
+
+// GetRepositoryArchiveLink defines the MCP tool for building the download URL of a repository archive.
+// GitLab serves archives from the archive endpoint itself instead of redirecting to a storage
+// URL, so there is no redirect to capture with Repositories.Archive. The URL is built from the
+// client's base URL and pinned to the resolved commit; the archive is not requested, so the
+// link is not checked for the format.
+func GetRepositoryArchiveLink(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"getRepositoryArchiveLink",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_REPOSITORY_ARCHIVE_LINK_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "Get Repository Archive Link",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithString("sha",
+				mcp.Description("The commit SHA to archive. Cannot be combined with ref."),
+			),
+			mcp.WithString("ref",
+				mcp.Description("The branch or tag to archive. Defaults to the project's default branch."),
+			),
+			mcp.WithString("format",
+				mcp.Description("The archive format (default: tar.gz)."),
+				mcp.Enum(archiveFormats...),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional parameters
+			sha, err := OptionalParam[string](&request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			ref, err := OptionalParam[string](&request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			if sha != "" && ref != "" {
+				return mcp.NewToolResultError("Validation Error: sha and ref cannot be used together"), nil
+			}
+
+			format, err := OptionalParam[string](&request, "format")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			if format == "" {
+				format = "tar.gz"
+			}
+			if !slices.Contains(archiveFormats, format) {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: format must be one of %s, got %q", strings.Join(archiveFormats, ", "), format)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			// The archive endpoint streams the archive itself, so instead of downloading it the ref
+			// is resolved to a commit and the link is pinned to that commit's SHA.
+			if sha == "" && ref == "" {
+				project, resp, err := glClient.Projects.GetProject(projectID, nil, gl.WithContext(ctx))
+				if err != nil {
+					result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("project %q", projectID))
+					if result != nil {
+						return result, nil
+					}
+					return nil, apiErr
+				}
+				if project.DefaultBranch == "" {
+					return mcp.NewToolResultError(fmt.Sprintf("project %q has no default branch; its repository may be empty", projectID)), nil
+				}
+				ref = project.DefaultBranch
+			}
+
+			commitRef := sha
+			if commitRef == "" {
+				commitRef = ref
+			}
+			commit, resp, err := glClient.Commits.GetCommit(projectID, commitRef, nil, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("ref %q in project %q", commitRef, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			archivePath, err := url.Parse(fmt.Sprintf("projects/%s/repository/archive.%s", gl.PathEscape(projectID), format))
+			if err != nil {
+				return nil, fmt.Errorf("failed to build archive URL: %w", err)
+			}
+			archiveURL := glClient.BaseURL().ResolveReference(archivePath)
+			archiveURL.RawQuery = url.Values{"sha": {commit.ID}}.Encode()
+
+			// --- Marshal and return success
+			data, err := json.Marshal(repositoryArchiveLink{
+				URL:    archiveURL.String(),
+				Ref:    ref,
+				SHA:    commit.ID,
+				Format: format,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal repository archive link: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// GetCommitStatuses defines the MCP tool for listing the build statuses reported on a commit.
+func GetCommitStatuses(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"getCommitStatuses",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_COMMIT_STATUSES_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "Get Commit Statuses",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("sha",
+				mcp.Description("The commit SHA."),
+				mcp.Required(),
+			),
+			// Optional filtering parameters
+			mcp.WithString("name",
+				mcp.Description("Return only statuses with this name (the job or external check name)."),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			sha, err := requiredParam[string](&request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional filtering parameters
+			name, err := OptionalParam[string](&request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.GetCommitStatusesOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+			}
+
+			if name != "" {
+				opts.Name = &name
+			}
+
+			// --- Call GitLab API
+			statuses, resp, err := glClient.Commits.GetCommitStatuses(projectID, sha, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("commit %q in project %q", sha, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(statuses) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(statuses)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal commit statuses: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// SetCommitStatus defines the MCP tool for reporting a build status on a commit, as external CI systems do.
+func SetCommitStatus(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"setCommitStatus",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_SET_COMMIT_STATUS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Set Commit Status",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("sha",
+				mcp.Description("The commit SHA."),
+				mcp.Required(),
+			),
+			mcp.WithString("state",
+				mcp.Description("The state of the status."),
+				mcp.Required(),
+				mcp.Enum("pending", "running", "success", "failed", "canceled"),
+			),
+			// Optional parameters
+			mcp.WithString("name",
+				mcp.Description("The label that differentiates this status from other systems (GitLab default: 'default')."),
+			),
+			mcp.WithString("targetUrl",
+				mcp.Description("The URL to associate with this status, e.g. the external build log."),
+			),
+			mcp.WithString("description",
+				mcp.Description("A short description of the status."),
+			),
+			mcp.WithNumber("coverage",
+				mcp.Description("The total code coverage, as a percentage between 0 and 100."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			sha, err := requiredParam[string](&request, "sha")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			state, err := requiredParam[string](&request, "state")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional parameters
+			name, err := OptionalParam[string](&request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			targetURL, err := OptionalParam[string](&request, "targetUrl")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			description, err := OptionalParam[string](&request, "description")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			coverage, err := OptionalParam[float64](&request, "coverage")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			if coverage < 0 || coverage > 100 {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: coverage must be between 0 and 100, got %v", coverage)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.SetCommitStatusOptions{
+				State: gl.BuildStateValue(state),
+			}
+
+			if name != "" {
+				opts.Name = &name
+			}
+
+			if targetURL != "" {
+				opts.TargetURL = &targetURL
+			}
+
+			if description != "" {
+				opts.Description = &description
+			}
+
+			// A coverage of 0 is meaningful, so only presence decides whether it is sent
+			if _, ok := request.GetArguments()["coverage"]; ok {
+				opts.Coverage = &coverage
+			}
+
+			// --- Call GitLab API
+			status, resp, err := glClient.Commits.SetCommitStatus(projectID, sha, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("commit %q in project %q", sha, projectID), "set commit status")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(status)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal commit status data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
@@ -17,6 +17,7 @@ func GetProjectFile(getClient GetClientFn, t map[string]string) (tool mcp.Tool,
 	return mcp.NewTool(
 			"getProjectFile",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_PROJECT_FILE_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        "Get Project File Content",
 				ReadOnlyHint: boolPtr(true),
@@ -90,6 +91,7 @@ func ListProjectFiles(getClient GetClientFn, t map[string]string) (tool mcp.Tool
 	return mcp.NewTool(
 			"listProjectFiles",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_PROJECT_FILES_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        "List Project Files/Directories",
 				ReadOnlyHint: boolPtr(true),
@@ -0,0 +1,534 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	mock_gitlab "gitlab.com/gitlab-org/api/client-go/testing"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+func TestGetContributorsHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetContributors(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockRepos, ctrl := setupMockClientForRepos(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := GetContributors(mockGetClient, nil)
+
+	tests := []struct {
+		name               string
+		inputArgs          map[string]any
+		mockSetup          func()
+		expectedText       string
+		expectHandlerError bool
+		expectResultError  bool
+		errorContains      string
+	}{
+		{
+			name:      "Success - Ordered by commits",
+			inputArgs: map[string]any{"projectId": "group/project", "orderBy": "commits", "sort": "desc"},
+			mockSetup: func() {
+				mockRepos.EXPECT().
+					Contributors("group/project", gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, opts *gl.ListContributorsOptions, _ ...gl.RequestOptionFunc) ([]*gl.Contributor, *gl.Response, error) {
+						assert.Equal(t, "commits", *opts.OrderBy)
+						assert.Equal(t, "desc", *opts.Sort)
+						return []*gl.Contributor{{Name: "Alex", Email: "alex@example.com", Commits: 17}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+					})
+			},
+			expectedText: `"commits":17`,
+		},
+		{
+			name:      "Success - Empty repository",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockRepos.EXPECT().
+					Contributors("group/project", gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, opts *gl.ListContributorsOptions, _ ...gl.RequestOptionFunc) ([]*gl.Contributor, *gl.Response, error) {
+						assert.Nil(t, opts.OrderBy)
+						assert.Nil(t, opts.Sort)
+						return []*gl.Contributor{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+					})
+			},
+			expectedText: "[]",
+		},
+		{
+			name:              "Error - Missing projectId",
+			inputArgs:         map[string]any{},
+			mockSetup:         func() {},
+			expectResultError: true,
+			errorContains:     "Validation Error: missing required parameter: projectId",
+		},
+		{
+			name:      "Error - Server error (500)",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockRepos.EXPECT().
+					Contributors("group/project", gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 500}}, errors.New("gitlab: 500 Internal Server Error"))
+			},
+			expectHandlerError: true,
+			errorContains:      "failed to list contributors from project",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.inputArgs}})
+
+			if tc.expectHandlerError {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			textContent := getTextResult(t, result)
+			if tc.expectResultError {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.errorContains)
+				return
+			}
+			assert.Contains(t, textContent.Text, tc.expectedText)
+		})
+	}
+}
+
+func TestCompareRepositoryRefsHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := CompareRepositoryRefs(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockRepos, ctrl := setupMockClientForRepos(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := CompareRepositoryRefs(mockGetClient, nil)
+
+	t.Run("Success - Diffs truncated to budget", func(t *testing.T) {
+		mockRepos.EXPECT().
+			Compare("group/project", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.CompareOptions, _ ...gl.RequestOptionFunc) (*gl.Compare, *gl.Response, error) {
+				assert.Equal(t, "v1.0.0", *opts.From)
+				assert.Equal(t, "main", *opts.To)
+				assert.True(t, *opts.Straight)
+				return &gl.Compare{
+					Commits: []*gl.Commit{{ID: "abc123", Title: "Fix bug"}},
+					Diffs: []*gl.Diff{
+						{NewPath: "a.go", Diff: "0123456789"},
+						{NewPath: "b.go", Diff: "0123456789"},
+					},
+					WebURL: "https://gitlab.example.com/group/project/-/compare/v1.0.0...main",
+				}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":    "group/project",
+			"from":         "v1.0.0",
+			"to":           "main",
+			"straight":     true,
+			"maxDiffBytes": 15.0,
+		}}})
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var compare compareResult
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &compare))
+		assert.True(t, compare.Truncated)
+		assert.Equal(t, 15, compare.MaxDiffBytes)
+		require.Len(t, compare.Diffs, 2)
+		assert.False(t, compare.Diffs[0].Truncated)
+		assert.True(t, compare.Diffs[1].Truncated)
+		require.Len(t, compare.Commits, 1)
+		assert.Equal(t, "abc123", compare.Commits[0].ID)
+	})
+
+	t.Run("Success - fromProjectId is sent as a query parameter", func(t *testing.T) {
+		mockRepos.EXPECT().
+			Compare("group/project", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ *gl.CompareOptions, options ...gl.RequestOptionFunc) (*gl.Compare, *gl.Response, error) {
+				req, err := retryablehttp.NewRequest(http.MethodGet, "https://gitlab.example.com/api/v4/projects/1/repository/compare?from=a", nil)
+				require.NoError(t, err)
+				for _, fn := range options {
+					require.NoError(t, fn(req))
+				}
+				assert.Equal(t, "77", req.URL.Query().Get("from_project_id"))
+				assert.Equal(t, "a", req.URL.Query().Get("from"))
+				return &gl.Compare{CompareSameRef: true}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":     "group/project",
+			"from":          "a",
+			"to":            "a",
+			"fromProjectId": 77.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"compare_same_ref":true`)
+	})
+
+	t.Run("Error - Missing to", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"from":      "main",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: to")
+	})
+
+	t.Run("Error - maxDiffBytes out of range", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":    "group/project",
+			"from":         "v1.0.0",
+			"to":           "main",
+			"maxDiffBytes": float64(MaxMaxDiffBytes + 1),
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: maxDiffBytes must be an integer")
+	})
+
+	t.Run("Error - Ref not found (404)", func(t *testing.T) {
+		mockRepos.EXPECT().
+			Compare("group/project", gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Ref Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"from":      "nope",
+			"to":        "main",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "not found or access denied")
+	})
+}
+
+func TestGetRepositoryArchiveLinkHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetRepositoryArchiveLink(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// The archive URL is built from the client's base URL, so a real client is used
+	mockClient, err := gl.NewClient("test-token", gl.WithBaseURL("https://gitlab.example.com"))
+	require.NoError(t, err)
+	mockCommits := mock_gitlab.NewMockCommitsServiceInterface(ctrl)
+	mockProjects := mock_gitlab.NewMockProjectsServiceInterface(ctrl)
+	mockClient.Commits = mockCommits
+	mockClient.Projects = mockProjects
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := GetRepositoryArchiveLink(mockGetClient, nil)
+
+	t.Run("Success - Ref is resolved to a SHA", func(t *testing.T) {
+		mockCommits.EXPECT().
+			GetCommit("group/project", "v1.2.0", gomock.Any(), gomock.Any()).
+			Return(&gl.Commit{ID: "abc123"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"ref":       "v1.2.0",
+			"format":    "zip",
+		}}})
+		require.NoError(t, err)
+
+		var link repositoryArchiveLink
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &link))
+		assert.Equal(t, "https://gitlab.example.com/api/v4/projects/group%2Fproject/repository/archive.zip?sha=abc123", link.URL)
+		assert.Equal(t, "v1.2.0", link.Ref)
+		assert.Equal(t, "abc123", link.SHA)
+		assert.Equal(t, "zip", link.Format)
+	})
+
+	t.Run("Success - Nested project path stays encoded", func(t *testing.T) {
+		mockCommits.EXPECT().
+			GetCommit("group/sub/proj", "main", gomock.Any(), gomock.Any()).
+			Return(&gl.Commit{ID: "abc123"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/sub/proj",
+			"ref":       "main",
+		}}})
+		require.NoError(t, err)
+
+		var link repositoryArchiveLink
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &link))
+		assert.Equal(t, "https://gitlab.example.com/api/v4/projects/group%2Fsub%2Fproj/repository/archive.tar.gz?sha=abc123", link.URL)
+	})
+
+	t.Run("Success - Default branch and format", func(t *testing.T) {
+		mockProjects.EXPECT().
+			GetProject("42", gomock.Any(), gomock.Any()).
+			Return(&gl.Project{ID: 42, DefaultBranch: "main"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+		mockCommits.EXPECT().
+			GetCommit("42", "main", gomock.Any(), gomock.Any()).
+			Return(&gl.Commit{ID: "def456"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "42",
+		}}})
+		require.NoError(t, err)
+
+		var link repositoryArchiveLink
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &link))
+		assert.Equal(t, "https://gitlab.example.com/api/v4/projects/42/repository/archive.tar.gz?sha=def456", link.URL)
+		assert.Equal(t, "main", link.Ref)
+		assert.Equal(t, "tar.gz", link.Format)
+	})
+
+	t.Run("Success - SHA is used without a ref", func(t *testing.T) {
+		mockCommits.EXPECT().
+			GetCommit("group/project", "abc", gomock.Any(), gomock.Any()).
+			Return(&gl.Commit{ID: "abc123"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"sha":       "abc",
+		}}})
+		require.NoError(t, err)
+
+		var link repositoryArchiveLink
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &link))
+		assert.Equal(t, "abc123", link.SHA)
+		assert.Empty(t, link.Ref)
+	})
+
+	t.Run("Error - Both sha and ref", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"sha":       "abc123",
+			"ref":       "main",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: sha and ref cannot be used together")
+	})
+
+	t.Run("Error - Invalid format", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"format":    "rar",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `Validation Error: format must be one of tar.gz, tar.bz2, tbz, tbz2, tb2, bz2, tar, zip, got "rar"`)
+	})
+
+	t.Run("Error - Empty repository", func(t *testing.T) {
+		mockProjects.EXPECT().
+			GetProject("group/empty", gomock.Any(), gomock.Any()).
+			Return(&gl.Project{ID: 7}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/empty",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `project "group/empty" has no default branch`)
+	})
+
+	t.Run("Error - Ref not found (404)", func(t *testing.T) {
+		mockCommits.EXPECT().
+			GetCommit("group/project", "missing", gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Commit Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"ref":       "missing",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `ref "missing" in project "group/project" not found or access denied (404)`)
+	})
+}
+
+func TestGetCommitStatusesHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetCommitStatuses(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockCommits, ctrl := setupMockClientForCommits(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := GetCommitStatuses(mockGetClient, nil)
+
+	t.Run("Success - Name filter is forwarded", func(t *testing.T) {
+		mockCommits.EXPECT().
+			GetCommitStatuses("group/project", "abc123", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ string, opts *gl.GetCommitStatusesOptions, _ ...gl.RequestOptionFunc) ([]*gl.CommitStatus, *gl.Response, error) {
+				assert.Equal(t, "external-ci", *opts.Name)
+				return []*gl.CommitStatus{{ID: 3, Name: "external-ci", Status: "success"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"sha":       "abc123",
+			"name":      "external-ci",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"name":"external-ci"`)
+	})
+
+	t.Run("Success - Empty list", func(t *testing.T) {
+		mockCommits.EXPECT().
+			GetCommitStatuses("group/project", "abc123", gomock.Any(), gomock.Any()).
+			Return([]*gl.CommitStatus{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"sha":       "abc123",
+		}}})
+		require.NoError(t, err)
+		assert.Equal(t, "[]", getTextResult(t, result).Text)
+	})
+
+	t.Run("Error - Missing sha", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: sha")
+	})
+
+	t.Run("Error - Commit not found (404)", func(t *testing.T) {
+		mockCommits.EXPECT().
+			GetCommitStatuses("group/project", "deadbeef", gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"sha":       "deadbeef",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "not found or access denied (404)")
+	})
+}
+
+func TestSetCommitStatusHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := SetCommitStatus(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockCommits, ctrl := setupMockClientForCommits(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := SetCommitStatus(mockGetClient, nil)
+
+	t.Run("Success - All fields are sent", func(t *testing.T) {
+		mockCommits.EXPECT().
+			SetCommitStatus("group/project", "abc123", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ string, opts *gl.SetCommitStatusOptions, _ ...gl.RequestOptionFunc) (*gl.CommitStatus, *gl.Response, error) {
+				assert.Equal(t, gl.Success, opts.State)
+				assert.Equal(t, "external-ci", *opts.Name)
+				assert.Equal(t, "https://ci.example.com/builds/1", *opts.TargetURL)
+				assert.Equal(t, "All checks passed", *opts.Description)
+				assert.Equal(t, 87.5, *opts.Coverage)
+				return &gl.CommitStatus{ID: 3, Name: "external-ci", Status: "success"}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":   "group/project",
+			"sha":         "abc123",
+			"state":       "success",
+			"name":        "external-ci",
+			"targetUrl":   "https://ci.example.com/builds/1",
+			"description": "All checks passed",
+			"coverage":    87.5,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"status":"success"`)
+	})
+
+	t.Run("Success - Zero coverage is sent", func(t *testing.T) {
+		mockCommits.EXPECT().
+			SetCommitStatus("group/project", "abc123", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ string, opts *gl.SetCommitStatusOptions, _ ...gl.RequestOptionFunc) (*gl.CommitStatus, *gl.Response, error) {
+				require.NotNil(t, opts.Coverage)
+				assert.Equal(t, 0.0, *opts.Coverage)
+				assert.Nil(t, opts.Name)
+				return &gl.CommitStatus{ID: 4, Status: "failed"}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"sha":       "abc123",
+			"state":     "failed",
+			"coverage":  0.0,
+		}}})
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	t.Run("Error - Coverage out of range", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"sha":       "abc123",
+			"state":     "success",
+			"coverage":  120.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: coverage must be between 0 and 100")
+	})
+
+	t.Run("Error - Missing state", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"sha":       "abc123",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: state")
+	})
+
+	t.Run("Error - Invalid transition (400)", func(t *testing.T) {
+		mockCommits.EXPECT().
+			SetCommitStatus("group/project", "abc123", gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 400}}, errors.New("gitlab: 400 Cannot transition status via :run from :running"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"sha":       "abc123",
+			"state":     "running",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "failed to set commit status")
+	})
+}
@@ -0,0 +1,225 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gl "gitlab.com/gitlab-org/api/client-go"
+)
+
+// graphqlQueryRequirements lists the requirements of a project. Requirements are only
+// exposed through GraphQL; the REST API has no endpoint for them.
+const graphqlQueryRequirements = `
+query GetProjectRequirements($fullPath: ID!, $state: RequirementState, $search: String, $iids: [ID!], $first: Int, $after: String) {
+	project(fullPath: $fullPath) {
+		requirements(state: $state, search: $search, iids: $iids, first: $first, after: $after) {
+			nodes {
+				iid
+				title
+				description
+				state
+				lastTestReportState
+				createdAt
+				updatedAt
+				webUrl
+				author {
+					username
+				}
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
+	}
+}
+`
+
+// ProjectRequirement represents a requirement of a project (GitLab Premium).
+type ProjectRequirement struct {
+	IID                 string           `json:"iid"`
+	Title               string           `json:"title"`
+	Description         string           `json:"description,omitempty"`
+	State               string           `json:"state"`
+	LastTestReportState string           `json:"lastTestReportState,omitempty"`
+	CreatedAt           string           `json:"createdAt"`
+	UpdatedAt           string           `json:"updatedAt"`
+	WebURL              string           `json:"webUrl,omitempty"`
+	Author              *RequirementUser `json:"author,omitempty"`
+}
+
+// RequirementUser represents the author of a requirement
+type RequirementUser struct {
+	Username string `json:"username"`
+}
+
+// ProjectRequirementsResponse represents the GraphQL response for the requirements query
+type ProjectRequirementsResponse struct {
+	Data struct {
+		Project *struct {
+			Requirements struct {
+				Nodes    []*ProjectRequirement `json:"nodes"`
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+			} `json:"requirements"`
+		} `json:"project"`
+	} `json:"data"`
+	gl.GenericGraphQLErrors
+}
+
+// requirementStates maps the accepted state values to the GraphQL RequirementState enum.
+// Archiving a requirement closes it, so "closed" is accepted as an alias of "archived".
+var requirementStates = map[string]string{
+	"opened":   "OPENED",
+	"closed":   "ARCHIVED",
+	"archived": "ARCHIVED",
+}
+
+// GetProjectRequirements defines the MCP tool for listing the requirements of a project.
+func GetProjectRequirements(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"getProjectRequirements",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_PROJECT_REQUIREMENTS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "Get GitLab Project Requirements",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The full path of the project (e.g. 'group/project'). Numeric IDs are not supported by the GraphQL API."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithString("state",
+				mcp.Description("Return only requirements in this state. 'closed' is an alias of 'archived'."),
+				mcp.Enum("opened", "closed", "archived"),
+			),
+			mcp.WithString("search",
+				mcp.Description("Return only requirements whose title or description matches this string."),
+			),
+			mcp.WithString("iids",
+				mcp.Description("Comma-separated list of requirement IIDs to return."),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional parameters
+			variables := map[string]any{"fullPath": projectID}
+
+			state, err := OptionalParam[string](&request, "state")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			if state != "" {
+				gqlState, ok := requirementStates[state]
+				if !ok {
+					return mcp.NewToolResultError(fmt.Sprintf("Validation Error: state must be 'opened', 'closed' or 'archived', got %q", state)), nil
+				}
+				variables["state"] = gqlState
+			}
+
+			search, err := OptionalParam[string](&request, "search")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			if search != "" {
+				variables["search"] = search
+			}
+
+			iidsStr, err := OptionalParam[string](&request, "iids")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			iids, err := ParseIDListString(iidsStr, "requirement IID")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			if iids != nil {
+				// GraphQL takes IIDs as ID strings
+				iidStrs := make([]string, len(*iids))
+				for i, iid := range *iids {
+					iidStrs[i] = strconv.FormatInt(iid, 10)
+				}
+				variables["iids"] = iidStrs
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			variables["first"] = perPage
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			// GraphQL connections are cursor-based, so earlier pages are walked to reach the requested one.
+			resourceDesc := fmt.Sprintf("requirements of project %q", projectID)
+			var responseData ProjectRequirementsResponse
+			for p := 1; p <= page; p++ {
+				responseData = ProjectRequirementsResponse{}
+				resp, err := glClient.GraphQL.Do(gl.GraphQLQuery{
+					Query:     graphqlQueryRequirements,
+					Variables: variables,
+				}, &responseData, gl.WithContext(ctx))
+
+				// --- Handle API errors
+				if err != nil {
+					result, apiErr := HandleGraphQLError(err, resp, resourceDesc)
+					if result != nil {
+						return result, nil
+					}
+					return nil, apiErr
+				}
+				if len(responseData.Errors) > 0 {
+					messages := make([]string, len(responseData.Errors))
+					for i, e := range responseData.Errors {
+						messages[i] = e.Message
+					}
+					return mcp.NewToolResultError(fmt.Sprintf("failed to get %s: %s", resourceDesc, strings.Join(messages, "; "))), nil
+				}
+				if responseData.Data.Project == nil {
+					return mcp.NewToolResultError(fmt.Sprintf("project %q not found or access denied", projectID)), nil
+				}
+
+				pageInfo := responseData.Data.Project.Requirements.PageInfo
+				if p < page && !pageInfo.HasNextPage {
+					return mcp.NewToolResultText("[]"), nil
+				}
+				variables["after"] = pageInfo.EndCursor
+			}
+
+			// --- Handle empty result gracefully
+			requirements := responseData.Data.Project.Requirements.Nodes
+			if len(requirements) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(requirements)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal project requirements: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
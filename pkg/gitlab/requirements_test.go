@@ -0,0 +1,120 @@
+package gitlab
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+// requirementsResponse builds the data of a requirements query with the given nodes.
+func requirementsResponse(hasNextPage bool, nodes ...map[string]any) map[string]any {
+	if nodes == nil {
+		nodes = []map[string]any{}
+	}
+	return map[string]any{
+		"project": map[string]any{
+			"requirements": map[string]any{
+				"nodes": nodes,
+				"pageInfo": map[string]any{
+					"hasNextPage": hasNextPage,
+					"endCursor":   "cursor-1",
+				},
+			},
+		},
+	}
+}
+
+// requirementsHandler returns a getProjectRequirements handler backed by a mocked GraphQL endpoint.
+func requirementsHandler(t *testing.T, response map[string]any) func(args map[string]any) (string, bool) {
+	mockClient, err := MockGraphQLClient(NewMockGraphQLHTTPClient(
+		GraphQLMockMatcher{
+			Query:    "GetProjectRequirements",
+			Response: response,
+		},
+	), "test-token")
+	require.NoError(t, err)
+
+	_, handler := GetProjectRequirements(func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}, nil)
+
+	return func(args map[string]any) (string, bool) {
+		result, err := handler(context.Background(), *createMCPRequest(args))
+		require.NoError(t, err)
+		return getTextResult(t, result).Text, result.IsError
+	}
+}
+
+func TestGetProjectRequirementsHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetProjectRequirements(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	t.Run("Success", func(t *testing.T) {
+		call := requirementsHandler(t, DataResponse(requirementsResponse(false, map[string]any{
+			"iid":                 "1",
+			"title":               "Export audit logs",
+			"state":               "OPENED",
+			"lastTestReportState": "PASSED",
+			"author":              map[string]any{"username": "alice"},
+		})))
+
+		text, isError := call(map[string]any{"projectId": "group/project", "state": "opened", "iids": "1,2"})
+		assert.False(t, isError)
+		assert.Contains(t, text, `"iid":"1"`)
+		assert.Contains(t, text, `"title":"Export audit logs"`)
+		assert.Contains(t, text, `"lastTestReportState":"PASSED"`)
+		assert.Contains(t, text, `"username":"alice"`)
+	})
+
+	t.Run("Success - No requirements", func(t *testing.T) {
+		call := requirementsHandler(t, DataResponse(requirementsResponse(false)))
+
+		text, isError := call(map[string]any{"projectId": "group/project"})
+		assert.False(t, isError)
+		assert.Equal(t, "[]", text)
+	})
+
+	t.Run("Success - Page past the end", func(t *testing.T) {
+		call := requirementsHandler(t, DataResponse(requirementsResponse(false, map[string]any{"iid": "1"})))
+
+		text, isError := call(map[string]any{"projectId": "group/project", "page": 2.0})
+		assert.False(t, isError)
+		assert.Equal(t, "[]", text)
+	})
+
+	t.Run("Error - Project not found", func(t *testing.T) {
+		call := requirementsHandler(t, DataResponse(map[string]any{"project": nil}))
+
+		text, isError := call(map[string]any{"projectId": "group/missing"})
+		assert.True(t, isError)
+		assert.Contains(t, text, `project "group/missing" not found`)
+	})
+
+	t.Run("Error - GraphQL error (not Premium)", func(t *testing.T) {
+		call := requirementsHandler(t, ErrorResponse("Field 'requirements' doesn't exist on type 'Project'"))
+
+		text, isError := call(map[string]any{"projectId": "group/project"})
+		assert.True(t, isError)
+		assert.Contains(t, text, "Field 'requirements' doesn't exist")
+	})
+
+	t.Run("Error - Invalid state", func(t *testing.T) {
+		call := requirementsHandler(t, DataResponse(requirementsResponse(false)))
+
+		text, _ := call(map[string]any{"projectId": "group/project", "state": "draft"})
+		assert.Contains(t, text, `Validation Error: state must be 'opened', 'closed' or 'archived', got "draft"`)
+	})
+
+	t.Run("Error - Invalid iids", func(t *testing.T) {
+		call := requirementsHandler(t, DataResponse(requirementsResponse(false)))
+
+		text, _ := call(map[string]any{"projectId": "group/project", "iids": "1,x"})
+		assert.Contains(t, text, "Validation Error:")
+	})
+}
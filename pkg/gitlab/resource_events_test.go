@@ -0,0 +1,436 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+func TestListIssueStateEventsHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListIssueStateEvents(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockStateEvents, ctrl := setupMockClientForResourceStateEvents(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListIssueStateEvents(mockGetClient, nil)
+
+	tests := []struct {
+		name               string
+		inputArgs          map[string]any
+		mockSetup          func()
+		expectedText       string
+		expectHandlerError bool
+		expectResultError  bool
+		errorContains      string
+	}{
+		{
+			name:      "Success - List state events",
+			inputArgs: map[string]any{"projectId": "group/project", "issueIid": 3.0, "page": 2.0, "per_page": 5.0},
+			mockSetup: func() {
+				mockStateEvents.EXPECT().
+					ListIssueStateEvents("group/project", int64(3), &gl.ListStateEventsOptions{ListOptions: gl.ListOptions{Page: 2, PerPage: 5}}, gomock.Any()).
+					Return([]*gl.StateEvent{{ID: 7, State: gl.ClosedEventType, User: &gl.BasicUser{Username: "alice"}}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: `"state":"closed"`,
+		},
+		{
+			name:      "Success - Empty list",
+			inputArgs: map[string]any{"projectId": "group/project", "issueIid": 3.0},
+			mockSetup: func() {
+				mockStateEvents.EXPECT().
+					ListIssueStateEvents("group/project", int64(3), gomock.Any(), gomock.Any()).
+					Return([]*gl.StateEvent{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: "[]",
+		},
+		{
+			name:              "Error - Missing issueIid",
+			inputArgs:         map[string]any{"projectId": "group/project"},
+			mockSetup:         func() {},
+			expectResultError: true,
+			errorContains:     "Validation Error: missing required parameter: issueIid",
+		},
+		{
+			name:      "Error - Server error (500)",
+			inputArgs: map[string]any{"projectId": "group/project", "issueIid": 99.0},
+			mockSetup: func() {
+				mockStateEvents.EXPECT().
+					ListIssueStateEvents("group/project", int64(99), gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 500}}, errors.New("gitlab: 500 Internal Server Error"))
+			},
+			expectHandlerError: true,
+			errorContains:      "failed to list state events for issue 99",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.inputArgs}})
+
+			if tc.expectHandlerError {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			textContent := getTextResult(t, result)
+			if tc.expectResultError {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.errorContains)
+				return
+			}
+			assert.Contains(t, textContent.Text, tc.expectedText)
+		})
+	}
+}
+
+func TestListMergeRequestStateEventsHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListMergeRequestStateEvents(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockStateEvents, ctrl := setupMockClientForResourceStateEvents(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListMergeRequestStateEvents(mockGetClient, nil)
+
+	tests := []struct {
+		name               string
+		inputArgs          map[string]any
+		mockSetup          func()
+		expectedText       string
+		expectHandlerError bool
+		expectResultError  bool
+		errorContains      string
+	}{
+		{
+			name:      "Success - List state events",
+			inputArgs: map[string]any{"projectId": "group/project", "mergeRequestIid": 4.0},
+			mockSetup: func() {
+				mockStateEvents.EXPECT().
+					ListMergeStateEvents("group/project", int64(4), gomock.Any(), gomock.Any()).
+					Return([]*gl.StateEvent{{ID: 8, State: gl.MergedEventType}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: `"state":"merged"`,
+		},
+		{
+			name:              "Error - Non-integer mergeRequestIid",
+			inputArgs:         map[string]any{"projectId": "group/project", "mergeRequestIid": 4.5},
+			mockSetup:         func() {},
+			expectResultError: true,
+			errorContains:     "Validation Error: mergeRequestIid 4.5 is not a valid integer",
+		},
+		{
+			name:      "Error - Unauthorized (401)",
+			inputArgs: map[string]any{"projectId": "group/project", "mergeRequestIid": 4.0},
+			mockSetup: func() {
+				mockStateEvents.EXPECT().
+					ListMergeStateEvents("group/project", int64(4), gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 401}}, errors.New("gitlab: 401 Unauthorized"))
+			},
+			expectResultError: true,
+			errorContains:     "Authentication failed (401)",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.inputArgs}})
+
+			if tc.expectHandlerError {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			textContent := getTextResult(t, result)
+			if tc.expectResultError {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.errorContains)
+				return
+			}
+			assert.Contains(t, textContent.Text, tc.expectedText)
+		})
+	}
+}
+
+func TestGetIssueResourceLabelEventsHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetIssueResourceLabelEvents(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockLabelEvents, ctrl := setupMockClientForResourceLabelEvents(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := GetIssueResourceLabelEvents(mockGetClient, nil)
+
+	tests := []struct {
+		name               string
+		inputArgs          map[string]any
+		mockSetup          func()
+		expectedText       string
+		expectHandlerError bool
+		expectResultError  bool
+		errorContains      string
+	}{
+		{
+			name:      "Success - List label events",
+			inputArgs: map[string]any{"projectId": "group/project", "issueIid": 3.0, "page": 1.0, "per_page": 50.0},
+			mockSetup: func() {
+				mockLabelEvents.EXPECT().
+					ListIssueLabelEvents("group/project", int64(3), &gl.ListLabelEventsOptions{ListOptions: gl.ListOptions{Page: 1, PerPage: 50}}, gomock.Any()).
+					Return([]*gl.LabelEvent{{ID: 7, Action: "add", Label: gl.LabelEventLabel{Name: "bug"}}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: `"action":"add"`,
+		},
+		{
+			name:      "Success - Empty list",
+			inputArgs: map[string]any{"projectId": "group/project", "issueIid": 3.0},
+			mockSetup: func() {
+				mockLabelEvents.EXPECT().
+					ListIssueLabelEvents("group/project", int64(3), gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: "[]",
+		},
+		{
+			name:              "Error - Missing projectId",
+			inputArgs:         map[string]any{"issueIid": 3.0},
+			mockSetup:         func() {},
+			expectResultError: true,
+			errorContains:     "Validation Error: missing required parameter: projectId",
+		},
+		{
+			name:      "Error - Server error (500)",
+			inputArgs: map[string]any{"projectId": "group/project", "issueIid": 3.0},
+			mockSetup: func() {
+				mockLabelEvents.EXPECT().
+					ListIssueLabelEvents("group/project", int64(3), gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 500}}, errors.New("gitlab: 500 Internal Server Error"))
+			},
+			expectHandlerError: true,
+			errorContains:      "failed to list label events for issue 3",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.inputArgs}})
+
+			if tc.expectHandlerError {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			textContent := getTextResult(t, result)
+			if tc.expectResultError {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.errorContains)
+				return
+			}
+			assert.Contains(t, textContent.Text, tc.expectedText)
+		})
+	}
+}
+
+func TestGetMergeRequestResourceLabelEventsHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetMergeRequestResourceLabelEvents(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockLabelEvents, ctrl := setupMockClientForResourceLabelEvents(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := GetMergeRequestResourceLabelEvents(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockLabelEvents.EXPECT().
+			ListMergeRequestsLabelEvents("group/project", int64(4), gomock.Any(), gomock.Any()).
+			Return([]*gl.LabelEvent{{ID: 9, Action: "remove", Label: gl.LabelEventLabel{Name: "needs-review"}}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{"projectId": "group/project", "mergeRequestIid": 4.0}))
+		require.NoError(t, err)
+		text := getTextResult(t, result).Text
+		assert.Contains(t, text, `"action":"remove"`)
+		assert.Contains(t, text, `"name":"needs-review"`)
+	})
+
+	t.Run("Error - Non-integer mergeRequestIid", func(t *testing.T) {
+		result, err := handler(ctx, *createMCPRequest(map[string]any{"projectId": "group/project", "mergeRequestIid": 4.5}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: mergeRequestIid 4.5 is not a valid integer")
+	})
+}
+
+func TestGetIssueResourceMilestoneEventsHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetIssueResourceMilestoneEvents(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockMilestoneEvents, ctrl := setupMockClientForResourceMilestoneEvents(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := GetIssueResourceMilestoneEvents(mockGetClient, nil)
+
+	tests := []struct {
+		name               string
+		inputArgs          map[string]any
+		mockSetup          func()
+		expectedText       string
+		expectHandlerError bool
+		expectResultError  bool
+		errorContains      string
+	}{
+		{
+			name:      "Success - List milestone events",
+			inputArgs: map[string]any{"projectId": "group/project", "issueIid": 3.0, "page": 3.0, "per_page": 10.0},
+			mockSetup: func() {
+				mockMilestoneEvents.EXPECT().
+					ListIssueMilestoneEvents("group/project", int64(3), &gl.ListMilestoneEventsOptions{ListOptions: gl.ListOptions{Page: 3, PerPage: 10}}, gomock.Any()).
+					Return([]*gl.MilestoneEvent{{ID: 7, Action: "add", Milestone: &gl.Milestone{Title: "v1.0"}}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: `"title":"v1.0"`,
+		},
+		{
+			name:      "Success - Empty list",
+			inputArgs: map[string]any{"projectId": "group/project", "issueIid": 3.0},
+			mockSetup: func() {
+				mockMilestoneEvents.EXPECT().
+					ListIssueMilestoneEvents("group/project", int64(3), gomock.Any(), gomock.Any()).
+					Return([]*gl.MilestoneEvent{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: "[]",
+		},
+		{
+			name:              "Error - Non-integer issueIid",
+			inputArgs:         map[string]any{"projectId": "group/project", "issueIid": 3.2},
+			mockSetup:         func() {},
+			expectResultError: true,
+			errorContains:     "Validation Error: issueIid 3.2 is not a valid integer",
+		},
+		{
+			name:      "Error - Server error (500)",
+			inputArgs: map[string]any{"projectId": "group/project", "issueIid": 3.0},
+			mockSetup: func() {
+				mockMilestoneEvents.EXPECT().
+					ListIssueMilestoneEvents("group/project", int64(3), gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 500}}, errors.New("gitlab: 500 Internal Server Error"))
+			},
+			expectHandlerError: true,
+			errorContains:      "failed to list milestone events for issue 3",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.inputArgs}})
+
+			if tc.expectHandlerError {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			textContent := getTextResult(t, result)
+			if tc.expectResultError {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.errorContains)
+				return
+			}
+			assert.Contains(t, textContent.Text, tc.expectedText)
+		})
+	}
+}
+
+func TestGetMergeRequestResourceMilestoneEventsHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetMergeRequestResourceMilestoneEvents(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockMilestoneEvents, ctrl := setupMockClientForResourceMilestoneEvents(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := GetMergeRequestResourceMilestoneEvents(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockMilestoneEvents.EXPECT().
+			ListMergeMilestoneEvents("group/project", int64(4), gomock.Any(), gomock.Any()).
+			Return([]*gl.MilestoneEvent{{ID: 9, Action: "remove", Milestone: &gl.Milestone{Title: "v2.0"}}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{"projectId": "group/project", "mergeRequestIid": 4.0}))
+		require.NoError(t, err)
+		text := getTextResult(t, result).Text
+		assert.Contains(t, text, `"action":"remove"`)
+		assert.Contains(t, text, `"title":"v2.0"`)
+	})
+
+	t.Run("Error - Unauthorized (401)", func(t *testing.T) {
+		mockMilestoneEvents.EXPECT().
+			ListMergeMilestoneEvents("group/project", int64(4), gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 401}}, errors.New("gitlab: 401 Unauthorized"))
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{"projectId": "group/project", "mergeRequestIid": 4.0}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "Authentication failed (401)")
+	})
+}
@@ -1,8 +1,11 @@
 package gitlab
 
 import (
+	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"strings"
 
 	gl "gitlab.com/gitlab-org/api/client-go"
@@ -20,6 +23,48 @@ type PaginationMetadata struct {
 type PaginatedResponse struct {
 	Items      interface{}         `json:"items"`
 	Pagination *PaginationMetadata `json:"pagination,omitempty"`
+	// NextCursor is set in keyset mode when more results are available. It is passed
+	// back as the 'cursor' parameter to fetch the next page.
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// PaginationMode selects how list tools page through results.
+type PaginationMode string
+
+const (
+	// PaginationModeOffset pages through results by page number (the default).
+	PaginationModeOffset PaginationMode = "offset"
+	// PaginationModeKeyset pages through results with an opaque cursor. It is more
+	// stable than offset pagination for large datasets.
+	PaginationModeKeyset PaginationMode = "keyset"
+)
+
+// ExtractNextCursor returns the cursor for the next page of a keyset-paginated response.
+// The cursor encodes the query of the 'next' link GitLab returns in the Link header.
+// It returns an empty string on the last page.
+func ExtractNextCursor(resp *gl.Response) string {
+	if resp == nil || resp.NextLink == "" {
+		return ""
+	}
+	nextURL, err := url.Parse(resp.NextLink)
+	if err != nil || nextURL.RawQuery == "" {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(nextURL.RawQuery))
+}
+
+// decodeCursor converts a cursor produced by ExtractNextCursor back into the query
+// parameters of the next page.
+func decodeCursor(cursor string) (url.Values, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+	query, err := url.ParseQuery(string(raw))
+	if err != nil || query.Get("pagination") != string(PaginationModeKeyset) {
+		return nil, fmt.Errorf("invalid cursor: not a keyset pagination cursor")
+	}
+	return query, nil
 }
 
 // ExtractPagination extracts pagination metadata from GitLab API response
@@ -219,3 +264,62 @@ func (o *ResponseOptimizer) OptimizeListResponse(data interface{}, resp *gl.Resp
 		Pagination: pagination,
 	}, nil
 }
+
+// filterFields keeps only the requested top-level JSON fields of data. data may be a single
+// object or an array of objects; array elements that are not objects are left untouched.
+// Unknown field names are ignored. With no fields, data is returned unchanged.
+func filterFields(data []byte, fields []string) ([]byte, error) {
+	if len(fields) == 0 {
+		return data, nil
+	}
+
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		var items []json.RawMessage
+		if err := json.Unmarshal(trimmed, &items); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal list for field filtering: %w", err)
+		}
+		for i, item := range items {
+			item = bytes.TrimSpace(item)
+			if len(item) == 0 || item[0] != '{' {
+				continue
+			}
+			filtered, err := filterFields(item, fields)
+			if err != nil {
+				return nil, err
+			}
+			items[i] = filtered
+		}
+		return json.Marshal(items)
+	}
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(trimmed, &object); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal object for field filtering: %w", err)
+	}
+	selected := make(map[string]json.RawMessage, len(fields))
+	for _, field := range fields {
+		if value, ok := object[field]; ok {
+			selected[field] = value
+		}
+	}
+	return json.Marshal(selected)
+}
+
+// SelectFields restricts every item of the response to the requested top-level fields.
+// The pagination metadata is kept as is.
+func (p *PaginatedResponse) SelectFields(fields []string) error {
+	if len(fields) == 0 {
+		return nil
+	}
+	data, err := json.Marshal(p.Items)
+	if err != nil {
+		return fmt.Errorf("failed to marshal items for field filtering: %w", err)
+	}
+	filtered, err := filterFields(data, fields)
+	if err != nil {
+		return err
+	}
+	p.Items = json.RawMessage(filtered)
+	return nil
+}
@@ -460,3 +460,77 @@ func TestExtractPagination_EmptyResponse(t *testing.T) {
 	result := ExtractPagination(&gl.Response{})
 	assert.Nil(t, result, "Should return nil for response with no headers")
 }
+
+func TestExtractNextCursor(t *testing.T) {
+	next := "https://gitlab.example.com/api/v4/projects?id_after=42&order_by=id&pagination=keyset&per_page=20&sort=asc"
+
+	cursor := ExtractNextCursor(&gl.Response{NextLink: next})
+	require.NotEmpty(t, cursor)
+
+	query, err := decodeCursor(cursor)
+	require.NoError(t, err)
+	assert.Equal(t, "42", query.Get("id_after"))
+	assert.Equal(t, "keyset", query.Get("pagination"))
+
+	assert.Empty(t, ExtractNextCursor(&gl.Response{}), "last page has no cursor")
+	assert.Empty(t, ExtractNextCursor(nil))
+
+	_, err = decodeCursor("not a cursor!")
+	assert.ErrorContains(t, err, "invalid cursor")
+	offsetCursor := ExtractNextCursor(&gl.Response{NextLink: "https://gitlab.example.com/api/v4/projects?page=2"})
+	_, err = decodeCursor(offsetCursor)
+	assert.ErrorContains(t, err, "not a keyset pagination cursor")
+}
+
+func TestFilterFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		fields   []string
+		expected string
+	}{
+		{
+			name:     "No fields returns data unchanged",
+			data:     `{"id":1,"title":"Test"}`,
+			fields:   nil,
+			expected: `{"id":1,"title":"Test"}`,
+		},
+		{
+			name:     "Object keeps requested fields",
+			data:     `{"id":1,"title":"Test","description":"Long","author":{"id":2}}`,
+			fields:   []string{"id", "author"},
+			expected: `{"author":{"id":2},"id":1}`,
+		},
+		{
+			name:     "Unknown fields are ignored",
+			data:     `{"id":1,"title":"Test"}`,
+			fields:   []string{"id", "missing"},
+			expected: `{"id":1}`,
+		},
+		{
+			name:     "Array filters each object",
+			data:     `[{"id":1,"title":"A","state":"opened"},{"id":2,"title":"B","state":"closed"}]`,
+			fields:   []string{"id", "state"},
+			expected: `[{"id":1,"state":"opened"},{"id":2,"state":"closed"}]`,
+		},
+		{
+			name:     "Array keeps non-object elements",
+			data:     `[{"id":1,"title":"A"},"plain",null]`,
+			fields:   []string{"id"},
+			expected: `[{"id":1},"plain",null]`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := filterFields([]byte(tc.data), tc.fields)
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.expected, string(result))
+		})
+	}
+
+	t.Run("Invalid JSON returns error", func(t *testing.T) {
+		_, err := filterFields([]byte(`not json`), []string{"id"})
+		assert.Error(t, err)
+	})
+}
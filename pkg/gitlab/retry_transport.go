@@ -0,0 +1,144 @@
+package gitlab
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	gl "gitlab.com/gitlab-org/api/client-go"
+)
+
+// DefaultMaxRetries is the default number of retries for transient GitLab errors.
+const DefaultMaxRetries = 3
+
+const (
+	retryInitialBackoff = 500 * time.Millisecond
+	retryMaxBackoff     = 10 * time.Second
+	// retryMaxRateLimitWait bounds the wait requested by GitLab on a 429 response
+	retryMaxRateLimitWait = time.Minute
+)
+
+// retryRoundTripper retries requests that fail with a transient status code using
+// exponential backoff, or the wait GitLab asks for on 429 responses. 429 and 503 are
+// retried for all methods; 502 and 504 only for GET, HEAD and OPTIONS, since the
+// gateway may have timed out after GitLab already applied a change.
+type retryRoundTripper struct {
+	next           http.RoundTripper
+	maxRetries     int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+}
+
+// newRetryRoundTripper wraps next (http.DefaultTransport if nil) with retry handling.
+func newRetryRoundTripper(next http.RoundTripper, maxRetries int) *retryRoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if maxRetries < 0 {
+		maxRetries = 0
+	}
+	return &retryRoundTripper{
+		next:           next,
+		maxRetries:     maxRetries,
+		initialBackoff: retryInitialBackoff,
+		maxBackoff:     retryMaxBackoff,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (rt *retryRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	backoff := rt.initialBackoff
+	for attempt := 0; ; attempt++ {
+		resp, err := rt.next.RoundTrip(req)
+		if err != nil || !isRetryable(req.Method, resp.StatusCode) || attempt >= rt.maxRetries {
+			return resp, err
+		}
+
+		// A request body can only be replayed if it can be obtained again
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return resp, nil
+			}
+			body, err := req.GetBody()
+			if err != nil {
+				return resp, nil
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
+		// Drain and close the discarded response so the connection can be reused
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(retryDelay(resp, backoff, time.Now())):
+		}
+
+		backoff *= 2
+		if backoff > rt.maxBackoff {
+			backoff = rt.maxBackoff
+		}
+	}
+}
+
+// isRetryable reports whether a request with the given method is worth retrying after a
+// response with the given status. A 502 or 504 may come after GitLab already processed
+// the request, so only requests without side effects are retried on them.
+func isRetryable(method string, code int) bool {
+	switch code {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		switch method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			return true
+		}
+	}
+	return false
+}
+
+// retryDelay returns how long to wait before retrying after resp. For a 429 response it
+// is the wait requested via Retry-After or RateLimit-Reset (at most retryMaxRateLimitWait);
+// otherwise, or without these headers, it is backoff.
+func retryDelay(resp *http.Response, backoff time.Duration, now time.Time) time.Duration {
+	if resp.StatusCode != http.StatusTooManyRequests {
+		return backoff
+	}
+
+	var wait time.Duration
+	retryAfter := resp.Header.Get("Retry-After")
+	if seconds, err := strconv.Atoi(retryAfter); err == nil {
+		wait = time.Duration(seconds) * time.Second
+	} else if at, err := http.ParseTime(retryAfter); err == nil {
+		wait = at.Sub(now)
+	} else if resetUnix, err := strconv.ParseInt(resp.Header.Get("RateLimit-Reset"), 10, 64); err == nil {
+		wait = time.Unix(resetUnix, 0).Sub(now)
+	} else {
+		return backoff
+	}
+	return min(max(wait, 0), retryMaxRateLimitWait)
+}
+
+// NewClient creates a GitLab client whose transport retries transient errors up to
+// maxRetries times. The client library's own retry logic is disabled so that the
+// attempts do not multiply.
+func NewClient(token string, maxRetries int, opts ...gl.ClientOptionFunc) (*gl.Client, error) {
+	return newClientWithTransport(token, http.DefaultTransport, maxRetries, opts...)
+}
+
+// newClientWithTransport is NewClient with a custom base transport (e.g. one using a proxy),
+// which is wrapped with retry handling.
+func newClientWithTransport(token string, transport http.RoundTripper, maxRetries int, opts ...gl.ClientOptionFunc) (*gl.Client, error) {
+	httpClient := &http.Client{
+		Transport: newRetryRoundTripper(transport, maxRetries),
+	}
+	clientOpts := append([]gl.ClientOptionFunc{
+		gl.WithHTTPClient(httpClient),
+		gl.WithoutRetries(),
+	}, opts...)
+	return gl.NewClient(token, clientOpts...)
+}
@@ -0,0 +1,223 @@
+package gitlab
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+)
+
+// newTestRetryRoundTripper returns a retryRoundTripper with millisecond backoffs for fast tests
+func newTestRetryRoundTripper(maxRetries int) *retryRoundTripper {
+	rt := newRetryRoundTripper(http.DefaultTransport, maxRetries)
+	rt.initialBackoff = time.Millisecond
+	rt.maxBackoff = 4 * time.Millisecond
+	return rt
+}
+
+// statusSequenceServer responds with the given status codes in order, then 200 OK
+func statusSequenceServer(t *testing.T, codes ...int) (*httptest.Server, *int32) {
+	t.Helper()
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(atomic.AddInt32(&calls, 1))
+		body, _ := io.ReadAll(r.Body)
+		if n <= len(codes) {
+			w.WriteHeader(codes[n-1])
+			return
+		}
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func TestRetryRoundTripper(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		maxRetries     int
+		codes          []int
+		expectedStatus int
+		expectedCalls  int32
+	}{
+		{
+			name:           "Success - No retry needed",
+			maxRetries:     3,
+			expectedStatus: http.StatusOK,
+			expectedCalls:  1,
+		},
+		{
+			name:           "Success - Retries 503 then succeeds",
+			maxRetries:     3,
+			codes:          []int{http.StatusServiceUnavailable, http.StatusBadGateway},
+			expectedStatus: http.StatusOK,
+			expectedCalls:  3,
+		},
+		{
+			name:           "Success - Retries 429",
+			maxRetries:     3,
+			codes:          []int{http.StatusTooManyRequests},
+			expectedStatus: http.StatusOK,
+			expectedCalls:  2,
+		},
+		{
+			name:           "Error - Gives up after max retries",
+			maxRetries:     2,
+			codes:          []int{http.StatusGatewayTimeout, http.StatusGatewayTimeout, http.StatusGatewayTimeout, http.StatusGatewayTimeout},
+			expectedStatus: http.StatusGatewayTimeout,
+			expectedCalls:  3,
+		},
+		{
+			name:           "Error - 500 is not retried",
+			maxRetries:     3,
+			codes:          []int{http.StatusInternalServerError},
+			expectedStatus: http.StatusInternalServerError,
+			expectedCalls:  1,
+		},
+		{
+			name:           "Success - POST retries 429 and 503",
+			method:         http.MethodPost,
+			maxRetries:     3,
+			codes:          []int{http.StatusTooManyRequests, http.StatusServiceUnavailable},
+			expectedStatus: http.StatusOK,
+			expectedCalls:  3,
+		},
+		{
+			name:           "Error - POST is not retried on 502",
+			method:         http.MethodPost,
+			maxRetries:     3,
+			codes:          []int{http.StatusBadGateway},
+			expectedStatus: http.StatusBadGateway,
+			expectedCalls:  1,
+		},
+		{
+			name:           "Error - DELETE is not retried on 504",
+			method:         http.MethodDelete,
+			maxRetries:     3,
+			codes:          []int{http.StatusGatewayTimeout},
+			expectedStatus: http.StatusGatewayTimeout,
+			expectedCalls:  1,
+		},
+		{
+			name:           "Error - Retries disabled",
+			maxRetries:     0,
+			codes:          []int{http.StatusServiceUnavailable},
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedCalls:  1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			srv, calls := statusSequenceServer(t, tc.codes...)
+			client := &http.Client{Transport: newTestRetryRoundTripper(tc.maxRetries)}
+
+			method := tc.method
+			if method == "" {
+				method = http.MethodGet
+			}
+			req, err := http.NewRequest(method, srv.URL, nil)
+			require.NoError(t, err)
+			resp, err := client.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+			assert.Equal(t, tc.expectedCalls, atomic.LoadInt32(calls))
+		})
+	}
+}
+
+func TestRetryRoundTripper_ReplaysBody(t *testing.T) {
+	srv, calls := statusSequenceServer(t, http.StatusServiceUnavailable)
+	client := &http.Client{Transport: newTestRetryRoundTripper(3)}
+
+	resp, err := client.Post(srv.URL, "application/json", strings.NewReader(`{"title":"retry"}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, `{"title":"retry"}`, string(body))
+	assert.Equal(t, int32(2), atomic.LoadInt32(calls))
+}
+
+func TestRetryDelay(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	backoff := 500 * time.Millisecond
+
+	tests := []struct {
+		name     string
+		status   int
+		headers  map[string]string
+		expected time.Duration
+	}{
+		{name: "503 uses backoff", status: http.StatusServiceUnavailable, headers: map[string]string{"Retry-After": "5"}, expected: backoff},
+		{name: "429 without headers uses backoff", status: http.StatusTooManyRequests, expected: backoff},
+		{name: "429 with Retry-After seconds", status: http.StatusTooManyRequests, headers: map[string]string{"Retry-After": "5"}, expected: 5 * time.Second},
+		{name: "429 with Retry-After date", status: http.StatusTooManyRequests, headers: map[string]string{"Retry-After": now.Add(3 * time.Second).Format(http.TimeFormat)}, expected: 3 * time.Second},
+		{name: "429 with RateLimit-Reset", status: http.StatusTooManyRequests, headers: map[string]string{"RateLimit-Reset": strconv.FormatInt(now.Add(7*time.Second).Unix(), 10)}, expected: 7 * time.Second},
+		{name: "Retry-After wins over RateLimit-Reset", status: http.StatusTooManyRequests, headers: map[string]string{"Retry-After": "2", "RateLimit-Reset": strconv.FormatInt(now.Add(7*time.Second).Unix(), 10)}, expected: 2 * time.Second},
+		{name: "Reset in the past does not wait", status: http.StatusTooManyRequests, headers: map[string]string{"RateLimit-Reset": strconv.FormatInt(now.Add(-time.Minute).Unix(), 10)}, expected: 0},
+		{name: "Wait is bounded", status: http.StatusTooManyRequests, headers: map[string]string{"Retry-After": "3600"}, expected: retryMaxRateLimitWait},
+		{name: "Unparsable headers use backoff", status: http.StatusTooManyRequests, headers: map[string]string{"Retry-After": "soon", "RateLimit-Reset": "later"}, expected: backoff},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: tc.status, Header: http.Header{}}
+			for k, v := range tc.headers {
+				resp.Header.Set(k, v)
+			}
+			assert.Equal(t, tc.expected, retryDelay(resp, backoff, now))
+		})
+	}
+}
+
+func TestRetryRoundTripper_ContextCancelled(t *testing.T) {
+	srv, calls := statusSequenceServer(t, http.StatusServiceUnavailable, http.StatusServiceUnavailable)
+	rt := newRetryRoundTripper(http.DefaultTransport, 3)
+	rt.initialBackoff = time.Hour
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	require.NoError(t, err)
+
+	_, err = (&http.Client{Transport: rt}).Do(req)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+}
+
+func TestNewClient_RetriesTransientErrors(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":1,"username":"root"}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClient("token", 1, gl.WithBaseURL(srv.URL))
+	require.NoError(t, err)
+
+	user, _, err := client.Users.CurrentUser()
+	require.NoError(t, err)
+	assert.Equal(t, "root", user.Username)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
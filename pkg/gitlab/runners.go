@@ -0,0 +1,285 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gl "gitlab.com/gitlab-org/api/client-go"
+)
+
+// applyRunnerScope maps the legacy runner scope onto the type, status and paused
+// filters, since GitLab deprecated the scope parameter in favour of those.
+func applyRunnerScope(opts *gl.ListRunnersOptions, scope string) {
+	switch scope {
+	case "active":
+		opts.Paused = gl.Ptr(false)
+	case "paused":
+		opts.Paused = gl.Ptr(true)
+	case "online", "offline":
+		opts.Status = &scope
+	case "instance_type", "group_type", "project_type":
+		opts.Type = &scope
+	}
+}
+
+// ListRunners defines the MCP tool for listing the runners available to the current user.
+func ListRunners(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listRunners",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_RUNNERS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Runners",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Optional filtering parameters
+			mcp.WithString("scope",
+				mcp.Description("Return only runners with this state or type."),
+				mcp.Enum("active", "paused", "online", "offline", "instance_type", "group_type", "project_type"),
+			),
+			mcp.WithString("tagList",
+				mcp.Description("Comma-separated list of runner tags; only runners with all of them are returned."),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse optional filtering parameters
+			scope, err := OptionalParam[string](&request, "scope")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			tagList, err := OptionalParam[string](&request, "tagList")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.ListRunnersOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+			}
+
+			applyRunnerScope(opts, scope)
+
+			if tags := ParseCommaSeparatedList(tagList); tags != nil {
+				opts.TagList = &tags
+			}
+
+			// --- Call GitLab API
+			runners, resp, err := glClient.Runners.ListRunners(opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, "runners")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(runners) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(runners)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal runners list: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// GetRunner defines the MCP tool for retrieving the details of a single runner.
+func GetRunner(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"getRunner",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_RUNNER_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "Get GitLab Runner",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithNumber("runnerId",
+				mcp.Description("The ID of the runner."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			runnerIDFloat, err := requiredParam[float64](&request, "runnerId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			runnerID := int64(runnerIDFloat)
+			if float64(runnerID) != runnerIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: runnerId %v is not a valid integer", runnerIDFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			runner, resp, err := glClient.Runners.GetRunnerDetails(runnerID, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("runner %d", runnerID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(runner)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal runner data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// EnableProjectRunner defines the MCP tool for assigning an existing runner to a project.
+func EnableProjectRunner(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"enableProjectRunner",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_ENABLE_PROJECT_RUNNER_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Enable GitLab Runner for Project",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("runnerId",
+				mcp.Description("The ID of the runner to enable."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			runnerIDFloat, err := requiredParam[float64](&request, "runnerId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			runnerID := int64(runnerIDFloat)
+			if float64(runnerID) != runnerIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: runnerId %v is not a valid integer", runnerIDFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			runner, resp, err := glClient.Runners.EnableProjectRunner(projectID, &gl.EnableProjectRunnerOptions{RunnerID: runnerID}, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("runner %d or project %q", runnerID, projectID), "enable runner")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(runner)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal runner data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// DisableProjectRunner defines the MCP tool for removing a runner from a project.
+func DisableProjectRunner(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"disableProjectRunner",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_DISABLE_PROJECT_RUNNER_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Disable GitLab Runner for Project",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("runnerId",
+				mcp.Description("The ID of the runner to disable."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			runnerIDFloat, err := requiredParam[float64](&request, "runnerId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			runnerID := int64(runnerIDFloat)
+			if float64(runnerID) != runnerIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: runnerId %v is not a valid integer", runnerIDFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			resp, err := glClient.Runners.DisableProjectRunner(projectID, runnerID, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("runner %d in project %q", runnerID, projectID), "disable runner")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf(`{"message":"Runner %d successfully disabled for project %q"}`, runnerID, projectID)), nil
+		}
+}
@@ -0,0 +1,264 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+func TestListRunnersHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListRunners(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockRunners, ctrl := setupMockClientForRunners(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListRunners(mockGetClient, nil)
+
+	tests := []struct {
+		name               string
+		inputArgs          map[string]any
+		mockSetup          func()
+		expectedText       string
+		expectHandlerError bool
+		expectResultError  bool
+		errorContains      string
+	}{
+		{
+			name:      "Success - Paused scope and tags",
+			inputArgs: map[string]any{"scope": "paused", "tagList": "docker, linux"},
+			mockSetup: func() {
+				mockRunners.EXPECT().
+					ListRunners(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(opts *gl.ListRunnersOptions, _ ...gl.RequestOptionFunc) ([]*gl.Runner, *gl.Response, error) {
+						assert.True(t, *opts.Paused)
+						assert.Nil(t, opts.Status)
+						assert.Nil(t, opts.Type)
+						assert.Equal(t, []string{"docker", "linux"}, *opts.TagList)
+						return []*gl.Runner{{ID: 8, Description: "docker runner", Paused: true}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+					})
+			},
+			expectedText: `"id":8`,
+		},
+		{
+			name:      "Success - Online scope maps to status",
+			inputArgs: map[string]any{"scope": "online"},
+			mockSetup: func() {
+				mockRunners.EXPECT().
+					ListRunners(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(opts *gl.ListRunnersOptions, _ ...gl.RequestOptionFunc) ([]*gl.Runner, *gl.Response, error) {
+						assert.Equal(t, "online", *opts.Status)
+						assert.Nil(t, opts.Paused)
+						return []*gl.Runner{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+					})
+			},
+			expectedText: "[]",
+		},
+		{
+			name:      "Success - Type scope maps to type",
+			inputArgs: map[string]any{"scope": "project_type"},
+			mockSetup: func() {
+				mockRunners.EXPECT().
+					ListRunners(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(opts *gl.ListRunnersOptions, _ ...gl.RequestOptionFunc) ([]*gl.Runner, *gl.Response, error) {
+						assert.Equal(t, "project_type", *opts.Type)
+						assert.Nil(t, opts.TagList)
+						return []*gl.Runner{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+					})
+			},
+			expectedText: "[]",
+		},
+		{
+			name:      "Error - Server error (500)",
+			inputArgs: map[string]any{},
+			mockSetup: func() {
+				mockRunners.EXPECT().
+					ListRunners(gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 500}}, errors.New("gitlab: 500 Internal Server Error"))
+			},
+			expectHandlerError: true,
+			errorContains:      "failed to list runners",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.inputArgs}})
+
+			if tc.expectHandlerError {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			textContent := getTextResult(t, result)
+			if tc.expectResultError {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.errorContains)
+				return
+			}
+			assert.Contains(t, textContent.Text, tc.expectedText)
+		})
+	}
+}
+
+func TestGetRunnerHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetRunner(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockRunners, ctrl := setupMockClientForRunners(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := GetRunner(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockRunners.EXPECT().
+			GetRunnerDetails(int64(8), gomock.Any()).
+			Return(&gl.RunnerDetails{ID: 8, Description: "docker runner", TagList: []string{"docker"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"runnerId": 8.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"tag_list":["docker"]`)
+	})
+
+	t.Run("Error - Non-integer runnerId", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"runnerId": 8.5,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: runnerId 8.5 is not a valid integer")
+	})
+
+	t.Run("Error - Not found (404)", func(t *testing.T) {
+		mockRunners.EXPECT().
+			GetRunnerDetails(int64(99), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"runnerId": 99.0,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "runner 99 not found or access denied (404)")
+	})
+}
+
+func TestEnableProjectRunnerHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := EnableProjectRunner(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockRunners, ctrl := setupMockClientForRunners(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := EnableProjectRunner(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockRunners.EXPECT().
+			EnableProjectRunner("group/project", &gl.EnableProjectRunnerOptions{RunnerID: 8}, gomock.Any()).
+			Return(&gl.Runner{ID: 8, Description: "docker runner"}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"runnerId":  8.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"id":8`)
+	})
+
+	t.Run("Error - Missing runnerId", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: runnerId")
+	})
+
+	t.Run("Error - Runner already enabled (400)", func(t *testing.T) {
+		mockRunners.EXPECT().
+			EnableProjectRunner("group/project", gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 400}}, errors.New("gitlab: 400 Runner has already been taken"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"runnerId":  8.0,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "failed to enable runner")
+	})
+}
+
+func TestDisableProjectRunnerHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := DisableProjectRunner(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockRunners, ctrl := setupMockClientForRunners(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := DisableProjectRunner(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockRunners.EXPECT().
+			DisableProjectRunner("group/project", int64(8), gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 204}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"runnerId":  8.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Runner 8 successfully disabled")
+	})
+
+	t.Run("Error - Not found (404)", func(t *testing.T) {
+		mockRunners.EXPECT().
+			DisableProjectRunner("group/project", int64(99), gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"runnerId":  99.0,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -11,12 +13,19 @@ import (
 	gl "gitlab.com/gitlab-org/api/client-go"
 )
 
+// searchOrderBy lists the order_by values accepted for each resourceType that can be ordered.
+var searchOrderBy = map[string][]string{
+	"projects": {"id", "name", "path", "created_at", "updated_at", "last_activity_at", "similarity"},
+	"issues":   {"created_at", "updated_at", "priority", "due_date", "relative_position", "label_priority", "milestone_due", "popularity", "weight"},
+}
+
 // Search defines the unified MCP tool for searching across GitLab resources.
 // This consolidates 19 previous search tools into one with resourceType and scope parameters.
 func Search(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool(
 			"search",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_SEARCH_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithString("resourceType",
 				mcp.Description("The type of resource to search for"),
 				mcp.Required(),
@@ -33,15 +42,21 @@ func Search(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler
 			mcp.WithString("gid",
 				mcp.Description("The ID or URL-encoded path of the group (required when scope='group')"),
 			),
-			mcp.WithString("pid",
+			mcp.WithString("projectId",
 				mcp.Description("The ID or URL-encoded path of the project (required when scope='project')"),
 			),
-			mcp.WithNumber("page",
-				mcp.Description("The page number to retrieve (default: 1)"),
+			mcp.WithString("pid",
+				mcp.Description("Deprecated: renamed to projectId, which takes precedence. Still accepted for backward compatibility."),
+			),
+			mcp.WithString("order_by",
+				mcp.Description(fmt.Sprintf("Order the results by this field (projects and issues only). Projects: %s. Issues: %s.",
+					strings.Join(searchOrderBy["projects"], ", "), strings.Join(searchOrderBy["issues"], ", "))),
 			),
-			mcp.WithNumber("per_page",
-				mcp.Description("The number of results per page (default: 20, max: 100)"),
+			mcp.WithString("sort",
+				mcp.Description("Sort order of the results (projects and issues only)"),
+				mcp.Enum("asc", "desc"),
 			),
+			WithPagination(),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        "Search GitLab Resources",
 				ReadOnlyHint: boolPtr(true),
@@ -78,17 +93,27 @@ func Search(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler
 				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
 			}
 
-			pid, err := OptionalParam[string](&req, "pid")
+			pid, err := OptionalParam[string](&req, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			// pid is the deprecated name of projectId
+			legacyPid, err := OptionalParam[string](&req, "pid")
 			if err != nil {
 				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
 			}
+			if pid == "" {
+				pid = legacyPid
+			} else if legacyPid != "" && legacyPid != pid {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: projectId %q and its deprecated alias pid %q differ", pid, legacyPid)), nil
+			}
 
 			// Validate scope-specific parameters
 			if scope == "group" && gid == "" {
 				return mcp.NewToolResultError("Validation Error: gid is required when scope='group'"), nil
 			}
 			if scope == "project" && pid == "" {
-				return mcp.NewToolResultError("Validation Error: pid is required when scope='project'"), nil
+				return mcp.NewToolResultError("Validation Error: projectId is required when scope='project'"), nil
 			}
 
 			page, perPage, err := OptionalPaginationParams(&req)
@@ -96,10 +121,34 @@ func Search(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler
 				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
 			}
 
+			// Parse ordering parameters, which only projects and issues support
+			orderBy, err := OptionalParam[string](&req, "order_by")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			sort, err := OptionalParam[string](&req, "sort")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			if orderBy != "" || sort != "" {
+				allowedOrderBy, ok := searchOrderBy[resourceType]
+				if !ok {
+					return mcp.NewToolResultError(fmt.Sprintf("Validation Error: order_by and sort are only supported for projects and issues, not '%s'", resourceType)), nil
+				}
+				if orderBy != "" && !slices.Contains(allowedOrderBy, orderBy) {
+					return mcp.NewToolResultError(fmt.Sprintf("Validation Error: order_by for %s must be one of %s, got '%s'", resourceType, strings.Join(allowedOrderBy, ", "), orderBy)), nil
+				}
+				if sort != "" && sort != "asc" && sort != "desc" {
+					return mcp.NewToolResultError(fmt.Sprintf("Validation Error: sort must be 'asc' or 'desc', got '%s'", sort)), nil
+				}
+			}
+
 			opts := &gl.SearchOptions{
 				ListOptions: gl.ListOptions{
 					Page:    int64(page),
 					PerPage: int64(perPage),
+					OrderBy: orderBy,
+					Sort:    sort,
 				},
 			}
 
@@ -247,3 +296,118 @@ func Search(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler
 			return mcp.NewToolResultText(string(jsonData)), nil
 		}
 }
+
+// maskPrivateUserFields clears the fields of a user that only administrators should see.
+func maskPrivateUserFields(user *gl.User) {
+	user.Email = ""
+	user.CurrentSignInIP = nil
+	user.LastSignInIP = nil
+	user.Identities = nil
+	user.ExternUID = ""
+	user.Note = ""
+}
+
+// SearchUsers defines the MCP tool for searching all users of the GitLab instance.
+// Private fields such as email addresses are cleared unless the caller is an administrator.
+func SearchUsers(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"searchUsers",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_SEARCH_USERS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithString("search",
+				mcp.Description("Search users by username, name or email"),
+				mcp.Required(),
+			),
+			mcp.WithBoolean("active",
+				mcp.Description("Return only active users"),
+			),
+			mcp.WithBoolean("blocked",
+				mcp.Description("Return only blocked users"),
+			),
+			WithPagination(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "Search GitLab Users",
+				ReadOnlyHint: boolPtr(true),
+			}),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// Parse required parameters
+			searchQuery, err := requiredParam[string](&req, "search")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// Parse optional parameters
+			active, err := OptionalBoolParam(&req, "active")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			blocked, err := OptionalBoolParam(&req, "blocked")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			page, perPage, err := OptionalPaginationParams(&req)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// The caller's admin status decides whether private fields are returned
+			currentUser, resp, err := client.Users.CurrentUser(gl.WithContext(ctx))
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, "current user")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			opts := &gl.ListUsersOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+				Search:  &searchQuery,
+				Active:  active,
+				Blocked: blocked,
+			}
+
+			users, resp, err := client.Users.ListUsers(opts, gl.WithContext(ctx))
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, "users")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// Handle empty results
+			if len(users) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			if !currentUser.IsAdmin {
+				for _, user := range users {
+					maskPrivateUserFields(user)
+				}
+			}
+
+			// --- Truncate long text fields
+			truncator := NewTextTruncator(MaxFieldLength)
+			truncatedUsers, err := truncator.TruncateListResponse(users, UserFields)
+			if err != nil {
+				return nil, fmt.Errorf("failed to truncate users: %w", err)
+			}
+
+			jsonData, err := json.Marshal(truncatedUsers)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal users data: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(jsonData)), nil
+		}
+}
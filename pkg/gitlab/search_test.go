@@ -1207,18 +1207,18 @@ func TestSearchIssuesByProjectHandler(t *testing.T) {
 	}{
 		{
 			name: "Success",
-			args: map[string]any{"resourceType": "issues", "scope": "project", "pid": "myproject", "search": "bug"},
+			args: map[string]any{"resourceType": "issues", "scope": "project", "projectId": "myproject", "search": "bug"},
 			mockSetup: func() {
 				mockSearch.EXPECT().IssuesByProject("myproject", "bug", gomock.Any(), gomock.Any()).
 					Return([]*gl.Issue{{ID: 1, IID: 1, Title: "Bug"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
 			},
 		},
 		{
-			name:              "Error - Missing pid",
+			name:              "Error - Missing projectId",
 			args:              map[string]any{"resourceType": "issues", "scope": "project", "search": "bug"},
 			mockSetup:         func() {},
 			expectResultError: true,
-			errorContains:     "pid is required when scope='project'",
+			errorContains:     "projectId is required when scope='project'",
 		},
 		{
 			name:              "Error - Missing search",
@@ -1229,7 +1229,7 @@ func TestSearchIssuesByProjectHandler(t *testing.T) {
 		},
 		{
 			name: "Error - 401",
-			args: map[string]any{"resourceType": "issues", "scope": "project", "pid": "myproject", "search": "bug"},
+			args: map[string]any{"resourceType": "issues", "scope": "project", "projectId": "myproject", "search": "bug"},
 			mockSetup: func() {
 				mockSearch.EXPECT().IssuesByProject(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, &gl.Response{Response: &http.Response{StatusCode: 401}}, errors.New("unauthorized"))
 			},
@@ -1238,7 +1238,7 @@ func TestSearchIssuesByProjectHandler(t *testing.T) {
 		},
 		{
 			name: "Error - 500",
-			args: map[string]any{"resourceType": "issues", "scope": "project", "pid": "myproject", "search": "bug"},
+			args: map[string]any{"resourceType": "issues", "scope": "project", "projectId": "myproject", "search": "bug"},
 			mockSetup: func() {
 				mockSearch.EXPECT().IssuesByProject(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, &gl.Response{Response: &http.Response{StatusCode: 500}}, errors.New("server error"))
 			},
@@ -1284,18 +1284,18 @@ func TestSearchMergeRequestsByProjectHandler(t *testing.T) {
 	}{
 		{
 			name: "Success",
-			args: map[string]any{"resourceType": "merge_requests", "scope": "project", "pid": "myproject", "search": "feature"},
+			args: map[string]any{"resourceType": "merge_requests", "scope": "project", "projectId": "myproject", "search": "feature"},
 			mockSetup: func() {
 				mockSearch.EXPECT().MergeRequestsByProject("myproject", "feature", gomock.Any(), gomock.Any()).
 					Return([]*gl.MergeRequest{{BasicMergeRequest: gl.BasicMergeRequest{ID: 1, IID: 1, Title: "Feature"}}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
 			},
 		},
 		{
-			name:              "Error - Missing pid",
+			name:              "Error - Missing projectId",
 			args:              map[string]any{"resourceType": "merge_requests", "scope": "project", "search": "feature"},
 			mockSetup:         func() {},
 			expectResultError: true,
-			errorContains:     "pid is required when scope='project'",
+			errorContains:     "projectId is required when scope='project'",
 		},
 		{
 			name:              "Error - Missing search",
@@ -1306,7 +1306,7 @@ func TestSearchMergeRequestsByProjectHandler(t *testing.T) {
 		},
 		{
 			name: "Error - 401",
-			args: map[string]any{"resourceType": "merge_requests", "scope": "project", "pid": "myproject", "search": "feature"},
+			args: map[string]any{"resourceType": "merge_requests", "scope": "project", "projectId": "myproject", "search": "feature"},
 			mockSetup: func() {
 				mockSearch.EXPECT().MergeRequestsByProject(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, &gl.Response{Response: &http.Response{StatusCode: 401}}, errors.New("unauthorized"))
 			},
@@ -1315,7 +1315,7 @@ func TestSearchMergeRequestsByProjectHandler(t *testing.T) {
 		},
 		{
 			name: "Error - 500",
-			args: map[string]any{"resourceType": "merge_requests", "scope": "project", "pid": "myproject", "search": "feature"},
+			args: map[string]any{"resourceType": "merge_requests", "scope": "project", "projectId": "myproject", "search": "feature"},
 			mockSetup: func() {
 				mockSearch.EXPECT().MergeRequestsByProject(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, &gl.Response{Response: &http.Response{StatusCode: 500}}, errors.New("server error"))
 			},
@@ -1361,18 +1361,18 @@ func TestSearchMilestonesByProjectHandler(t *testing.T) {
 	}{
 		{
 			name: "Success",
-			args: map[string]any{"resourceType": "milestones", "scope": "project", "pid": "myproject", "search": "v1.0"},
+			args: map[string]any{"resourceType": "milestones", "scope": "project", "projectId": "myproject", "search": "v1.0"},
 			mockSetup: func() {
 				mockSearch.EXPECT().MilestonesByProject("myproject", "v1.0", gomock.Any(), gomock.Any()).
 					Return([]*gl.Milestone{{ID: 1, Title: "v1.0"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
 			},
 		},
 		{
-			name:              "Error - Missing pid",
+			name:              "Error - Missing projectId",
 			args:              map[string]any{"resourceType": "milestones", "scope": "project", "search": "v1.0"},
 			mockSetup:         func() {},
 			expectResultError: true,
-			errorContains:     "pid is required when scope='project'",
+			errorContains:     "projectId is required when scope='project'",
 		},
 		{
 			name:              "Error - Missing search",
@@ -1383,7 +1383,7 @@ func TestSearchMilestonesByProjectHandler(t *testing.T) {
 		},
 		{
 			name: "Error - 401",
-			args: map[string]any{"resourceType": "milestones", "scope": "project", "pid": "myproject", "search": "v1.0"},
+			args: map[string]any{"resourceType": "milestones", "scope": "project", "projectId": "myproject", "search": "v1.0"},
 			mockSetup: func() {
 				mockSearch.EXPECT().MilestonesByProject(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, &gl.Response{Response: &http.Response{StatusCode: 401}}, errors.New("unauthorized"))
 			},
@@ -1392,7 +1392,7 @@ func TestSearchMilestonesByProjectHandler(t *testing.T) {
 		},
 		{
 			name: "Error - 500",
-			args: map[string]any{"resourceType": "milestones", "scope": "project", "pid": "myproject", "search": "v1.0"},
+			args: map[string]any{"resourceType": "milestones", "scope": "project", "projectId": "myproject", "search": "v1.0"},
 			mockSetup: func() {
 				mockSearch.EXPECT().MilestonesByProject(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, &gl.Response{Response: &http.Response{StatusCode: 500}}, errors.New("server error"))
 			},
@@ -1438,18 +1438,18 @@ func TestSearchBlobsByProjectHandler(t *testing.T) {
 	}{
 		{
 			name: "Success",
-			args: map[string]any{"resourceType": "blobs", "scope": "project", "pid": "myproject", "search": "function"},
+			args: map[string]any{"resourceType": "blobs", "scope": "project", "projectId": "myproject", "search": "function"},
 			mockSetup: func() {
 				mockSearch.EXPECT().BlobsByProject("myproject", "function", gomock.Any(), gomock.Any()).
 					Return([]*gl.Blob{{Filename: "test.go"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
 			},
 		},
 		{
-			name:              "Error - Missing pid",
+			name:              "Error - Missing projectId",
 			args:              map[string]any{"resourceType": "blobs", "scope": "project", "search": "function"},
 			mockSetup:         func() {},
 			expectResultError: true,
-			errorContains:     "pid is required when scope='project'",
+			errorContains:     "projectId is required when scope='project'",
 		},
 		{
 			name:              "Error - Missing search",
@@ -1460,7 +1460,7 @@ func TestSearchBlobsByProjectHandler(t *testing.T) {
 		},
 		{
 			name: "Error - 401",
-			args: map[string]any{"resourceType": "blobs", "scope": "project", "pid": "myproject", "search": "function"},
+			args: map[string]any{"resourceType": "blobs", "scope": "project", "projectId": "myproject", "search": "function"},
 			mockSetup: func() {
 				mockSearch.EXPECT().BlobsByProject(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, &gl.Response{Response: &http.Response{StatusCode: 401}}, errors.New("unauthorized"))
 			},
@@ -1469,7 +1469,7 @@ func TestSearchBlobsByProjectHandler(t *testing.T) {
 		},
 		{
 			name: "Error - 500",
-			args: map[string]any{"resourceType": "blobs", "scope": "project", "pid": "myproject", "search": "function"},
+			args: map[string]any{"resourceType": "blobs", "scope": "project", "projectId": "myproject", "search": "function"},
 			mockSetup: func() {
 				mockSearch.EXPECT().BlobsByProject(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, &gl.Response{Response: &http.Response{StatusCode: 500}}, errors.New("server error"))
 			},
@@ -1515,6 +1515,14 @@ func TestSearchCommitsByProjectHandler(t *testing.T) {
 	}{
 		{
 			name: "Success",
+			args: map[string]any{"resourceType": "commits", "scope": "project", "projectId": "myproject", "search": "fix"},
+			mockSetup: func() {
+				mockSearch.EXPECT().CommitsByProject("myproject", "fix", gomock.Any(), gomock.Any()).
+					Return([]*gl.Commit{{ID: "abc123", Message: "Fix bug"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+		},
+		{
+			name: "Success - Deprecated pid alias",
 			args: map[string]any{"resourceType": "commits", "scope": "project", "pid": "myproject", "search": "fix"},
 			mockSetup: func() {
 				mockSearch.EXPECT().CommitsByProject("myproject", "fix", gomock.Any(), gomock.Any()).
@@ -1522,11 +1530,26 @@ func TestSearchCommitsByProjectHandler(t *testing.T) {
 			},
 		},
 		{
-			name:              "Error - Missing pid",
+			name: "Success - Same projectId and pid",
+			args: map[string]any{"resourceType": "commits", "scope": "project", "projectId": "myproject", "pid": "myproject", "search": "fix"},
+			mockSetup: func() {
+				mockSearch.EXPECT().CommitsByProject("myproject", "fix", gomock.Any(), gomock.Any()).
+					Return([]*gl.Commit{{ID: "abc123", Message: "Fix bug"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+		},
+		{
+			name:              "Error - projectId and pid differ",
+			args:              map[string]any{"resourceType": "commits", "scope": "project", "projectId": "myproject", "pid": "other", "search": "fix"},
+			mockSetup:         func() {},
+			expectResultError: true,
+			errorContains:     `projectId "myproject" and its deprecated alias pid "other" differ`,
+		},
+		{
+			name:              "Error - Missing projectId",
 			args:              map[string]any{"resourceType": "commits", "scope": "project", "search": "fix"},
 			mockSetup:         func() {},
 			expectResultError: true,
-			errorContains:     "pid is required when scope='project'",
+			errorContains:     "projectId is required when scope='project'",
 		},
 		{
 			name:              "Error - Missing search",
@@ -1537,7 +1560,7 @@ func TestSearchCommitsByProjectHandler(t *testing.T) {
 		},
 		{
 			name: "Error - 401",
-			args: map[string]any{"resourceType": "commits", "scope": "project", "pid": "myproject", "search": "fix"},
+			args: map[string]any{"resourceType": "commits", "scope": "project", "projectId": "myproject", "search": "fix"},
 			mockSetup: func() {
 				mockSearch.EXPECT().CommitsByProject(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, &gl.Response{Response: &http.Response{StatusCode: 401}}, errors.New("unauthorized"))
 			},
@@ -1546,7 +1569,7 @@ func TestSearchCommitsByProjectHandler(t *testing.T) {
 		},
 		{
 			name: "Error - 500",
-			args: map[string]any{"resourceType": "commits", "scope": "project", "pid": "myproject", "search": "fix"},
+			args: map[string]any{"resourceType": "commits", "scope": "project", "projectId": "myproject", "search": "fix"},
 			mockSetup: func() {
 				mockSearch.EXPECT().CommitsByProject(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, &gl.Response{Response: &http.Response{StatusCode: 500}}, errors.New("server error"))
 			},
@@ -1819,18 +1842,18 @@ func TestSearchNotesByProjectHandler(t *testing.T) {
 	}{
 		{
 			name: "Success",
-			args: map[string]any{"resourceType": "notes", "scope": "project", "pid": "myproject", "search": "comment"},
+			args: map[string]any{"resourceType": "notes", "scope": "project", "projectId": "myproject", "search": "comment"},
 			mockSetup: func() {
 				mockSearch.EXPECT().NotesByProject("myproject", "comment", gomock.Any(), gomock.Any()).
 					Return([]*gl.Note{{ID: 1, Body: "Great comment"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
 			},
 		},
 		{
-			name:              "Error - Missing pid",
+			name:              "Error - Missing projectId",
 			args:              map[string]any{"resourceType": "notes", "scope": "project", "search": "comment"},
 			mockSetup:         func() {},
 			expectResultError: true,
-			errorContains:     "pid is required when scope='project'",
+			errorContains:     "projectId is required when scope='project'",
 		},
 		{
 			name:              "Error - Missing search",
@@ -1841,7 +1864,7 @@ func TestSearchNotesByProjectHandler(t *testing.T) {
 		},
 		{
 			name: "Error - 401",
-			args: map[string]any{"resourceType": "notes", "scope": "project", "pid": "myproject", "search": "comment"},
+			args: map[string]any{"resourceType": "notes", "scope": "project", "projectId": "myproject", "search": "comment"},
 			mockSetup: func() {
 				mockSearch.EXPECT().NotesByProject(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, &gl.Response{Response: &http.Response{StatusCode: 401}}, errors.New("unauthorized"))
 			},
@@ -1850,7 +1873,7 @@ func TestSearchNotesByProjectHandler(t *testing.T) {
 		},
 		{
 			name: "Error - 500",
-			args: map[string]any{"resourceType": "notes", "scope": "project", "pid": "myproject", "search": "comment"},
+			args: map[string]any{"resourceType": "notes", "scope": "project", "projectId": "myproject", "search": "comment"},
 			mockSetup: func() {
 				mockSearch.EXPECT().NotesByProject(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, &gl.Response{Response: &http.Response{StatusCode: 500}}, errors.New("server error"))
 			},
@@ -1880,6 +1903,179 @@ func TestSearchNotesByProjectHandler(t *testing.T) {
 }
 
 // TestSearchTools_SchemaSnapshots verifies that search tool schemas match their snapshots
+// TestSearchHandler_Pagination checks that page and per_page reach every search endpoint.
+func TestSearchHandler_Pagination(t *testing.T) {
+	ctx := context.Background()
+	mockClient, mockSearch, ctrl := setupMockClientForSearch(t)
+	defer ctrl.Finish()
+	mockGetClient := func(_ context.Context) (*gl.Client, error) { return mockClient, nil }
+	_, handler := Search(mockGetClient, nil)
+
+	okResp := &gl.Response{Response: &http.Response{StatusCode: 200}}
+	paged := &gl.SearchOptions{ListOptions: gl.ListOptions{Page: 3, PerPage: 50}}
+
+	tests := []struct {
+		resourceType string
+		scope        string
+		expect       func()
+	}{
+		{"projects", "global", func() { mockSearch.EXPECT().Projects("q", paged, gomock.Any()).Return([]*gl.Project{}, okResp, nil) }},
+		{"projects", "group", func() {
+			mockSearch.EXPECT().ProjectsByGroup("g", "q", paged, gomock.Any()).Return([]*gl.Project{}, okResp, nil)
+		}},
+		{"issues", "global", func() { mockSearch.EXPECT().Issues("q", paged, gomock.Any()).Return([]*gl.Issue{}, okResp, nil) }},
+		{"issues", "group", func() {
+			mockSearch.EXPECT().IssuesByGroup("g", "q", paged, gomock.Any()).Return([]*gl.Issue{}, okResp, nil)
+		}},
+		{"issues", "project", func() {
+			mockSearch.EXPECT().IssuesByProject("p", "q", paged, gomock.Any()).Return([]*gl.Issue{}, okResp, nil)
+		}},
+		{"merge_requests", "global", func() {
+			mockSearch.EXPECT().MergeRequests("q", paged, gomock.Any()).Return([]*gl.MergeRequest{}, okResp, nil)
+		}},
+		{"merge_requests", "group", func() {
+			mockSearch.EXPECT().MergeRequestsByGroup("g", "q", paged, gomock.Any()).Return([]*gl.MergeRequest{}, okResp, nil)
+		}},
+		{"merge_requests", "project", func() {
+			mockSearch.EXPECT().MergeRequestsByProject("p", "q", paged, gomock.Any()).Return([]*gl.MergeRequest{}, okResp, nil)
+		}},
+		{"blobs", "global", func() { mockSearch.EXPECT().Blobs("q", paged, gomock.Any()).Return([]*gl.Blob{}, okResp, nil) }},
+		{"blobs", "group", func() {
+			mockSearch.EXPECT().BlobsByGroup("g", "q", paged, gomock.Any()).Return([]*gl.Blob{}, okResp, nil)
+		}},
+		{"blobs", "project", func() {
+			mockSearch.EXPECT().BlobsByProject("p", "q", paged, gomock.Any()).Return([]*gl.Blob{}, okResp, nil)
+		}},
+		{"commits", "global", func() { mockSearch.EXPECT().Commits("q", paged, gomock.Any()).Return([]*gl.Commit{}, okResp, nil) }},
+		{"commits", "project", func() {
+			mockSearch.EXPECT().CommitsByProject("p", "q", paged, gomock.Any()).Return([]*gl.Commit{}, okResp, nil)
+		}},
+		{"milestones", "global", func() {
+			mockSearch.EXPECT().Milestones("q", paged, gomock.Any()).Return([]*gl.Milestone{}, okResp, nil)
+		}},
+		{"milestones", "group", func() {
+			mockSearch.EXPECT().MilestonesByGroup("g", "q", paged, gomock.Any()).Return([]*gl.Milestone{}, okResp, nil)
+		}},
+		{"milestones", "project", func() {
+			mockSearch.EXPECT().MilestonesByProject("p", "q", paged, gomock.Any()).Return([]*gl.Milestone{}, okResp, nil)
+		}},
+		{"snippet_titles", "global", func() {
+			mockSearch.EXPECT().SnippetTitles("q", paged, gomock.Any()).Return([]*gl.Snippet{}, okResp, nil)
+		}},
+		{"wiki_blobs", "global", func() { mockSearch.EXPECT().WikiBlobs("q", paged, gomock.Any()).Return([]*gl.Wiki{}, okResp, nil) }},
+		{"notes", "project", func() {
+			mockSearch.EXPECT().NotesByProject("p", "q", paged, gomock.Any()).Return([]*gl.Note{}, okResp, nil)
+		}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.resourceType+"/"+tc.scope, func(t *testing.T) {
+			tc.expect()
+			result, err := handler(ctx, *createMCPRequest(map[string]any{
+				"resourceType": tc.resourceType,
+				"scope":        tc.scope,
+				"search":       "q",
+				"gid":          "g",
+				"projectId":    "p",
+				"page":         3.0,
+				"per_page":     50.0,
+			}))
+			require.NoError(t, err)
+			assert.False(t, result.IsError)
+		})
+	}
+
+	t.Run("Defaults and cap", func(t *testing.T) {
+		mockSearch.EXPECT().Projects("q", &gl.SearchOptions{ListOptions: gl.ListOptions{Page: 1, PerPage: DefaultPerPage}}, gomock.Any()).Return([]*gl.Project{}, okResp, nil)
+		mockSearch.EXPECT().Projects("q", &gl.SearchOptions{ListOptions: gl.ListOptions{Page: 1, PerPage: MaxPerPage}}, gomock.Any()).Return([]*gl.Project{}, okResp, nil)
+
+		_, err := handler(ctx, *createMCPRequest(map[string]any{"resourceType": "projects", "search": "q"}))
+		require.NoError(t, err)
+		_, err = handler(ctx, *createMCPRequest(map[string]any{"resourceType": "projects", "search": "q", "per_page": 500.0}))
+		require.NoError(t, err)
+	})
+}
+
+// TestSearchHandler_Ordering checks the order_by and sort parameters of project and issue searches.
+func TestSearchHandler_Ordering(t *testing.T) {
+	ctx := context.Background()
+	mockClient, mockSearch, ctrl := setupMockClientForSearch(t)
+	defer ctrl.Finish()
+	mockGetClient := func(_ context.Context) (*gl.Client, error) { return mockClient, nil }
+	_, handler := Search(mockGetClient, nil)
+
+	okResp := &gl.Response{Response: &http.Response{StatusCode: 200}}
+	ordered := func(orderBy, sort string) *gl.SearchOptions {
+		return &gl.SearchOptions{ListOptions: gl.ListOptions{Page: 1, PerPage: DefaultPerPage, OrderBy: orderBy, Sort: sort}}
+	}
+
+	t.Run("Success - Projects ordered by similarity", func(t *testing.T) {
+		mockSearch.EXPECT().Projects("q", ordered("similarity", "desc"), gomock.Any()).Return([]*gl.Project{}, okResp, nil)
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{
+			"resourceType": "projects", "search": "q", "order_by": "similarity", "sort": "desc",
+		}))
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	t.Run("Success - Group issues ordered by due date", func(t *testing.T) {
+		mockSearch.EXPECT().IssuesByGroup("g", "q", ordered("due_date", ""), gomock.Any()).Return([]*gl.Issue{}, okResp, nil)
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{
+			"resourceType": "issues", "scope": "group", "gid": "g", "search": "q", "order_by": "due_date",
+		}))
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	t.Run("Success - Issues sorted without order_by", func(t *testing.T) {
+		mockSearch.EXPECT().Issues("q", ordered("", "asc"), gomock.Any()).Return([]*gl.Issue{}, okResp, nil)
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{
+			"resourceType": "issues", "search": "q", "sort": "asc",
+		}))
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	validationTests := []struct {
+		name          string
+		args          map[string]any
+		errorContains string
+	}{
+		{
+			name:          "Issue order_by for projects",
+			args:          map[string]any{"resourceType": "projects", "search": "q", "order_by": "weight"},
+			errorContains: "order_by for projects must be one of id, name, path, created_at, updated_at, last_activity_at, similarity, got 'weight'",
+		},
+		{
+			name:          "Project order_by for issues",
+			args:          map[string]any{"resourceType": "issues", "search": "q", "order_by": "similarity"},
+			errorContains: "order_by for issues must be one of",
+		},
+		{
+			name:          "Invalid sort",
+			args:          map[string]any{"resourceType": "issues", "search": "q", "sort": "up"},
+			errorContains: "sort must be 'asc' or 'desc', got 'up'",
+		},
+		{
+			name:          "Ordering unsupported resource type",
+			args:          map[string]any{"resourceType": "commits", "search": "q", "sort": "asc"},
+			errorContains: "order_by and sort are only supported for projects and issues, not 'commits'",
+		},
+	}
+
+	for _, tc := range validationTests {
+		t.Run("Error - "+tc.name, func(t *testing.T) {
+			result, err := handler(ctx, *createMCPRequest(tc.args))
+			require.NoError(t, err)
+			assert.True(t, result.IsError)
+			assert.Contains(t, getTextResult(t, result).Text, "Validation Error: "+tc.errorContains)
+		})
+	}
+}
+
 func TestSearchTools_SchemaSnapshots(t *testing.T) {
 	tools := []struct {
 		name string
@@ -1901,3 +2097,88 @@ func mustMakeSearchTool(fn func(GetClientFn, map[string]string) (mcp.Tool, serve
 	tool, _ := fn(nil, nil)
 	return tool
 }
+
+func TestSearchUsersHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := SearchUsers(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockUsers, ctrl := setupMockClientForUsers(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := SearchUsers(mockGetClient, nil)
+
+	okResp := &gl.Response{Response: &http.Response{StatusCode: 200}}
+	foundUsers := func() []*gl.User {
+		return []*gl.User{{ID: 7, Username: "alice", Email: "alice@example.com", PublicEmail: "alice@public.example.com", Note: "admin note"}}
+	}
+
+	t.Run("Success - Non-admin caller gets masked users", func(t *testing.T) {
+		mockUsers.EXPECT().CurrentUser(gomock.Any()).Return(&gl.User{ID: 1, IsAdmin: false}, okResp, nil)
+		mockUsers.EXPECT().
+			ListUsers(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(opts *gl.ListUsersOptions, _ ...gl.RequestOptionFunc) ([]*gl.User, *gl.Response, error) {
+				assert.Equal(t, "alice", *opts.Search)
+				assert.True(t, *opts.Active)
+				assert.Nil(t, opts.Blocked)
+				assert.Equal(t, int64(2), opts.Page)
+				return foundUsers(), okResp, nil
+			})
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{
+			"search": "alice",
+			"active": true,
+			"page":   2.0,
+		}))
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+
+		var users []*gl.User
+		require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &users))
+		require.Len(t, users, 1)
+		assert.Equal(t, "alice", users[0].Username)
+		assert.Empty(t, users[0].Email)
+		assert.Empty(t, users[0].Note)
+		assert.Equal(t, "alice@public.example.com", users[0].PublicEmail)
+	})
+
+	t.Run("Success - Admin caller gets emails", func(t *testing.T) {
+		mockUsers.EXPECT().CurrentUser(gomock.Any()).Return(&gl.User{ID: 1, IsAdmin: true}, okResp, nil)
+		mockUsers.EXPECT().ListUsers(gomock.Any(), gomock.Any()).Return(foundUsers(), okResp, nil)
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{"search": "alice"}))
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"email":"alice@example.com"`)
+	})
+
+	t.Run("Success - No users", func(t *testing.T) {
+		mockUsers.EXPECT().CurrentUser(gomock.Any()).Return(&gl.User{ID: 1}, okResp, nil)
+		mockUsers.EXPECT().ListUsers(gomock.Any(), gomock.Any()).Return([]*gl.User{}, okResp, nil)
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{"search": "nobody"}))
+		require.NoError(t, err)
+		assert.Equal(t, "[]", getTextResult(t, result).Text)
+	})
+
+	t.Run("Error - Unauthorized", func(t *testing.T) {
+		mockUsers.EXPECT().CurrentUser(gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 401}}, errors.New("gitlab: 401 Unauthorized"))
+
+		result, err := handler(ctx, *createMCPRequest(map[string]any{"search": "alice"}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "Authentication failed (401)")
+	})
+
+	t.Run("Error - Missing search", func(t *testing.T) {
+		result, err := handler(ctx, *createMCPRequest(map[string]any{}))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: search")
+	})
+}
@@ -244,6 +244,7 @@ func GetProjectSAST(getClient GetClientFn, t map[string]string) (tool mcp.Tool,
 	return mcp.NewTool(
 			"getProjectSAST",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_PROJECT_SAST_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithString("pid",
 				mcp.Description("The ID or URL-encoded path of the project"),
 				mcp.Required(),
@@ -262,6 +263,7 @@ func GetProjectDAST(getClient GetClientFn, t map[string]string) (tool mcp.Tool,
 	return mcp.NewTool(
 			"getProjectDAST",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_PROJECT_DAST_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithString("pid",
 				mcp.Description("The ID or URL-encoded path of the project"),
 				mcp.Required(),
@@ -280,6 +282,7 @@ func GetProjectDependencyScanning(getClient GetClientFn, t map[string]string) (t
 	return mcp.NewTool(
 			"getProjectDependencyScanning",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_PROJECT_DEPENDENCY_SCANNING_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithString("pid",
 				mcp.Description("The ID or URL-encoded path of the project"),
 				mcp.Required(),
@@ -298,6 +301,7 @@ func GetProjectContainerScanning(getClient GetClientFn, t map[string]string) (to
 	return mcp.NewTool(
 			"getProjectContainerScanning",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_PROJECT_CONTAINER_SCANNING_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithString("pid",
 				mcp.Description("The ID or URL-encoded path of the project"),
 				mcp.Required(),
@@ -316,6 +320,7 @@ func GetProjectSecretDetection(getClient GetClientFn, t map[string]string) (tool
 	return mcp.NewTool(
 			"getProjectSecretDetection",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_PROJECT_SECRET_DETECTION_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithString("pid",
 				mcp.Description("The ID or URL-encoded path of the project"),
 				mcp.Required(),
@@ -334,6 +339,7 @@ func GetProjectLicenseCompliance(getClient GetClientFn, t map[string]string) (to
 	return mcp.NewTool(
 			"getProjectLicenseCompliance",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_PROJECT_LICENSE_COMPLIANCE_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithString("pid",
 				mcp.Description("The ID or URL-encoded path of the project"),
 				mcp.Required(),
@@ -1,14 +1,22 @@
 package gitlab
 
 import (
-	// Added for potential future use in handlers
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/toolsets"
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
+	log "github.com/sirupsen/logrus"
+	gl "gitlab.com/gitlab-org/api/client-go"
 )
 
 // DefaultPerPage defines the default number of items per page for pagination.
@@ -17,8 +25,15 @@ const DefaultPerPage = 20
 // MaxPerPage defines the maximum number of items per page allowed by GitLab.
 const MaxPerPage = 100
 
+// DefaultRequestTimeout is the default upper bound for a single tool invocation.
+const DefaultRequestTimeout = 30 * time.Second
+
+// DefaultRateLimitThreshold is the default number of remaining requests below which a warning is logged.
+const DefaultRateLimitThreshold = 10
+
 // NewServer creates a new MCP server instance with default options suitable for GitLab.
-func NewServer(appName, appVersion string) *server.MCPServer {
+// Additional options (e.g. WithRequestTimeout) are appended to the defaults.
+func NewServer(appName, appVersion string, extraOpts ...server.ServerOption) *server.MCPServer {
 	// Configure default server options here if needed
 	opts := []server.ServerOption{
 		// Add server options similar to github-mcp-server if needed
@@ -26,9 +41,103 @@ func NewServer(appName, appVersion string) *server.MCPServer {
 		server.WithResourceCapabilities(true, true), // Assuming these exist and are desired
 		server.WithLogging(),                        // Assuming this exists
 	}
+	opts = append(opts, extraOpts...)
 	return server.NewMCPServer(appName, appVersion, opts...)
 }
 
+// WithRequestTimeout bounds every tool invocation with the given timeout. Handlers pass
+// their context to the GitLab client via gl.WithContext, so a slow API call is cancelled
+// once the timeout elapses. A non-positive timeout disables the limit.
+func WithRequestTimeout(timeout time.Duration) server.ServerOption {
+	return server.WithToolHandlerMiddleware(requestTimeoutMiddleware(timeout))
+}
+
+// requestTimeoutMiddleware wraps the context of each tool call with context.WithTimeout.
+func requestTimeoutMiddleware(timeout time.Duration) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		if timeout <= 0 {
+			return next
+		}
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			return next(ctx, request)
+		}
+	}
+}
+
+// ServerInfo describes the running server instance for the getServerMetadata tool.
+type ServerInfo struct {
+	Version  string
+	Commit   string
+	ReadOnly bool
+	Metrics  *Metrics // per-tool call metrics; omitted from the output when nil
+}
+
+// serverMetadata is the JSON document returned by getServerMetadata.
+type serverMetadata struct {
+	Version               string                 `json:"version"`
+	Commit                string                 `json:"commit"`
+	GitLabHost            string                 `json:"gitlabHost"`
+	AuthenticatedUsername string                 `json:"authenticatedUsername"`
+	TokenExpiresAt        *time.Time             `json:"tokenExpiresAt"`
+	TokenDaysUntilExpiry  *int                   `json:"tokenDaysUntilExpiry"`
+	EnabledToolsets       []string               `json:"enabledToolsets"`
+	ReadOnly              bool                   `json:"readOnly"`
+	ToolMetrics           map[string]ToolMetrics `json:"toolMetrics,omitempty"`
+}
+
+// GetServerMetadata defines the MCP tool reporting the server version, the default token status
+// and the enabled toolsets. It is registered regardless of the toolset configuration.
+func GetServerMetadata(tokenStore *TokenStore, tg *toolsets.ToolsetGroup, info ServerInfo, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"getServerMetadata",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_SERVER_METADATA_DESCRIPTION)),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "Get Server Metadata",
+				ReadOnlyHint: boolPtr(true),
+			}),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			result := serverMetadata{
+				Version:         info.Version,
+				Commit:          info.Commit,
+				EnabledToolsets: []string{},
+				ReadOnly:        info.ReadOnly,
+			}
+
+			if metadata, err := tokenStore.GetDefaultToken(); err == nil {
+				result.GitLabHost = metadata.GitLabHost
+				result.AuthenticatedUsername = metadata.Username
+				if metadata.ExpiresAt != nil {
+					days := metadata.DaysUntilExpiry()
+					result.TokenExpiresAt = metadata.ExpiresAt
+					result.TokenDaysUntilExpiry = &days
+				}
+			}
+
+			// Computed per call so toolsets enabled later in dynamic mode are reported
+			if tg != nil {
+				for _, ts := range tg.ListToolsets() {
+					if ts.Enabled {
+						result.EnabledToolsets = append(result.EnabledToolsets, ts.Name)
+					}
+				}
+				sort.Strings(result.EnabledToolsets)
+			}
+
+			if info.Metrics != nil {
+				result.ToolMetrics = info.Metrics.GetMetricsSnapshot()
+			}
+
+			data, err := json.MarshalIndent(result, "", "  ")
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal server metadata: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
 // --- Generic Parameter Helpers (Inspired by github-mcp-server) ---
 
 // requiredParam fetches a required parameter, checks presence, type, and non-zero value.
@@ -246,6 +355,37 @@ func WithPagination() mcp.ToolOption {
 	}
 }
 
+// WithKeysetPagination returns a ToolOption to add the 'pagination' mode and 'cursor' parameters
+// to list tools whose GitLab endpoint supports keyset pagination.
+func WithKeysetPagination() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		mcp.WithString("pagination",
+			mcp.Description("Pagination mode (default: offset). 'keyset' is more stable for large datasets and returns a 'next_cursor' instead of page numbers."),
+			mcp.Enum(string(PaginationModeOffset), string(PaginationModeKeyset)),
+		)(tool)
+
+		mcp.WithString("cursor",
+			mcp.Description("The 'next_cursor' value of a previous keyset-paginated response. Used instead of 'page' to fetch the next page."),
+		)(tool)
+	}
+}
+
+// WithFields returns a ToolOption to add the optional 'fields' parameter used to trim responses.
+func WithFields() mcp.ToolOption {
+	return mcp.WithString("fields",
+		mcp.Description("Comma-separated list of top-level fields to include in the response (e.g. 'id,title,state'). Returns all fields when omitted."),
+	)
+}
+
+// OptionalFieldsParam extracts the 'fields' parameter as a list of field names (nil when absent).
+func OptionalFieldsParam(req *mcp.CallToolRequest) ([]string, error) {
+	fields, err := OptionalParam[string](req, "fields")
+	if err != nil {
+		return nil, err
+	}
+	return ParseCommaSeparatedList(fields), nil
+}
+
 // OptionalPaginationParams extracts page and per_page parameters from the request.
 // It applies default and max values.
 func OptionalPaginationParams(req *mcp.CallToolRequest) (page, perPage int, err error) {
@@ -274,6 +414,109 @@ func OptionalPaginationParams(req *mcp.CallToolRequest) (page, perPage int, err
 	return page, perPage, nil
 }
 
+// OptionalPaginationModeParams extracts the 'pagination' and 'cursor' parameters from the request.
+// Passing a cursor implies keyset mode.
+func OptionalPaginationModeParams(req *mcp.CallToolRequest) (mode PaginationMode, cursor string, err error) {
+	modeVal, err := OptionalParam[string](req, "pagination")
+	if err != nil {
+		return "", "", err
+	}
+	cursor, err = OptionalParam[string](req, "cursor")
+	if err != nil {
+		return "", "", err
+	}
+
+	switch PaginationMode(modeVal) {
+	case "":
+		mode = PaginationModeOffset
+		if cursor != "" {
+			mode = PaginationModeKeyset
+		}
+	case PaginationModeOffset:
+		if cursor != "" {
+			return "", "", fmt.Errorf("'cursor' requires keyset pagination")
+		}
+		mode = PaginationModeOffset
+	case PaginationModeKeyset:
+		mode = PaginationModeKeyset
+	default:
+		return "", "", fmt.Errorf("invalid 'pagination' parameter: must be '%s' or '%s', got '%s'", PaginationModeOffset, PaginationModeKeyset, modeVal)
+	}
+	return mode, cursor, nil
+}
+
+// applyKeysetPagination switches listOpts to keyset pagination. When a cursor is given, the
+// returned request options continue from the page it points to.
+func applyKeysetPagination(listOpts *gl.ListOptions, cursor string) ([]gl.RequestOptionFunc, error) {
+	listOpts.Pagination = string(PaginationModeKeyset)
+	listOpts.Page = 0
+	if cursor == "" {
+		return nil, nil
+	}
+	query, err := decodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	return []gl.RequestOptionFunc{gl.WithKeysetPaginationParameters("?" + query.Encode())}, nil
+}
+
+// --- Rate limit handling ---
+
+// checkRateLimit inspects the RateLimit-Remaining and RateLimit-Reset headers of a GitLab
+// response. It logs a warning when fewer than threshold requests remain and, once the
+// limit is exhausted, blocks until the reset time or until the request context is done.
+func checkRateLimit(resp *gl.Response, logger *log.Logger, threshold int) {
+	if resp == nil || resp.Response == nil {
+		return
+	}
+
+	remainingHeader := resp.Header.Get("RateLimit-Remaining")
+	if remainingHeader == "" {
+		return
+	}
+	remaining, err := strconv.Atoi(remainingHeader)
+	if err != nil || remaining >= threshold {
+		return
+	}
+
+	resetUnix, err := strconv.ParseInt(resp.Header.Get("RateLimit-Reset"), 10, 64)
+	hasReset := err == nil
+	reset := time.Unix(resetUnix, 0)
+
+	if remaining > 0 {
+		if hasReset {
+			logger.Warnf("GitLab rate limit almost exhausted: %d requests remaining (resets at %s)", remaining, reset.Format(time.RFC3339))
+		} else {
+			logger.Warnf("GitLab rate limit almost exhausted: %d requests remaining", remaining)
+		}
+		return
+	}
+
+	wait := time.Until(reset)
+	if !hasReset || wait <= 0 {
+		return
+	}
+	logger.Warnf("GitLab rate limit exhausted, waiting %s until reset", wait.Round(time.Second))
+
+	ctx := context.Background()
+	if resp.Request != nil {
+		ctx = resp.Request.Context()
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
+
+// WithRateLimitCheck returns a client option that runs checkRateLimit after every response.
+func WithRateLimitCheck(logger *log.Logger, threshold int) gl.ClientOptionFunc {
+	return gl.WithResponseLogHook(func(_ retryablehttp.Logger, resp *http.Response) {
+		checkRateLimit(&gl.Response{Response: resp}, logger, threshold)
+	})
+}
+
 // Note: newSuccessResult and newErrorResult helpers (if they existed) were removed
 // due to previous unresolved type errors. They will need to be added back based on
 // the actual signature of server.ToolHandlerFunc determined later.
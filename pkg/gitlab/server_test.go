@@ -1,11 +1,22 @@
 package gitlab
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
 	"testing"
+	"time"
 
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/toolsets"
 	"github.com/mark3labs/mcp-go/mcp"
+	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
 )
 
 // createMCPRequest is a helper function to create a CallToolRequest pointer for tests
@@ -583,6 +594,36 @@ func TestOptionalPaginationParams(t *testing.T) {
 	}
 }
 
+func TestOptionalPaginationModeParams(t *testing.T) {
+	tests := []struct {
+		name           string
+		args           map[string]any
+		expectedMode   PaginationMode
+		expectedCursor string
+		errorContains  string
+	}{
+		{name: "Default is offset", args: map[string]any{}, expectedMode: PaginationModeOffset},
+		{name: "Explicit keyset", args: map[string]any{"pagination": "keyset"}, expectedMode: PaginationModeKeyset},
+		{name: "Cursor implies keyset", args: map[string]any{"cursor": "abc"}, expectedMode: PaginationModeKeyset, expectedCursor: "abc"},
+		{name: "Cursor with offset mode", args: map[string]any{"pagination": "offset", "cursor": "abc"}, errorContains: "'cursor' requires keyset pagination"},
+		{name: "Invalid mode", args: map[string]any{"pagination": "seek"}, errorContains: "invalid 'pagination' parameter"},
+		{name: "Invalid cursor type", args: map[string]any{"cursor": 42.0}, errorContains: "parameter 'cursor' is not of expected type"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mode, cursor, err := OptionalPaginationModeParams(createMCPRequest(tc.args))
+			if tc.errorContains != "" {
+				assert.ErrorContains(t, err, tc.errorContains)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedMode, mode)
+			assert.Equal(t, tc.expectedCursor, cursor)
+		})
+	}
+}
+
 func TestOptionalBoolParam(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -789,3 +830,249 @@ func TestNewServer(t *testing.T) {
 		})
 	}
 }
+
+func TestRequestTimeoutMiddleware(t *testing.T) {
+	t.Run("Applies deadline to handler context", func(t *testing.T) {
+		var deadline time.Time
+		var hasDeadline bool
+		handler := requestTimeoutMiddleware(time.Minute)(func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			deadline, hasDeadline = ctx.Deadline()
+			return mcp.NewToolResultText("ok"), nil
+		})
+
+		start := time.Now()
+		_, err := handler(context.Background(), mcp.CallToolRequest{})
+		require.NoError(t, err)
+		require.True(t, hasDeadline, "handler context should have a deadline")
+		assert.WithinDuration(t, start.Add(time.Minute), deadline, 5*time.Second)
+	})
+
+	t.Run("Cancels slow handler", func(t *testing.T) {
+		handler := requestTimeoutMiddleware(10 * time.Millisecond)(func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+
+		_, err := handler(context.Background(), mcp.CallToolRequest{})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("Zero timeout disables limit", func(t *testing.T) {
+		var hasDeadline bool
+		handler := requestTimeoutMiddleware(0)(func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			_, hasDeadline = ctx.Deadline()
+			return mcp.NewToolResultText("ok"), nil
+		})
+
+		_, err := handler(context.Background(), mcp.CallToolRequest{})
+		require.NoError(t, err)
+		assert.False(t, hasDeadline)
+	})
+}
+
+func TestCheckRateLimit(t *testing.T) {
+	newResponse := func(ctx context.Context, remaining string, reset time.Time) *gl.Response {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://gitlab.example.com/api/v4/projects", nil)
+		header := http.Header{}
+		if remaining != "" {
+			header.Set("RateLimit-Remaining", remaining)
+		}
+		if !reset.IsZero() {
+			header.Set("RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+		}
+		return &gl.Response{Response: &http.Response{StatusCode: 200, Header: header, Request: req}}
+	}
+
+	tests := []struct {
+		name           string
+		remaining      string
+		reset          time.Time
+		expectWarning  string
+		notExpectInLog string
+	}{
+		{
+			name:      "No rate limit headers",
+			remaining: "",
+		},
+		{
+			name:      "Plenty of requests remaining",
+			remaining: "500",
+			reset:     time.Now().Add(time.Minute),
+		},
+		{
+			name:          "Below threshold logs warning",
+			remaining:     "5",
+			reset:         time.Now().Add(time.Minute),
+			expectWarning: "5 requests remaining (resets at",
+		},
+		{
+			name:           "Below threshold without reset header omits reset time",
+			remaining:      "5",
+			expectWarning:  "5 requests remaining",
+			notExpectInLog: "resets at",
+		},
+		{
+			name:      "Exhausted without reset header does not wait",
+			remaining: "0",
+		},
+		{
+			name:      "Exhausted with reset in the past does not wait",
+			remaining: "0",
+			reset:     time.Now().Add(-time.Minute),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger := log.New()
+			logger.SetOutput(&buf)
+
+			start := time.Now()
+			checkRateLimit(newResponse(context.Background(), tc.remaining, tc.reset), logger, DefaultRateLimitThreshold)
+			assert.Less(t, time.Since(start), time.Second)
+
+			if tc.expectWarning != "" {
+				assert.Contains(t, buf.String(), "level=warning")
+				assert.Contains(t, buf.String(), tc.expectWarning)
+				if tc.notExpectInLog != "" {
+					assert.NotContains(t, buf.String(), tc.notExpectInLog)
+				}
+			} else {
+				assert.Empty(t, buf.String())
+			}
+		})
+	}
+
+	t.Run("Exhausted waits until reset or context done", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := log.New()
+		logger.SetOutput(&buf)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		checkRateLimit(newResponse(ctx, "0", time.Now().Add(time.Hour)), logger, DefaultRateLimitThreshold)
+		elapsed := time.Since(start)
+
+		assert.GreaterOrEqual(t, elapsed, 50*time.Millisecond)
+		assert.Less(t, elapsed, time.Second)
+		assert.Contains(t, buf.String(), "rate limit exhausted")
+	})
+
+	t.Run("Nil response is ignored", func(t *testing.T) {
+		assert.NotPanics(t, func() { checkRateLimit(nil, log.New(), DefaultRateLimitThreshold) })
+	})
+}
+
+func TestWithRateLimitCheck(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("RateLimit-Remaining", "3")
+		w.Header().Set("RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Minute).Unix(), 10))
+		_, _ = w.Write([]byte(`{"id":1,"username":"root"}`))
+	}))
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	logger := log.New()
+	logger.SetOutput(&buf)
+
+	client, err := NewClient("token", 0, gl.WithBaseURL(srv.URL), WithRateLimitCheck(logger, DefaultRateLimitThreshold))
+	require.NoError(t, err)
+
+	_, _, err = client.Users.CurrentUser()
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), "3 requests remaining")
+}
+
+func TestGetServerMetadataHandler(t *testing.T) {
+	tool, _ := GetServerMetadata(nil, nil, ServerInfo{}, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+	assert.Equal(t, "getServerMetadata", tool.Name)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.Empty(t, tool.InputSchema.Required)
+
+	expiresAt := time.Now().Add(10*24*time.Hour + time.Hour)
+	newTokenStore := func(defaultName string, names ...string) *TokenStore {
+		store := NewTokenStore()
+		for _, name := range names {
+			require.NoError(t, store.AddToken(name, &TokenMetadata{
+				Token:      "glpat-secret",
+				Name:       name,
+				GitLabHost: "https://" + name + ".example.com",
+				Username:   name + "-user",
+				ExpiresAt:  &expiresAt,
+			}))
+		}
+		store.SetDefaultToken(defaultName)
+		return store
+	}
+
+	tg := toolsets.NewToolsetGroup(false)
+	tg.AddToolset(toolsets.NewToolset("projects", "Projects"))
+	tg.AddToolset(toolsets.NewToolset("issues", "Issues"))
+	tg.AddToolset(toolsets.NewToolset("wikis", "Wikis"))
+	require.NoError(t, tg.EnableToolsets([]string{"wikis", "issues"}))
+
+	tests := []struct {
+		name             string
+		tokenStore       *TokenStore
+		expectedHost     string
+		expectedUsername string
+		expectExpiry     bool
+	}{
+		{
+			name:             "Success - Default token",
+			tokenStore:       newTokenStore("personal", "work", "personal"),
+			expectedHost:     "https://personal.example.com",
+			expectedUsername: "personal-user",
+			expectExpiry:     true,
+		},
+		{
+			name:             "Success - Falls back to the only token",
+			tokenStore:       newTokenStore("", "work"),
+			expectedHost:     "https://work.example.com",
+			expectedUsername: "work-user",
+			expectExpiry:     true,
+		},
+		{
+			name:       "Success - No token",
+			tokenStore: NewTokenStore(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, handler := GetServerMetadata(tc.tokenStore, tg, ServerInfo{
+				Version:  "1.2.3",
+				Commit:   "abc123",
+				ReadOnly: true,
+			}, nil)
+
+			result, err := handler(context.Background(), *createMCPRequest(map[string]interface{}{}))
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			text := getTextResult(t, result).Text
+			assert.NotContains(t, text, "glpat-secret")
+
+			var metadata map[string]any
+			require.NoError(t, json.Unmarshal([]byte(text), &metadata))
+			assert.Equal(t, "1.2.3", metadata["version"])
+			assert.Equal(t, "abc123", metadata["commit"])
+			assert.Equal(t, true, metadata["readOnly"])
+			assert.Equal(t, []any{"issues", "wikis"}, metadata["enabledToolsets"])
+			assert.Equal(t, tc.expectedHost, metadata["gitlabHost"])
+			assert.Equal(t, tc.expectedUsername, metadata["authenticatedUsername"])
+			if tc.expectExpiry {
+				assert.NotNil(t, metadata["tokenExpiresAt"])
+				assert.Equal(t, float64(10), metadata["tokenDaysUntilExpiry"])
+			} else {
+				assert.Nil(t, metadata["tokenExpiresAt"])
+				assert.Nil(t, metadata["tokenDaysUntilExpiry"])
+			}
+		})
+	}
+}
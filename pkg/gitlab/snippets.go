@@ -0,0 +1,484 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gl "gitlab.com/gitlab-org/api/client-go"
+)
+
+// snippetWithContent is a project snippet together with its raw content
+type snippetWithContent struct {
+	*gl.Snippet
+	Content string `json:"content"`
+}
+
+// ListProjectSnippets defines the MCP tool for listing the snippets of a GitLab project.
+func ListProjectSnippets(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listProjectSnippets",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_PROJECT_SNIPPETS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Project Snippets",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			opts := &gl.ListProjectSnippetsOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+			}
+			snippets, resp, err := glClient.ProjectSnippets.ListSnippets(projectID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, fmt.Sprintf("snippets from project %q", projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(snippets) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Truncate long descriptions and marshal
+			truncator := NewTextTruncator(MaxFieldLength)
+			truncated, err := truncator.TruncateListResponse(snippets, SnippetFields)
+			if err != nil {
+				return nil, fmt.Errorf("failed to truncate snippets list: %w", err)
+			}
+
+			data, err := json.Marshal(truncated)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal snippets list: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// GetSnippet defines the MCP tool for retrieving a project snippet together with its content.
+func GetSnippet(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"getSnippet",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_SNIPPET_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "Get GitLab Project Snippet",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("snippetId",
+				mcp.Description("The ID of the snippet."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			snippetIDFloat, err := requiredParam[float64](&request, "snippetId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			snippetID := int64(snippetIDFloat)
+			if float64(snippetID) != snippetIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: snippetId %v is not a valid integer", snippetIDFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			snippetDesc := fmt.Sprintf("snippet %d in project %q", snippetID, projectID)
+
+			// --- Call GitLab API
+			snippet, resp, err := glClient.ProjectSnippets.GetSnippet(projectID, snippetID, gl.WithContext(ctx))
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, snippetDesc)
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// The snippet metadata does not include its content, fetch it separately
+			content, resp, err := glClient.ProjectSnippets.SnippetContent(projectID, snippetID, gl.WithContext(ctx))
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("content of %s", snippetDesc))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(snippetWithContent{Snippet: snippet, Content: string(content)})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal snippet data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// CreateSnippet defines the MCP tool for creating a single-file snippet in a GitLab project.
+func CreateSnippet(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"createSnippet",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_CREATE_SNIPPET_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Create GitLab Project Snippet",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("title",
+				mcp.Description("The title of the snippet."),
+				mcp.Required(),
+			),
+			mcp.WithString("fileName",
+				mcp.Description("The name of the snippet file, e.g. 'script.sh'."),
+				mcp.Required(),
+			),
+			mcp.WithString("content",
+				mcp.Description("The content of the snippet file."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithString("description",
+				mcp.Description("The description of the snippet."),
+			),
+			mcp.WithString("visibility",
+				mcp.Description("The visibility of the snippet (default: private)."),
+				mcp.Enum("private", "internal", "public"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			title, err := requiredParam[string](&request, "title")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			fileName, err := requiredParam[string](&request, "fileName")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			content, err := requiredParam[string](&request, "content")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional parameters
+			description, err := OptionalParam[string](&request, "description")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			visibility, err := OptionalParam[string](&request, "visibility")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.CreateProjectSnippetOptions{
+				Title: &title,
+				Files: &[]*gl.CreateSnippetFileOptions{
+					{FilePath: &fileName, Content: &content},
+				},
+				// GitLab requires a visibility, default to the most restrictive one
+				Visibility: gl.Ptr(gl.PrivateVisibility),
+			}
+
+			if description != "" {
+				opts.Description = &description
+			}
+
+			if visibility != "" {
+				opts.Visibility = gl.Ptr(gl.VisibilityValue(visibility))
+			}
+
+			// --- Call GitLab API
+			snippet, resp, err := glClient.ProjectSnippets.CreateSnippet(projectID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("project %q", projectID), "create snippet")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(snippet)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal snippet data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// UpdateSnippet defines the MCP tool for updating a snippet of a GitLab project.
+func UpdateSnippet(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"updateSnippet",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_UPDATE_SNIPPET_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Update GitLab Project Snippet",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("snippetId",
+				mcp.Description("The ID of the snippet to update."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithString("title",
+				mcp.Description("The new title of the snippet."),
+			),
+			mcp.WithString("content",
+				mcp.Description("The new content of the snippet file."),
+			),
+			mcp.WithString("fileName",
+				mcp.Description("The snippet file whose content is replaced. Only needed for snippets with more than one file."),
+			),
+			mcp.WithString("description",
+				mcp.Description("The new description of the snippet."),
+			),
+			mcp.WithString("visibility",
+				mcp.Description("The new visibility of the snippet."),
+				mcp.Enum("private", "internal", "public"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			snippetIDFloat, err := requiredParam[float64](&request, "snippetId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			snippetID := int64(snippetIDFloat)
+			if float64(snippetID) != snippetIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: snippetId %v is not a valid integer", snippetIDFloat)), nil
+			}
+
+			// --- Parse optional parameters
+			title, err := OptionalParam[string](&request, "title")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			content, err := OptionalParam[string](&request, "content")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			fileName, err := OptionalParam[string](&request, "fileName")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			description, err := OptionalParam[string](&request, "description")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			visibility, err := OptionalParam[string](&request, "visibility")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			if title == "" && content == "" && description == "" && visibility == "" {
+				return mcp.NewToolResultError("Validation Error: at least one of title, content, description or visibility must be provided"), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			snippetDesc := fmt.Sprintf("snippet %d in project %q", snippetID, projectID)
+
+			// --- Construct GitLab API options
+			opts := &gl.UpdateProjectSnippetOptions{}
+
+			if title != "" {
+				opts.Title = &title
+			}
+
+			if description != "" {
+				opts.Description = &description
+			}
+
+			if visibility != "" {
+				opts.Visibility = gl.Ptr(gl.VisibilityValue(visibility))
+			}
+
+			if content != "" {
+				// File updates must name the file; look it up for single-file snippets
+				if fileName == "" {
+					current, resp, err := glClient.ProjectSnippets.GetSnippet(projectID, snippetID, gl.WithContext(ctx))
+					if err != nil {
+						result, apiErr := HandleAPIError(err, resp, snippetDesc)
+						if result != nil {
+							return result, nil
+						}
+						return nil, apiErr
+					}
+					if len(current.Files) != 1 {
+						return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %s has %d files, fileName is required to update its content", snippetDesc, len(current.Files))), nil
+					}
+					fileName = current.Files[0].Path
+				}
+
+				opts.Files = &[]*gl.UpdateSnippetFileOptions{
+					{Action: gl.Ptr("update"), FilePath: &fileName, Content: &content},
+				}
+			}
+
+			// --- Call GitLab API
+			snippet, resp, err := glClient.ProjectSnippets.UpdateSnippet(projectID, snippetID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, snippetDesc, "update snippet")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(snippet)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal snippet data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// DeleteSnippet defines the MCP tool for deleting a snippet of a GitLab project.
+func DeleteSnippet(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"deleteSnippet",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_DELETE_SNIPPET_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Delete GitLab Project Snippet",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithNumber("snippetId",
+				mcp.Description("The ID of the snippet to delete."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			snippetIDFloat, err := requiredParam[float64](&request, "snippetId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			snippetID := int64(snippetIDFloat)
+			if float64(snippetID) != snippetIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: snippetId %v is not a valid integer", snippetIDFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			resp, err := glClient.ProjectSnippets.DeleteSnippet(projectID, snippetID, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("snippet %d in project %q", snippetID, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf(`{"message":"Snippet %d successfully deleted from project %q"}`, snippetID, projectID)), nil
+		}
+}
@@ -0,0 +1,360 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+func TestListProjectSnippetsHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListProjectSnippets(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockSnippets, ctrl := setupMockClientForProjectSnippets(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListProjectSnippets(mockGetClient, nil)
+
+	tests := []struct {
+		name               string
+		inputArgs          map[string]any
+		mockSetup          func()
+		expectedText       string
+		expectHandlerError bool
+		expectResultError  bool
+		errorContains      string
+	}{
+		{
+			name:      "Success - List snippets",
+			inputArgs: map[string]any{"projectId": "group/project", "page": 2.0, "per_page": 5.0},
+			mockSetup: func() {
+				mockSnippets.EXPECT().
+					ListSnippets("group/project", gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, opts *gl.ListProjectSnippetsOptions, _ ...gl.RequestOptionFunc) ([]*gl.Snippet, *gl.Response, error) {
+						assert.Equal(t, int64(2), opts.Page)
+						assert.Equal(t, int64(5), opts.PerPage)
+						return []*gl.Snippet{{ID: 3, Title: "Deploy script"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+					})
+			},
+			expectedText: `"title":"Deploy script"`,
+		},
+		{
+			name:      "Success - Empty list",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockSnippets.EXPECT().
+					ListSnippets("group/project", gomock.Any(), gomock.Any()).
+					Return([]*gl.Snippet{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: "[]",
+		},
+		{
+			name:              "Error - Missing projectId",
+			inputArgs:         map[string]any{},
+			mockSetup:         func() {},
+			expectResultError: true,
+			errorContains:     "Validation Error: missing required parameter: projectId",
+		},
+		{
+			name:      "Error - Forbidden (403)",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockSnippets.EXPECT().
+					ListSnippets("group/project", gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 403}}, errors.New("gitlab: 403 Forbidden"))
+			},
+			expectHandlerError: true,
+			errorContains:      "failed to list snippets from project",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.inputArgs}})
+
+			if tc.expectHandlerError {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			textContent := getTextResult(t, result)
+			if tc.expectResultError {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.errorContains)
+				return
+			}
+			assert.Contains(t, textContent.Text, tc.expectedText)
+		})
+	}
+}
+
+func TestGetSnippetHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetSnippet(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockSnippets, ctrl := setupMockClientForProjectSnippets(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := GetSnippet(mockGetClient, nil)
+
+	t.Run("Success - Includes content", func(t *testing.T) {
+		mockSnippets.EXPECT().
+			GetSnippet("group/project", int64(3), gomock.Any()).
+			Return(&gl.Snippet{ID: 3, Title: "Deploy script"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+		mockSnippets.EXPECT().
+			SnippetContent("group/project", int64(3), gomock.Any()).
+			Return([]byte("echo deploy"), &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"snippetId": 3.0,
+		}}})
+		require.NoError(t, err)
+		text := getTextResult(t, result).Text
+		assert.Contains(t, text, `"title":"Deploy script"`)
+		assert.Contains(t, text, `"content":"echo deploy"`)
+	})
+
+	t.Run("Error - Snippet Not Found (404)", func(t *testing.T) {
+		mockSnippets.EXPECT().
+			GetSnippet("group/project", int64(99), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"snippetId": 99.0,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, `snippet 99 in project "group/project" not found`)
+	})
+
+	t.Run("Error - Non-integer snippetId", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"snippetId": 3.5,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: snippetId 3.5 is not a valid integer")
+	})
+}
+
+func TestCreateSnippetHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := CreateSnippet(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockSnippets, ctrl := setupMockClientForProjectSnippets(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := CreateSnippet(mockGetClient, nil)
+
+	t.Run("Success - Defaults to private visibility", func(t *testing.T) {
+		mockSnippets.EXPECT().
+			CreateSnippet("group/project", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.CreateProjectSnippetOptions, _ ...gl.RequestOptionFunc) (*gl.Snippet, *gl.Response, error) {
+				assert.Equal(t, "Deploy script", *opts.Title)
+				assert.Equal(t, gl.PrivateVisibility, *opts.Visibility)
+				assert.Nil(t, opts.Description)
+				require.Len(t, *opts.Files, 1)
+				assert.Equal(t, "deploy.sh", *(*opts.Files)[0].FilePath)
+				assert.Equal(t, "echo deploy", *(*opts.Files)[0].Content)
+				return &gl.Snippet{ID: 3, Title: "Deploy script"}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"title":     "Deploy script",
+			"fileName":  "deploy.sh",
+			"content":   "echo deploy",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"id":3`)
+	})
+
+	t.Run("Success - Explicit visibility and description", func(t *testing.T) {
+		mockSnippets.EXPECT().
+			CreateSnippet("group/project", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.CreateProjectSnippetOptions, _ ...gl.RequestOptionFunc) (*gl.Snippet, *gl.Response, error) {
+				assert.Equal(t, gl.PublicVisibility, *opts.Visibility)
+				assert.Equal(t, "Used by CI", *opts.Description)
+				return &gl.Snippet{ID: 4}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":   "group/project",
+			"title":       "Deploy script",
+			"fileName":    "deploy.sh",
+			"content":     "echo deploy",
+			"description": "Used by CI",
+			"visibility":  "public",
+		}}})
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	t.Run("Error - Missing content", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"title":     "Deploy script",
+			"fileName":  "deploy.sh",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: content")
+	})
+}
+
+func TestUpdateSnippetHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := UpdateSnippet(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockSnippets, ctrl := setupMockClientForProjectSnippets(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := UpdateSnippet(mockGetClient, nil)
+
+	t.Run("Success - Title only does not touch files", func(t *testing.T) {
+		mockSnippets.EXPECT().
+			UpdateSnippet("group/project", int64(3), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ int64, opts *gl.UpdateProjectSnippetOptions, _ ...gl.RequestOptionFunc) (*gl.Snippet, *gl.Response, error) {
+				assert.Equal(t, "Renamed", *opts.Title)
+				assert.Nil(t, opts.Files)
+				return &gl.Snippet{ID: 3, Title: "Renamed"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"snippetId": 3.0,
+			"title":     "Renamed",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"title":"Renamed"`)
+	})
+
+	t.Run("Success - Content resolves single file name", func(t *testing.T) {
+		mockSnippets.EXPECT().
+			GetSnippet("group/project", int64(3), gomock.Any()).
+			Return(&gl.Snippet{ID: 3, Files: []gl.SnippetFile{{Path: "deploy.sh"}}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+		mockSnippets.EXPECT().
+			UpdateSnippet("group/project", int64(3), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ int64, opts *gl.UpdateProjectSnippetOptions, _ ...gl.RequestOptionFunc) (*gl.Snippet, *gl.Response, error) {
+				require.Len(t, *opts.Files, 1)
+				file := (*opts.Files)[0]
+				assert.Equal(t, "update", *file.Action)
+				assert.Equal(t, "deploy.sh", *file.FilePath)
+				assert.Equal(t, "echo v2", *file.Content)
+				return &gl.Snippet{ID: 3}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"snippetId": 3.0,
+			"content":   "echo v2",
+		}}})
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	t.Run("Error - Content on multi-file snippet without fileName", func(t *testing.T) {
+		mockSnippets.EXPECT().
+			GetSnippet("group/project", int64(3), gomock.Any()).
+			Return(&gl.Snippet{ID: 3, Files: []gl.SnippetFile{{Path: "a.sh"}, {Path: "b.sh"}}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"snippetId": 3.0,
+			"content":   "echo v2",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "fileName is required")
+	})
+
+	t.Run("Error - Nothing to update", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"snippetId": 3.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: at least one of title, content, description or visibility must be provided")
+	})
+}
+
+func TestDeleteSnippetHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := DeleteSnippet(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockSnippets, ctrl := setupMockClientForProjectSnippets(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := DeleteSnippet(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockSnippets.EXPECT().
+			DeleteSnippet("group/project", int64(3), gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 204}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"snippetId": 3.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Snippet 3 successfully deleted")
+	})
+
+	t.Run("Error - Snippet Not Found (404)", func(t *testing.T) {
+		mockSnippets.EXPECT().
+			DeleteSnippet("group/project", int64(99), gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"snippetId": 99.0,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
@@ -41,33 +41,38 @@ func NewStrictResolver(pool *ClientPool, serverHosts map[string]string, logger *
 	}
 }
 
-// Resolve returns (client, serverName, error). It NEVER falls back.
+// Resolve returns (client, serverName, error). It NEVER falls back. The server
+// comes from the tool call's "gitlabServer" argument or, failing that, from .gmcprc.
 func (r *StrictResolver) Resolve(ctx context.Context) (*gl.Client, string, error) {
-	cfg, _, err := FindProjectConfig()
-	if err != nil {
-		return nil, "", fmt.Errorf("strict resolver: failed to read .gmcprc: %w", err)
-	}
-	if cfg == nil {
-		return nil, "", errors.New("strict resolver: no project configured — run 'gitlab-mcp-server project init' or pass --server")
-	}
-	if cfg.Server == "" {
-		return nil, "", errors.New("strict resolver: .gmcprc is missing required 'server' field — re-run 'gitlab-mcp-server project init'")
+	name := ServerNameFromContext(ctx)
+	if name == "" {
+		cfg, _, err := FindProjectConfig()
+		if err != nil {
+			return nil, "", fmt.Errorf("strict resolver: failed to read .gmcprc: %w", err)
+		}
+		if cfg == nil {
+			return nil, "", errors.New("strict resolver: no project configured — run 'gitlab-mcp-server project init' or pass --server")
+		}
+		if cfg.Server == "" {
+			return nil, "", errors.New("strict resolver: .gmcprc is missing required 'server' field — re-run 'gitlab-mcp-server project init'")
+		}
+		name = cfg.Server
 	}
 
-	client, err := r.pool.GetClient(cfg.Server)
+	client, err := r.pool.GetClient(name)
 	if err != nil {
 		configured := make([]string, 0, len(r.serverHosts))
 		for n := range r.serverHosts {
 			configured = append(configured, n)
 		}
 		return nil, "", fmt.Errorf("strict resolver: server %q not configured; configured servers: %s",
-			cfg.Server, strings.Join(configured, ", "))
+			name, strings.Join(configured, ", "))
 	}
 
-	if err := r.verifyHost(ctx, cfg.Server, client); err != nil {
+	if err := r.verifyHost(ctx, name, client); err != nil {
 		return nil, "", err
 	}
-	return client, cfg.Server, nil
+	return client, name, nil
 }
 
 // GetClientFn adapts StrictResolver to the GetClientFn signature used by
@@ -55,6 +55,23 @@ func TestStrictResolver_ResolvesGoodMatch(t *testing.T) {
 	assert.NotNil(t, got)
 }
 
+func TestStrictResolver_UsesServerArgument(t *testing.T) {
+	srv := newFakeGitLab(t)
+	defer srv.Close()
+	_ = mkProjectCfg(t, "personal")
+
+	pool := NewClientPool(NewTokenStore(), logrus.New())
+	client, err := gl.NewClient("x", gl.WithBaseURL(srv.URL))
+	require.NoError(t, err)
+	require.NoError(t, pool.AddClient("work", client))
+
+	r := NewStrictResolver(pool, map[string]string{"work": srv.URL}, logrus.New())
+	got, name, err := r.Resolve(ContextWithServerName(context.Background(), "work"))
+	require.NoError(t, err)
+	assert.Equal(t, "work", name)
+	assert.Same(t, client, got)
+}
+
 func TestStrictResolver_ErrorsWhenNoProjectConfig(t *testing.T) {
 	dir := t.TempDir()
 	cwd, _ := os.Getwd()
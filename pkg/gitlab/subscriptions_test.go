@@ -0,0 +1,179 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	mock_gitlab "gitlab.com/gitlab-org/api/client-go/testing"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+func TestIssueSubscriptionHandlers(t *testing.T) {
+	tests := []struct {
+		name            string
+		tool            func(GetClientFn, map[string]string) (mcp.Tool, server.ToolHandlerFunc)
+		setupMock       func(*mock_gitlab.MockIssuesServiceInterface)
+		expectResultErr bool
+		expectedText    string
+	}{
+		{
+			name: "Subscribe - Success",
+			tool: SubscribeToIssue,
+			setupMock: func(m *mock_gitlab.MockIssuesServiceInterface) {
+				m.EXPECT().SubscribeToIssue("group/project", int64(5), gomock.Any()).
+					Return(&gl.Issue{IID: 5, Subscribed: true}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil)
+			},
+			expectedText: `{"message":"Subscribed to issue 5 in project "group/project""}`,
+		},
+		{
+			name: "Subscribe - Already subscribed (304)",
+			tool: SubscribeToIssue,
+			setupMock: func(m *mock_gitlab.MockIssuesServiceInterface) {
+				m.EXPECT().SubscribeToIssue("group/project", int64(5), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 304}}, io.EOF)
+			},
+			expectedText: `{"message":"Already subscribed to issue 5 in project "group/project""}`,
+		},
+		{
+			name: "Subscribe - Not Found (404)",
+			tool: SubscribeToIssue,
+			setupMock: func(m *mock_gitlab.MockIssuesServiceInterface) {
+				m.EXPECT().SubscribeToIssue("group/project", int64(5), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+			},
+			expectResultErr: true,
+			expectedText:    `issue 5 in project "group/project" not found or access denied (404)`,
+		},
+		{
+			name: "Unsubscribe - Success",
+			tool: UnsubscribeFromIssue,
+			setupMock: func(m *mock_gitlab.MockIssuesServiceInterface) {
+				m.EXPECT().UnsubscribeFromIssue("group/project", int64(5), gomock.Any()).
+					Return(&gl.Issue{IID: 5}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil)
+			},
+			expectedText: `{"message":"Unsubscribed from issue 5 in project "group/project""}`,
+		},
+		{
+			name: "Unsubscribe - Not subscribed (304)",
+			tool: UnsubscribeFromIssue,
+			setupMock: func(m *mock_gitlab.MockIssuesServiceInterface) {
+				m.EXPECT().UnsubscribeFromIssue("group/project", int64(5), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 304}}, io.EOF)
+			},
+			expectedText: `{"message":"Not subscribed to issue 5 in project "group/project""}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockClient, mockIssues, ctrl := setupMockClientForIssues(t)
+			defer ctrl.Finish()
+
+			tool, handler := tc.tool(func(_ context.Context) (*gl.Client, error) {
+				return mockClient, nil
+			}, nil)
+			require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+			tc.setupMock(mockIssues)
+
+			result, err := handler(ctx, *createMCPRequest(map[string]any{
+				"projectId": "group/project",
+				"issueIid":  5.0,
+			}))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectResultErr, result.IsError)
+			assert.Equal(t, tc.expectedText, getTextResult(t, result).Text)
+		})
+	}
+}
+
+func TestMergeRequestSubscriptionHandlers(t *testing.T) {
+	tests := []struct {
+		name            string
+		tool            func(GetClientFn, map[string]string) (mcp.Tool, server.ToolHandlerFunc)
+		setupMock       func(*mock_gitlab.MockMergeRequestsServiceInterface)
+		expectResultErr bool
+		expectedText    string
+	}{
+		{
+			name: "Subscribe - Success",
+			tool: SubscribeToMergeRequest,
+			setupMock: func(m *mock_gitlab.MockMergeRequestsServiceInterface) {
+				m.EXPECT().SubscribeToMergeRequest("group/project", int64(3), gomock.Any()).
+					Return(&gl.MergeRequest{}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil)
+			},
+			expectedText: `{"message":"Subscribed to merge request 3 in project "group/project""}`,
+		},
+		{
+			name: "Subscribe - Already subscribed (304)",
+			tool: SubscribeToMergeRequest,
+			setupMock: func(m *mock_gitlab.MockMergeRequestsServiceInterface) {
+				m.EXPECT().SubscribeToMergeRequest("group/project", int64(3), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 304}}, io.EOF)
+			},
+			expectedText: `{"message":"Already subscribed to merge request 3 in project "group/project""}`,
+		},
+		{
+			name: "Unsubscribe - Success",
+			tool: UnsubscribeFromMergeRequest,
+			setupMock: func(m *mock_gitlab.MockMergeRequestsServiceInterface) {
+				m.EXPECT().UnsubscribeFromMergeRequest("group/project", int64(3), gomock.Any()).
+					Return(&gl.MergeRequest{}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil)
+			},
+			expectedText: `{"message":"Unsubscribed from merge request 3 in project "group/project""}`,
+		},
+		{
+			name: "Unsubscribe - Not subscribed (304)",
+			tool: UnsubscribeFromMergeRequest,
+			setupMock: func(m *mock_gitlab.MockMergeRequestsServiceInterface) {
+				m.EXPECT().UnsubscribeFromMergeRequest("group/project", int64(3), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 304}}, io.EOF)
+			},
+			expectedText: `{"message":"Not subscribed to merge request 3 in project "group/project""}`,
+		},
+		{
+			name: "Unsubscribe - Not Found (404)",
+			tool: UnsubscribeFromMergeRequest,
+			setupMock: func(m *mock_gitlab.MockMergeRequestsServiceInterface) {
+				m.EXPECT().UnsubscribeFromMergeRequest("group/project", int64(3), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+			},
+			expectResultErr: true,
+			expectedText:    `merge request 3 in project "group/project" not found or access denied (404)`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockClient, mockMRs, ctrl := setupMockClientForMergeRequests(t)
+			defer ctrl.Finish()
+
+			tool, handler := tc.tool(func(_ context.Context) (*gl.Client, error) {
+				return mockClient, nil
+			}, nil)
+			require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+			tc.setupMock(mockMRs)
+
+			result, err := handler(ctx, *createMCPRequest(map[string]any{
+				"projectId":       "group/project",
+				"mergeRequestIid": 3.0,
+			}))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectResultErr, result.IsError)
+			assert.Equal(t, tc.expectedText, getTextResult(t, result).Text)
+		})
+	}
+}
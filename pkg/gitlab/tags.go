@@ -16,6 +16,7 @@ func Tag(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler ser
 	return mcp.NewTool(
 			"tag",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_TAG_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title: "Manage Repository Tag",
 			}),
@@ -150,6 +151,7 @@ func ListRepositoryTags(getClient GetClientFn, t map[string]string) (tool mcp.To
 	return mcp.NewTool(
 			"listRepositoryTags",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_REPOSITORY_TAGS_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        "List Repository Tags",
 				ReadOnlyHint: boolPtr(true),
@@ -0,0 +1,251 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+func TestGetTimeTrackingStatsHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetTimeTrackingStats(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockIssues, ctrl := setupMockClientForIssues(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := GetTimeTrackingStats(mockGetClient, nil)
+
+	tests := []struct {
+		name               string
+		inputArgs          map[string]any
+		mockSetup          func()
+		expectedText       string
+		expectHandlerError bool
+		expectResultError  bool
+		errorContains      string
+	}{
+		{
+			name:      "Success",
+			inputArgs: map[string]any{"projectId": "group/project", "issueIid": 3.0},
+			mockSetup: func() {
+				mockIssues.EXPECT().
+					GetTimeSpent("group/project", int64(3), gomock.Any()).
+					Return(&gl.TimeStats{HumanTimeEstimate: "3h", TimeEstimate: 10800, HumanTotalTimeSpent: "1h", TotalTimeSpent: 3600}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: `"human_time_estimate":"3h"`,
+		},
+		{
+			name:              "Error - Non-integer issueIid",
+			inputArgs:         map[string]any{"projectId": "group/project", "issueIid": 3.5},
+			mockSetup:         func() {},
+			expectResultError: true,
+			errorContains:     "Validation Error: issueIid 3.5 is not a valid integer",
+		},
+		{
+			name:      "Error - Issue Not Found (404)",
+			inputArgs: map[string]any{"projectId": "group/project", "issueIid": 99.0},
+			mockSetup: func() {
+				mockIssues.EXPECT().
+					GetTimeSpent("group/project", int64(99), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+			},
+			expectResultError: true,
+			errorContains:     `issue 99 in project "group/project" not found`,
+		},
+		{
+			name:      "Error - Internal Server Error (500)",
+			inputArgs: map[string]any{"projectId": "group/project", "issueIid": 3.0},
+			mockSetup: func() {
+				mockIssues.EXPECT().
+					GetTimeSpent("group/project", int64(3), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 500}}, errors.New("gitlab: 500 Internal Server Error"))
+			},
+			expectHandlerError: true,
+			errorContains:      "issue 3 in project",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.inputArgs}})
+
+			if tc.expectHandlerError {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			textContent := getTextResult(t, result)
+			if tc.expectResultError {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.errorContains)
+				return
+			}
+			assert.Contains(t, textContent.Text, tc.expectedText)
+		})
+	}
+}
+
+func TestSetTimeEstimateHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := SetTimeEstimate(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockIssues, ctrl := setupMockClientForIssues(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := SetTimeEstimate(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockIssues.EXPECT().
+			SetTimeEstimate("group/project", int64(3), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ int64, opts *gl.SetTimeEstimateOptions, _ ...gl.RequestOptionFunc) (*gl.TimeStats, *gl.Response, error) {
+				assert.Equal(t, "3h30m", *opts.Duration)
+				return &gl.TimeStats{HumanTimeEstimate: "3h 30m", TimeEstimate: 12600}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"issueIid":  3.0,
+			"duration":  "3h30m",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"time_estimate":12600`)
+	})
+
+	t.Run("Error - Missing duration", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"issueIid":  3.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: duration")
+	})
+
+	t.Run("Error - Invalid duration (400)", func(t *testing.T) {
+		mockIssues.EXPECT().
+			SetTimeEstimate("group/project", int64(3), gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 400}}, errors.New("gitlab: 400 Invalid time format"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"issueIid":  3.0,
+			"duration":  "soon",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
+
+func TestResetTimeEstimateHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ResetTimeEstimate(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockIssues, ctrl := setupMockClientForIssues(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ResetTimeEstimate(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockIssues.EXPECT().
+			ResetTimeEstimate("group/project", int64(3), gomock.Any()).
+			Return(&gl.TimeStats{TotalTimeSpent: 3600}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"issueIid":  3.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"time_estimate":0`)
+	})
+
+	t.Run("Error - Missing issueIid", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: issueIid")
+	})
+}
+
+func TestAddTimeSpentHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := AddTimeSpent(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockIssues, ctrl := setupMockClientForIssues(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := AddTimeSpent(mockGetClient, nil)
+
+	t.Run("Success - With summary", func(t *testing.T) {
+		mockIssues.EXPECT().
+			AddSpentTime("group/project", int64(3), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ int64, opts *gl.AddSpentTimeOptions, _ ...gl.RequestOptionFunc) (*gl.TimeStats, *gl.Response, error) {
+				assert.Equal(t, "1h15m", *opts.Duration)
+				assert.Equal(t, "Code review", *opts.Summary)
+				return &gl.TimeStats{HumanTotalTimeSpent: "1h 15m", TotalTimeSpent: 4500}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"issueIid":  3.0,
+			"duration":  "1h15m",
+			"summary":   "Code review",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"total_time_spent":4500`)
+	})
+
+	t.Run("Success - Without summary", func(t *testing.T) {
+		mockIssues.EXPECT().
+			AddSpentTime("group/project", int64(3), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ int64, opts *gl.AddSpentTimeOptions, _ ...gl.RequestOptionFunc) (*gl.TimeStats, *gl.Response, error) {
+				assert.Nil(t, opts.Summary)
+				return &gl.TimeStats{TotalTimeSpent: 1800}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"issueIid":  3.0,
+			"duration":  "30m",
+		}}})
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+}
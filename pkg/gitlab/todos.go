@@ -0,0 +1,194 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gl "gitlab.com/gitlab-org/api/client-go"
+)
+
+// ListTodos defines the MCP tool for listing the to-do items of the current user.
+func ListTodos(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listTodos",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_TODOS_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab To-Do Items",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Optional filtering parameters
+			mcp.WithString("action",
+				mcp.Description("Return to-do items created by the given action."),
+				mcp.Enum("assigned", "mentioned", "build_failed", "marked", "approval_required", "unmergeable", "directly_addressed", "merge_train_removed"),
+			),
+			mcp.WithString("type",
+				mcp.Description("Return to-do items for the given target type."),
+				mcp.Enum("Issue", "MergeRequest", "Commit", "Epic", "DesignManagement::Design", "AlertManagement::Alert"),
+			),
+			mcp.WithString("state",
+				mcp.Description("Return to-do items in the given state (GitLab default: pending)."),
+				mcp.Enum("pending", "done"),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse optional filtering parameters
+			action, err := OptionalParam[string](&request, "action")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			targetType, err := OptionalParam[string](&request, "type")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			state, err := OptionalParam[string](&request, "state")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.ListTodosOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+			}
+
+			if action != "" {
+				opts.Action = gl.Ptr(gl.TodoAction(action))
+			}
+
+			if targetType != "" {
+				opts.Type = &targetType
+			}
+
+			if state != "" {
+				opts.State = &state
+			}
+
+			// --- Call GitLab API
+			todos, resp, err := glClient.Todos.ListTodos(opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, "to-do items")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(todos) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(todos)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal to-do items: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// MarkTodoDone defines the MCP tool for marking a single to-do item of the current user as done.
+func MarkTodoDone(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"markTodoDone",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_MARK_TODO_DONE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Mark GitLab To-Do Item as Done",
+			}),
+			// Required parameters
+			mcp.WithNumber("todoId",
+				mcp.Description("The ID of the to-do item."),
+				mcp.Required(),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			todoIDFloat, err := requiredParam[float64](&request, "todoId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+			todoID := int64(todoIDFloat)
+			if float64(todoID) != todoIDFloat {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: todoId %v is not a valid integer", todoIDFloat)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			resp, err := glClient.Todos.MarkTodoAsDone(todoID, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("to-do item %d", todoID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf(`{"message":"To-do item %d marked as done"}`, todoID)), nil
+		}
+}
+
+// MarkAllTodosDone defines the MCP tool for marking all pending to-do items of the current user as done.
+func MarkAllTodosDone(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"markAllTodosDone",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_MARK_ALL_TODOS_DONE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Mark All GitLab To-Do Items as Done",
+			}),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			resp, err := glClient.Todos.MarkAllTodosAsDone(gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, "to-do items", "mark all to-do items as done")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			return mcp.NewToolResultText(`{"message":"All pending to-do items marked as done"}`), nil
+		}
+}
@@ -0,0 +1,204 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+func TestListTodosHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListTodos(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockTodos, ctrl := setupMockClientForTodos(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListTodos(mockGetClient, nil)
+
+	tests := []struct {
+		name               string
+		inputArgs          map[string]any
+		mockSetup          func()
+		expectedText       string
+		expectHandlerError bool
+		expectResultError  bool
+		errorContains      string
+	}{
+		{
+			name:      "Success - Filters are forwarded",
+			inputArgs: map[string]any{"action": "assigned", "type": "MergeRequest", "state": "pending", "page": 2.0},
+			mockSetup: func() {
+				mockTodos.EXPECT().
+					ListTodos(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(opts *gl.ListTodosOptions, _ ...gl.RequestOptionFunc) ([]*gl.Todo, *gl.Response, error) {
+						assert.Equal(t, gl.TodoAction("assigned"), *opts.Action)
+						assert.Equal(t, "MergeRequest", *opts.Type)
+						assert.Equal(t, "pending", *opts.State)
+						assert.Equal(t, int64(2), opts.Page)
+						return []*gl.Todo{{ID: 5, ActionName: "assigned", TargetType: "MergeRequest"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+					})
+			},
+			expectedText: `"id":5`,
+		},
+		{
+			name:      "Success - Empty list",
+			inputArgs: map[string]any{},
+			mockSetup: func() {
+				mockTodos.EXPECT().
+					ListTodos(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(opts *gl.ListTodosOptions, _ ...gl.RequestOptionFunc) ([]*gl.Todo, *gl.Response, error) {
+						assert.Nil(t, opts.Action)
+						assert.Nil(t, opts.Type)
+						assert.Nil(t, opts.State)
+						return []*gl.Todo{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+					})
+			},
+			expectedText: "[]",
+		},
+		{
+			name:      "Error - Unauthorized (401)",
+			inputArgs: map[string]any{},
+			mockSetup: func() {
+				mockTodos.EXPECT().
+					ListTodos(gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 401}}, errors.New("gitlab: 401 Unauthorized"))
+			},
+			expectResultError: true,
+			errorContains:     "Authentication failed (401)",
+		},
+		{
+			name:      "Error - Server error (500)",
+			inputArgs: map[string]any{},
+			mockSetup: func() {
+				mockTodos.EXPECT().
+					ListTodos(gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 500}}, errors.New("gitlab: 500 Internal Server Error"))
+			},
+			expectHandlerError: true,
+			errorContains:      "failed to list to-do items",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.inputArgs}})
+
+			if tc.expectHandlerError {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			textContent := getTextResult(t, result)
+			if tc.expectResultError {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, tc.errorContains)
+				return
+			}
+			assert.Contains(t, textContent.Text, tc.expectedText)
+		})
+	}
+}
+
+func TestMarkTodoDoneHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := MarkTodoDone(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockTodos, ctrl := setupMockClientForTodos(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := MarkTodoDone(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockTodos.EXPECT().
+			MarkTodoAsDone(int64(5), gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 201}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"todoId": 5.0,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "To-do item 5 marked as done")
+	})
+
+	t.Run("Error - Non-integer todoId", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"todoId": 5.5,
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: todoId 5.5 is not a valid integer")
+	})
+
+	t.Run("Error - Not found (404)", func(t *testing.T) {
+		mockTodos.EXPECT().
+			MarkTodoAsDone(int64(999), gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"todoId": 999.0,
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "to-do item 999 not found")
+	})
+}
+
+func TestMarkAllTodosDoneHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := MarkAllTodosDone(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockTodos, ctrl := setupMockClientForTodos(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := MarkAllTodosDone(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockTodos.EXPECT().
+			MarkAllTodosAsDone(gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 204}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "All pending to-do items marked as done")
+	})
+
+	t.Run("Error - Server error (500)", func(t *testing.T) {
+		mockTodos.EXPECT().
+			MarkAllTodosAsDone(gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 500}}, errors.New("gitlab: 500 Internal Server Error"))
+
+		_, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{}}})
+		require.Error(t, err)
+	})
+}
@@ -11,6 +11,9 @@ import (
 	gl "gitlab.com/gitlab-org/api/client-go"
 )
 
+// DefaultTokenExpiryWarningDays is the default number of days before expiry from which a token is reported as expiring soon.
+const DefaultTokenExpiryWarningDays = 30
+
 // TokenMetadata stores information about a GitLab access token
 type TokenMetadata struct {
 	Token         string     `json:"-"`                   // Token value (not persisted to JSON)
@@ -22,6 +25,9 @@ type TokenMetadata struct {
 	UserID        int64      `json:"userId,omitempty"`    // GitLab user ID
 	Username      string     `json:"username,omitempty"`  // GitLab username
 	IsExpiredFlag bool       `json:"isExpired"`           // Flag indicating if token is expired
+	// ExpiryWarningDays is the number of days before expiry from which the token is reported
+	// as expiring soon. Zero means DefaultTokenExpiryWarningDays.
+	ExpiryWarningDays int `json:"expiryWarningDays,omitempty"`
 }
 
 // IsExpired checks if the token is expired or close to expiration
@@ -45,10 +51,34 @@ func (tm *TokenMetadata) DaysUntilExpiry() int {
 	return int(duration.Hours() / 24)
 }
 
+// ExpiresWithin reports whether the token has an expiry date and expires within the given number of days
+func (tm *TokenMetadata) ExpiresWithin(days int) bool {
+	if tm.ExpiresAt == nil || tm.IsExpired() {
+		return false
+	}
+	return tm.DaysUntilExpiry() <= days
+}
+
+// ExpiryWarningThreshold returns the number of days before expiry from which the token is
+// reported as expiring soon
+func (tm *TokenMetadata) ExpiryWarningThreshold() int {
+	if tm.ExpiryWarningDays <= 0 {
+		return DefaultTokenExpiryWarningDays
+	}
+	return tm.ExpiryWarningDays
+}
+
+// IsExpiringSoon reports whether the token expires within its expiry warning threshold
+func (tm *TokenMetadata) IsExpiringSoon() bool {
+	return tm.ExpiresWithin(tm.ExpiryWarningThreshold())
+}
+
 // TokenStore manages multiple GitLab tokens
 type TokenStore struct {
-	tokens map[string]*TokenMetadata // key: token name
-	mu     sync.RWMutex
+	tokens          map[string]*TokenMetadata // key: token name
+	defaultName     string                    // name of the token used when no server is specified
+	refreshHandlers []func(name string, metadata TokenMetadata)
+	mu              sync.RWMutex
 }
 
 // NewTokenStore creates a new token store
@@ -89,6 +119,36 @@ func (ts *TokenStore) GetToken(name string) (*TokenMetadata, error) {
 	return token, nil
 }
 
+// SetDefaultToken sets the name of the token used when no server is specified
+func (ts *TokenStore) SetDefaultToken(name string) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	ts.defaultName = name
+}
+
+// GetDefaultToken retrieves the default token. Without a default set, the only
+// stored token is returned.
+func (ts *TokenStore) GetDefaultToken() (*TokenMetadata, error) {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+
+	if ts.defaultName != "" {
+		token, ok := ts.tokens[ts.defaultName]
+		if !ok {
+			return nil, fmt.Errorf("default token '%s' not found", ts.defaultName)
+		}
+		return token, nil
+	}
+
+	if len(ts.tokens) == 1 {
+		for _, token := range ts.tokens {
+			return token, nil
+		}
+	}
+	return nil, fmt.Errorf("no default token configured")
+}
+
 // ListTokens returns all tokens in the store
 func (ts *TokenStore) ListTokens() map[string]*TokenMetadata {
 	ts.mu.RLock()
@@ -103,6 +163,20 @@ func (ts *TokenStore) ListTokens() map[string]*TokenMetadata {
 	return result
 }
 
+// TokenValues returns the values of all tokens in the store, e.g. to redact them from logs
+func (ts *TokenStore) TokenValues() []string {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+
+	values := make([]string, 0, len(ts.tokens))
+	for _, token := range ts.tokens {
+		if token.Token != "" {
+			values = append(values, token.Token)
+		}
+	}
+	return values
+}
+
 // RemoveToken removes a token from the store
 func (ts *TokenStore) RemoveToken(name string) error {
 	ts.mu.Lock()
@@ -153,6 +227,82 @@ func (ts *TokenStore) ValidateToken(ctx context.Context, name string, glClient *
 	return token, nil
 }
 
+// OnTokenRefreshed registers fn to be called with a copy of the metadata after RefreshToken
+// replaced a token, e.g. to recreate the client using it.
+func (ts *TokenStore) OnTokenRefreshed(fn func(name string, metadata TokenMetadata)) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	ts.refreshHandlers = append(ts.refreshHandlers, fn)
+}
+
+// RefreshToken replaces the token stored under name with newToken. glClient must be
+// authenticated with newToken; it is used to validate the token and read its expiry.
+// A non-empty host also replaces the stored GitLab host. The user information and
+// LastValidated are updated before the refresh handlers are notified.
+//
+// The caller builds glClient because the store does not know how clients are
+// created: the ClientPool applies the configured proxy and TLS settings, which a
+// client built here would bypass. The returned metadata is a copy.
+func (ts *TokenStore) RefreshToken(ctx context.Context, name, newToken, host string, glClient *gl.Client) (*TokenMetadata, error) {
+	if newToken == "" {
+		return nil, fmt.Errorf("new token for '%s' cannot be empty", name)
+	}
+	if _, err := ts.GetToken(name); err != nil {
+		return nil, err
+	}
+
+	user, resp, err := glClient.Users.CurrentUser(gl.WithContext(ctx))
+	if err != nil {
+		if resp != nil && resp.StatusCode == 401 {
+			return nil, fmt.Errorf("new token for '%s' is invalid or expired (401)", name)
+		}
+		return nil, fmt.Errorf("failed to validate new token for '%s': %w", name, err)
+	}
+	expiresAt := FetchTokenExpiry(ctx, glClient)
+
+	ts.mu.Lock()
+	token, ok := ts.tokens[name]
+	if !ok {
+		ts.mu.Unlock()
+		return nil, fmt.Errorf("token '%s' not found", name)
+	}
+	// Store a new value rather than changing the old one, which callers of
+	// GetToken may still be reading
+	refreshed := *token
+	refreshed.Token = newToken
+	if host != "" {
+		refreshed.GitLabHost = host
+	}
+	refreshed.ExpiresAt = expiresAt
+	refreshed.UserID = user.ID
+	refreshed.Username = user.Username
+	refreshed.LastValidated = time.Now()
+	refreshed.IsExpiredFlag = false
+	stored := refreshed
+	ts.tokens[name] = &stored
+	handlers := append([]func(string, TokenMetadata){}, ts.refreshHandlers...)
+	ts.mu.Unlock()
+
+	// Handlers run without the lock so that they may use the store
+	for _, handler := range handlers {
+		handler(name, refreshed)
+	}
+	return &refreshed, nil
+}
+
+// FetchTokenExpiry returns the expiry date of the personal access token glClient is
+// authenticated with. It returns nil if the token has no expiry or is not a personal
+// access token, since GitLab rejects other token types on this endpoint.
+func FetchTokenExpiry(ctx context.Context, glClient *gl.Client) *time.Time {
+	pat, _, err := glClient.PersonalAccessTokens.GetSinglePersonalAccessToken(gl.WithContext(ctx))
+	if err != nil || pat == nil || pat.ExpiresAt == nil {
+		return nil
+	}
+	expiresAt := time.Time(*pat.ExpiresAt)
+	return &expiresAt
+}
+
 // CheckAllTokens validates all stored tokens and returns results
 // Note: This function does NOT hold a lock while calling ValidateToken to avoid deadlock.
 // ValidateToken acquires its own lock, so we need to release our lock before calling it.
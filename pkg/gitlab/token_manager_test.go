@@ -233,6 +233,53 @@ func TestTokenStore_GetToken(t *testing.T) {
 	}
 }
 
+func TestTokenStore_GetDefaultToken(t *testing.T) {
+	t.Run("Error - Empty store", func(t *testing.T) {
+		ts := NewTokenStore()
+		_, err := ts.GetDefaultToken()
+		assert.EqualError(t, err, "no default token configured")
+	})
+
+	t.Run("Success - Only token is the default", func(t *testing.T) {
+		ts := NewTokenStore()
+		require.NoError(t, ts.AddToken("work", &TokenMetadata{Token: "token1"}))
+
+		token, err := ts.GetDefaultToken()
+		require.NoError(t, err)
+		assert.Equal(t, "work", token.Name)
+	})
+
+	t.Run("Error - Several tokens without default", func(t *testing.T) {
+		ts := NewTokenStore()
+		require.NoError(t, ts.AddToken("work", &TokenMetadata{Token: "token1"}))
+		require.NoError(t, ts.AddToken("personal", &TokenMetadata{Token: "token2"}))
+
+		_, err := ts.GetDefaultToken()
+		assert.EqualError(t, err, "no default token configured")
+	})
+
+	t.Run("Success - Explicit default", func(t *testing.T) {
+		ts := NewTokenStore()
+		require.NoError(t, ts.AddToken("work", &TokenMetadata{Token: "token1"}))
+		require.NoError(t, ts.AddToken("personal", &TokenMetadata{Token: "token2"}))
+		ts.SetDefaultToken("personal")
+
+		token, err := ts.GetDefaultToken()
+		require.NoError(t, err)
+		assert.Equal(t, "personal", token.Name)
+	})
+
+	t.Run("Error - Default removed", func(t *testing.T) {
+		ts := NewTokenStore()
+		require.NoError(t, ts.AddToken("work", &TokenMetadata{Token: "token1"}))
+		ts.SetDefaultToken("work")
+		require.NoError(t, ts.RemoveToken("work"))
+
+		_, err := ts.GetDefaultToken()
+		assert.EqualError(t, err, "default token 'work' not found")
+	})
+}
+
 func TestTokenStore_ListTokens(t *testing.T) {
 	ts := NewTokenStore()
 
@@ -264,6 +311,17 @@ func TestTokenStore_ListTokens(t *testing.T) {
 	})
 }
 
+func TestTokenStore_TokenValues(t *testing.T) {
+	ts := NewTokenStore()
+	assert.Empty(t, ts.TokenValues())
+
+	require.NoError(t, ts.AddToken("work", &TokenMetadata{Token: "token1", CreatedAt: time.Now()}))
+	require.NoError(t, ts.AddToken("personal", &TokenMetadata{Token: "token2", CreatedAt: time.Now()}))
+	require.NoError(t, ts.AddToken("empty", &TokenMetadata{CreatedAt: time.Now()}))
+
+	assert.ElementsMatch(t, []string{"token1", "token2"}, ts.TokenValues())
+}
+
 func TestTokenStore_RemoveToken(t *testing.T) {
 	ts := NewTokenStore()
 
@@ -365,6 +423,113 @@ func TestTokenStore_ConcurrentAccess(t *testing.T) {
 	assert.Less(t, len(tokens), numGoroutines)
 }
 
+func TestTokenStore_RefreshToken(t *testing.T) {
+	ctx := context.Background()
+	lastValidated := time.Now().Add(-24 * time.Hour)
+	oldExpiry := time.Now().Add(2 * 24 * time.Hour)
+
+	newStore := func() *TokenStore {
+		ts := NewTokenStore()
+		require.NoError(t, ts.AddToken("work", &TokenMetadata{
+			Token:         "old-token",
+			GitLabHost:    "https://gitlab.example.com",
+			ExpiresAt:     &oldExpiry,
+			LastValidated: lastValidated,
+			IsExpiredFlag: true,
+		}))
+		return ts
+	}
+
+	t.Run("Success - Token replaced and handlers notified", func(t *testing.T) {
+		ts := newStore()
+		var notified []TokenMetadata
+		ts.OnTokenRefreshed(func(name string, metadata TokenMetadata) {
+			assert.Equal(t, "work", name)
+			notified = append(notified, metadata)
+		})
+
+		testClient := gltesting.NewTestClient(t)
+		testClient.MockUsers.EXPECT().
+			CurrentUser(gomock.Any()).
+			Return(&gl.User{ID: 7, Username: "rotated"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+		newExpiry := gl.ISOTime(time.Date(2030, 1, 15, 0, 0, 0, 0, time.UTC))
+		testClient.MockPersonalAccessTokens.EXPECT().
+			GetSinglePersonalAccessToken(gomock.Any()).
+			Return(&gl.PersonalAccessToken{ExpiresAt: &newExpiry}, nil, nil)
+
+		metadata, err := ts.RefreshToken(ctx, "work", "new-token", "", testClient.Client)
+		require.NoError(t, err)
+		assert.Equal(t, "new-token", metadata.Token)
+		assert.Equal(t, "https://gitlab.example.com", metadata.GitLabHost)
+		assert.Equal(t, int64(7), metadata.UserID)
+		assert.Equal(t, "rotated", metadata.Username)
+		assert.False(t, metadata.IsExpiredFlag)
+		assert.True(t, metadata.LastValidated.After(lastValidated))
+		require.NotNil(t, metadata.ExpiresAt)
+		assert.Equal(t, "2030-01-15", metadata.ExpiresAt.Format("2006-01-02"))
+
+		require.Len(t, notified, 1)
+		assert.Equal(t, "new-token", notified[0].Token)
+
+		// The result is a copy; changing it does not affect the store
+		metadata.Token = "changed"
+		stored, err := ts.GetToken("work")
+		require.NoError(t, err)
+		assert.Equal(t, "new-token", stored.Token)
+	})
+
+	t.Run("Success - Host replaced, expiry unknown", func(t *testing.T) {
+		ts := newStore()
+
+		testClient := gltesting.NewTestClient(t)
+		testClient.MockUsers.EXPECT().
+			CurrentUser(gomock.Any()).
+			Return(&gl.User{ID: 7, Username: "rotated"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+		testClient.MockPersonalAccessTokens.EXPECT().
+			GetSinglePersonalAccessToken(gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, fmt.Errorf("404 Not Found"))
+
+		metadata, err := ts.RefreshToken(ctx, "work", "new-token", "https://gitlab.com", testClient.Client)
+		require.NoError(t, err)
+		assert.Equal(t, "https://gitlab.com", metadata.GitLabHost)
+		assert.Nil(t, metadata.ExpiresAt, "the old token's expiry must not carry over")
+	})
+
+	t.Run("Error - New token rejected", func(t *testing.T) {
+		ts := newStore()
+		ts.OnTokenRefreshed(func(string, TokenMetadata) {
+			t.Error("handlers must not be notified when the refresh fails")
+		})
+
+		testClient := gltesting.NewTestClient(t)
+		testClient.MockUsers.EXPECT().
+			CurrentUser(gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 401}}, fmt.Errorf("401 Unauthorized"))
+
+		_, err := ts.RefreshToken(ctx, "work", "bad-token", "", testClient.Client)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "new token for 'work' is invalid or expired (401)")
+
+		stored, err := ts.GetToken("work")
+		require.NoError(t, err)
+		assert.Equal(t, "old-token", stored.Token)
+	})
+
+	t.Run("Error - Unknown token", func(t *testing.T) {
+		ts := newStore()
+		_, err := ts.RefreshToken(ctx, "personal", "new-token", "", nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "token 'personal' not found")
+	})
+
+	t.Run("Error - Empty token", func(t *testing.T) {
+		ts := newStore()
+		_, err := ts.RefreshToken(ctx, "work", "", "", nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "new token for 'work' cannot be empty")
+	})
+}
+
 func TestTokenStore_ValidateToken(t *testing.T) {
 	ts := NewTokenStore()
 
@@ -11,12 +11,18 @@ import (
 	gl "gitlab.com/gitlab-org/api/client-go"
 )
 
-// ClientFactory creates a GitLab client from a token
-type ClientFactory func(token string, opts ...gl.ClientOptionFunc) (*gl.Client, error)
-
-// DefaultClientFactory creates a real GitLab client
-func DefaultClientFactory(token string, opts ...gl.ClientOptionFunc) (*gl.Client, error) {
-	return gl.NewClient(token, opts...)
+// ClientFactory creates a GitLab client from a token for host (empty or "https://gitlab.com"
+// for gitlab.com)
+type ClientFactory func(token, host string) (*gl.Client, error)
+
+// DefaultClientFactory creates a real GitLab client with the default transport. The server
+// uses ClientPool.NewClient instead, so that the configured proxy and TLS settings apply.
+func DefaultClientFactory(token, host string) (*gl.Client, error) {
+	opts := []gl.ClientOptionFunc{}
+	if host != "" && host != "https://gitlab.com" {
+		opts = append(opts, gl.WithBaseURL(host))
+	}
+	return NewClient(token, DefaultMaxRetries, opts...)
 }
 
 // AddToken adds a new GitLab token configuration
@@ -65,12 +71,7 @@ func AddToken(clientFactory ClientFactory, logger *log.Logger, tokenStore *Token
 			}
 
 			// Create client to validate token
-			clientOpts := []gl.ClientOptionFunc{}
-			if gitlabHost != "" && gitlabHost != "https://gitlab.com" {
-				clientOpts = append(clientOpts, gl.WithBaseURL(gitlabHost))
-			}
-
-			glClient, err := clientFactory(token, clientOpts...)
+			glClient, err := clientFactory(token, gitlabHost)
 			if err != nil {
 				return mcp.NewToolResultError(fmt.Sprintf("Failed to create GitLab client: %v", err)), nil
 			}
@@ -156,7 +157,8 @@ func ListTokens(tokenStore *TokenStore) (tool mcp.Tool, handler server.ToolHandl
 		}
 }
 
-// UpdateToken updates an existing token configuration
+// UpdateToken replaces a stored token (the default one unless a name is given) with a new,
+// validated token. The pooled client of that server is recreated with the new token.
 func UpdateToken(clientFactory ClientFactory, logger *log.Logger, tokenStore *TokenStore) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	// Use default factory if none provided
 	if clientFactory == nil {
@@ -164,13 +166,16 @@ func UpdateToken(clientFactory ClientFactory, logger *log.Logger, tokenStore *To
 	}
 	return mcp.NewTool(
 			"updateToken",
-			mcp.WithDescription("Updates an existing GitLab token. Validates the new token before updating."),
-			mcp.WithString("name",
+			mcp.WithDescription("Replaces a GitLab token at runtime, e.g. after rotating an expired token. Validates the new token before updating."),
+			mcp.WithString("token",
 				mcp.Required(),
-				mcp.Description("Token name to update."),
+				mcp.Description("New GitLab Personal Access Token."),
 			),
-			mcp.WithString("token",
-				mcp.Description("New GitLab Personal Access Token. If not provided, only revalidates existing token."),
+			mcp.WithString("host",
+				mcp.Description("Optional: New GitLab host URL for the token. Defaults to the currently stored host."),
+			),
+			mcp.WithString("name",
+				mcp.Description("Optional: Token name to update. Defaults to the default server's token."),
 			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -178,60 +183,48 @@ func UpdateToken(clientFactory ClientFactory, logger *log.Logger, tokenStore *To
 				"This tool will be removed in v3.0 for security. Configure tokens via " +
 				"'gitlab-mcp-server config add' (CLI) instead.")
 
-			// Parse parameters
-			name, err := requiredParam[string](&request, "name")
+			// --- Parse parameters
+			newToken, err := requiredParam[string](&request, "token")
 			if err != nil {
 				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
 			}
-
-			// Get existing token
-			existing, err := tokenStore.GetToken(name)
+			host, err := OptionalParam[string](&request, "host")
 			if err != nil {
-				return mcp.NewToolResultError(fmt.Sprintf("Token '%s' not found: %v", name, err)), nil
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
 			}
-
-			// Check if new token value is provided
-			args := request.GetArguments()
-			newToken, hasNewToken := args["token"].(string)
-
-			var tokenToValidate string
-			if hasNewToken {
-				tokenToValidate = newToken
-			} else {
-				tokenToValidate = existing.Token
+			name, err := OptionalParam[string](&request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
 			}
 
-			// Create client to validate token
-			clientOpts := []gl.ClientOptionFunc{}
-			if existing.GitLabHost != "" && existing.GitLabHost != "https://gitlab.com" {
-				clientOpts = append(clientOpts, gl.WithBaseURL(existing.GitLabHost))
+			// Get existing token
+			var existing *TokenMetadata
+			if name == "" {
+				existing, err = tokenStore.GetDefaultToken()
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("Default token not found: %v. Pass the token name explicitly.", err)), nil
+				}
+				name = existing.Name
+			} else if existing, err = tokenStore.GetToken(name); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Token '%s' not found: %v", name, err)), nil
 			}
 
-			glClient, err := clientFactory(tokenToValidate, clientOpts...)
+			// Create client to validate the new token
+			targetHost := existing.GitLabHost
+			if host != "" {
+				targetHost = host
+			}
+			glClient, err := clientFactory(newToken, targetHost)
 			if err != nil {
 				return mcp.NewToolResultError(fmt.Sprintf("Failed to create GitLab client: %v", err)), nil
 			}
 
-			// Validate token
-			metadata, err := tokenStore.ValidateToken(ctx, name, glClient)
+			// Validate and store the new token
+			metadata, err := tokenStore.RefreshToken(ctx, name, newToken, host, glClient)
 			if err != nil {
 				return mcp.NewToolResultError(fmt.Sprintf("Token validation failed: %v", err)), nil
 			}
 
-			// Update metadata
-			if hasNewToken {
-				metadata.Token = newToken
-			} else {
-				metadata.Token = existing.Token
-			}
-			metadata.GitLabHost = existing.GitLabHost
-			metadata.CreatedAt = existing.CreatedAt
-
-			// Re-add to store
-			if err := tokenStore.AddToken(name, metadata); err != nil {
-				return mcp.NewToolResultError(fmt.Sprintf("Failed to update token: %v", err)), nil
-			}
-
 			// Send notification
 			notifyTokenValidated(logger, name, metadata.UserID, metadata.Username)
 
@@ -240,12 +233,16 @@ func UpdateToken(clientFactory ClientFactory, logger *log.Logger, tokenStore *To
 				"success":            true,
 				"message":            fmt.Sprintf("Token '%s' updated successfully", name),
 				"tokenName":          name,
+				"gitlabHost":         metadata.GitLabHost,
 				"userId":             metadata.UserID,
 				"username":           metadata.Username,
-				"updated":            hasNewToken,
+				"updated":            true,
 				"deprecated":         true,
 				"deprecationMessage": "updateToken will be removed in v3.0. Use 'gitlab-mcp-server config add' CLI instead.",
 			}
+			if metadata.ExpiresAt != nil {
+				result["expiresAt"] = metadata.ExpiresAt
+			}
 
 			data, _ := json.MarshalIndent(result, "", "  ")
 			return mcp.NewToolResultText(string(data)), nil
@@ -279,11 +276,7 @@ func ValidateToken(clientFactory ClientFactory, logger *log.Logger, tokenStore *
 				}
 
 				// Create client using factory
-				clientOpts := []gl.ClientOptionFunc{}
-				if metadata.GitLabHost != "" && metadata.GitLabHost != "https://gitlab.com" {
-					clientOpts = append(clientOpts, gl.WithBaseURL(metadata.GitLabHost))
-				}
-				return clientFactory(metadata.Token, clientOpts...)
+				return clientFactory(metadata.Token, metadata.GitLabHost)
 			}
 
 			if tokenName != "" {
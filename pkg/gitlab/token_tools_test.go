@@ -3,6 +3,7 @@ package gitlab
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -95,7 +96,7 @@ func TestAddTokenHandler(t *testing.T) {
 			}, nil)
 
 		// Create a mock client factory that returns our test client
-		mockClientFactory := func(token string, opts ...gl.ClientOptionFunc) (*gl.Client, error) {
+		mockClientFactory := func(token, host string) (*gl.Client, error) {
 			return testClient.Client, nil
 		}
 
@@ -156,7 +157,7 @@ func TestAddTokenHandler(t *testing.T) {
 			}, nil)
 
 		// Create a mock client factory
-		mockClientFactory := func(token string, opts ...gl.ClientOptionFunc) (*gl.Client, error) {
+		mockClientFactory := func(token, host string) (*gl.Client, error) {
 			return testClient.Client, nil
 		}
 
@@ -294,10 +295,16 @@ func TestUpdateTokenHandler(t *testing.T) {
 		errorContains     string
 	}{
 		{
-			name:              "Error - Missing name",
-			inputArgs:         map[string]any{},
+			name:              "Error - Missing token",
+			inputArgs:         map[string]any{"name": "work"},
 			expectResultError: true,
-			errorContains:     "Validation Error: missing required parameter: name",
+			errorContains:     "Validation Error: missing required parameter: token",
+		},
+		{
+			name:              "Error - Token not found",
+			inputArgs:         map[string]any{"name": "nonexistent", "token": "new-token"},
+			expectResultError: true,
+			errorContains:     "Token 'nonexistent' not found",
 		},
 	}
 
@@ -324,116 +331,94 @@ func TestUpdateTokenHandler(t *testing.T) {
 		})
 	}
 
-	// GitLab API tests with proper mocking
-	t.Run("Error - Token not found", func(t *testing.T) {
-		// This tests the error path when token doesn't exist in store
+	t.Run("Error - No default token", func(t *testing.T) {
+		store := NewTokenStore()
+		_ = store.AddToken("work", &TokenMetadata{Token: "work-token"})
+		_ = store.AddToken("personal", &TokenMetadata{Token: "personal-token"})
+		_, handler := UpdateToken(nil, logger, store)
+
 		req := mcp.CallToolRequest{
 			Params: mcp.CallToolParams{
 				Name:      tool.Name,
-				Arguments: map[string]any{"name": "nonexistent"},
+				Arguments: map[string]any{"token": "new-token"},
 			},
 		}
 
 		result, err := handler(context.Background(), req)
 		require.NoError(t, err)
-		require.NotNil(t, result)
-
-		textContent := getTextResult(t, result)
-		assert.Contains(t, textContent.Text, "Token 'nonexistent' not found")
+		require.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "Default token not found")
 	})
 
-	t.Run("Success - Revalidate existing token", func(t *testing.T) {
-		// Create a test client with mocked services
+	t.Run("Error - New token rejected", func(t *testing.T) {
 		testClient := gltesting.NewTestClient(t)
-
-		// Setup expectation for CurrentUser call
 		testClient.MockUsers.EXPECT().
 			CurrentUser(gomock.Any()).
-			Return(&gl.User{
-				ID:       789,
-				Username: "workuser",
-				Name:     "Work User",
-				Email:    "work@example.com",
-			}, &gl.Response{
-				Response: &http.Response{
-					StatusCode: 200,
-				},
-			}, nil)
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 401}}, errors.New("401 Unauthorized"))
 
-		// Create a mock client factory
-		mockClientFactory := func(token string, opts ...gl.ClientOptionFunc) (*gl.Client, error) {
+		mockClientFactory := func(token, host string) (*gl.Client, error) {
 			return testClient.Client, nil
 		}
-
-		tool, handler := UpdateToken(mockClientFactory, logger, tokenStore)
+		_, handler := UpdateToken(mockClientFactory, logger, tokenStore)
 
 		req := mcp.CallToolRequest{
 			Params: mcp.CallToolParams{
 				Name:      tool.Name,
-				Arguments: map[string]any{"name": "work"}, // No new token provided, just revalidate
+				Arguments: map[string]any{"name": "work", "token": "bad-token"},
 			},
 		}
 
 		result, err := handler(context.Background(), req)
 		require.NoError(t, err)
-		require.NotNil(t, result)
-
-		textContent := getTextResult(t, result)
-
-		// Verify response
-		var resultMap map[string]interface{}
-		err = json.Unmarshal([]byte(textContent.Text), &resultMap)
-		require.NoError(t, err)
-		assert.True(t, resultMap["success"].(bool))
-		assert.Equal(t, false, resultMap["updated"]) // No new token provided
-		assert.Equal(t, 789, int(resultMap["userId"].(float64)))
+		require.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "is invalid or expired (401)")
 
-		// Verify token metadata was updated
 		storedToken, err := tokenStore.GetToken("work")
 		require.NoError(t, err)
-		assert.Equal(t, int64(789), storedToken.UserID)
-		assert.Equal(t, "workuser", storedToken.Username)
+		assert.Equal(t, "old-token", storedToken.Token, "a rejected token must not replace the stored one")
 	})
 
-	t.Run("Success - Update with new token value", func(t *testing.T) {
-		// Setup: Create another existing token
-		existingMetadata := &TokenMetadata{
+	t.Run("Success - Replace default token", func(t *testing.T) {
+		store := NewTokenStore()
+		_ = store.AddToken("personal", &TokenMetadata{
 			Token:      "old-token-value",
 			GitLabHost: "https://gitlab.com",
 			CreatedAt:  time.Now(),
-		}
-		_ = tokenStore.AddToken("personal", existingMetadata)
+		})
+		store.SetDefaultToken("personal")
 
 		// Create a test client with mocked services
 		testClient := gltesting.NewTestClient(t)
-
-		// Setup expectation for CurrentUser call with new token
 		testClient.MockUsers.EXPECT().
 			CurrentUser(gomock.Any()).
 			Return(&gl.User{
 				ID:       999,
 				Username: "personaluser",
-				Name:     "Personal User",
-				Email:    "personal@example.com",
 			}, &gl.Response{
 				Response: &http.Response{
 					StatusCode: 200,
 				},
 			}, nil)
+		expiresAt := gl.ISOTime(time.Date(2030, 1, 15, 0, 0, 0, 0, time.UTC))
+		testClient.MockPersonalAccessTokens.EXPECT().
+			GetSinglePersonalAccessToken(gomock.Any()).
+			Return(&gl.PersonalAccessToken{ExpiresAt: &expiresAt}, nil, nil)
 
 		// Create a mock client factory
-		mockClientFactory := func(token string, opts ...gl.ClientOptionFunc) (*gl.Client, error) {
+		var factoryToken string
+		mockClientFactory := func(token, host string) (*gl.Client, error) {
+			factoryToken = token
 			return testClient.Client, nil
 		}
 
-		tool, handler := UpdateToken(mockClientFactory, logger, tokenStore)
+		tool, handler := UpdateToken(mockClientFactory, logger, store)
 
 		req := mcp.CallToolRequest{
 			Params: mcp.CallToolParams{
 				Name: tool.Name,
 				Arguments: map[string]any{
-					"name":  "personal",
-					"token": "new-personal-token", // Provide new token value
+					"token": "new-personal-token",
+					"host":  "https://gitlab.example.com",
 				},
 			},
 		}
@@ -441,6 +426,8 @@ func TestUpdateTokenHandler(t *testing.T) {
 		result, err := handler(context.Background(), req)
 		require.NoError(t, err)
 		require.NotNil(t, result)
+		require.False(t, result.IsError, getTextResult(t, result).Text)
+		assert.Equal(t, "new-personal-token", factoryToken)
 
 		textContent := getTextResult(t, result)
 
@@ -449,14 +436,19 @@ func TestUpdateTokenHandler(t *testing.T) {
 		err = json.Unmarshal([]byte(textContent.Text), &resultMap)
 		require.NoError(t, err)
 		assert.True(t, resultMap["success"].(bool))
-		assert.Equal(t, true, resultMap["updated"]) // New token provided
+		assert.Equal(t, "personal", resultMap["tokenName"])
+		assert.Equal(t, "https://gitlab.example.com", resultMap["gitlabHost"])
 		assert.Equal(t, 999, int(resultMap["userId"].(float64)))
+		assert.NotContains(t, textContent.Text, "new-personal-token")
 
 		// Verify token metadata was updated with new token
-		storedToken, err := tokenStore.GetToken("personal")
+		storedToken, err := store.GetToken("personal")
 		require.NoError(t, err)
 		assert.Equal(t, "new-personal-token", storedToken.Token)
+		assert.Equal(t, "https://gitlab.example.com", storedToken.GitLabHost)
 		assert.Equal(t, int64(999), storedToken.UserID)
+		require.NotNil(t, storedToken.ExpiresAt)
+		assert.Equal(t, "2030-01-15", storedToken.ExpiresAt.Format("2006-01-02"))
 	})
 }
 
@@ -517,7 +509,7 @@ func TestValidateTokenHandler(t *testing.T) {
 			}, nil)
 
 		// Create a mock client factory
-		mockClientFactory := func(token string, opts ...gl.ClientOptionFunc) (*gl.Client, error) {
+		mockClientFactory := func(token, host string) (*gl.Client, error) {
 			return testClient.Client, nil
 		}
 
@@ -595,7 +587,7 @@ func TestValidateTokenHandler(t *testing.T) {
 			}, nil)
 
 		// Create a mock client factory
-		mockClientFactory := func(token string, opts ...gl.ClientOptionFunc) (*gl.Client, error) {
+		mockClientFactory := func(token, host string) (*gl.Client, error) {
 			return testClient.Client, nil
 		}
 
@@ -645,7 +637,7 @@ func TestValidateTokenHandler(t *testing.T) {
 			Return(nil, (*gl.Response)(nil), assert.AnError)
 
 		// Create a mock client factory
-		mockClientFactory := func(token string, opts ...gl.ClientOptionFunc) (*gl.Client, error) {
+		mockClientFactory := func(token, host string) (*gl.Client, error) {
 			return testClient.Client, nil
 		}
 
@@ -908,7 +900,7 @@ func TestTokenManagementIntegration(t *testing.T) {
 			}, nil)
 
 		// Create a mock client factory
-		mockClientFactory := func(token string, opts ...gl.ClientOptionFunc) (*gl.Client, error) {
+		mockClientFactory := func(token, host string) (*gl.Client, error) {
 			return testClient.Client, nil
 		}
 
@@ -987,21 +979,62 @@ func TestDefaultClientFactory(t *testing.T) {
 	t.Run("Success - Create client with token", func(t *testing.T) {
 		token := "glpat-test123"
 
-		client, err := DefaultClientFactory(token)
+		client, err := DefaultClientFactory(token, "")
 		require.NoError(t, err)
 		assert.NotNil(t, client)
 	})
 
-	t.Run("Success - Create client with options", func(t *testing.T) {
+	t.Run("Success - Create client for custom host", func(t *testing.T) {
 		token := "glpat-test456"
 
-		// Create client with custom base URL
-		client, err := DefaultClientFactory(token, gl.WithBaseURL("https://gitlab.example.com"))
+		client, err := DefaultClientFactory(token, "https://gitlab.example.com")
 		require.NoError(t, err)
-		assert.NotNil(t, client)
+		assert.Equal(t, "https://gitlab.example.com/api/v4/", client.BaseURL().String())
 	})
 }
 
+// TestUpdateToken_UsesPoolTransport checks that the client validating a new token is built
+// with the pool's transport, so that a configured proxy is used.
+func TestUpdateToken_UsesPoolTransport(t *testing.T) {
+	logger := log.New()
+	logger.SetLevel(log.ErrorLevel)
+
+	var proxiedHost string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// A forward proxy receives the absolute URL of the target
+		proxiedHost = r.URL.Host
+		if r.URL.Path != "/api/v4/user" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":7,"username":"proxied"}`))
+	}))
+	defer proxy.Close()
+
+	store := NewTokenStore()
+	require.NoError(t, store.AddToken("work", &TokenMetadata{Token: "old-token", GitLabHost: "http://gitlab.invalid"}))
+	cp := NewClientPool(store, logger)
+	require.NoError(t, cp.SetProxy(ProxyConfig{HTTPProxy: proxy.URL}))
+
+	_, handler := UpdateToken(cp.NewClient, logger, store)
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]any{
+		"token": "new-token",
+		"name":  "work",
+	}
+	result, err := handler(context.Background(), req)
+	require.NoError(t, err)
+	require.False(t, result.IsError, getTextResult(t, result).Text)
+	assert.Equal(t, "gitlab.invalid", proxiedHost)
+
+	stored, err := store.GetToken("work")
+	require.NoError(t, err)
+	assert.Equal(t, "new-token", stored.Token)
+	assert.Equal(t, "proxied", stored.Username)
+}
+
 func TestAddToken_EmitsDeprecationFields(t *testing.T) {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/api/v4/user", func(w http.ResponseWriter, _ *http.Request) {
@@ -1014,8 +1047,8 @@ func TestAddToken_EmitsDeprecationFields(t *testing.T) {
 	logger := log.New()
 	store := NewTokenStore()
 
-	factory := func(token string, opts ...gl.ClientOptionFunc) (*gl.Client, error) {
-		return gl.NewClient(token, append(opts, gl.WithBaseURL(srv.URL))...)
+	factory := func(token, host string) (*gl.Client, error) {
+		return gl.NewClient(token, gl.WithBaseURL(host))
 	}
 
 	_, handler := AddToken(factory, logger, store)
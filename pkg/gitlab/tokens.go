@@ -0,0 +1,54 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// tokenInfo is the JSON document returned by getTokenInfo. The raw token is always redacted.
+type tokenInfo struct {
+	*TokenMetadata
+	Token           string `json:"token"`
+	IsExpired       bool   `json:"isExpired"`
+	DaysUntilExpiry int    `json:"daysUntilExpiry"`
+	Warning         string `json:"warning,omitempty"`
+}
+
+// GetTokenInfo returns the metadata of the default token without exposing the token itself
+func GetTokenInfo(tokenStore *TokenStore, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"getTokenInfo",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_TOKEN_INFO_DESCRIPTION)),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "Get Token Info",
+				ReadOnlyHint: boolPtr(true),
+			}),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			metadata, err := tokenStore.GetDefaultToken()
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Failed to get token info: %v", err)), nil
+			}
+
+			info := tokenInfo{
+				TokenMetadata:   metadata,
+				Token:           "[REDACTED]",
+				IsExpired:       metadata.IsExpired(),
+				DaysUntilExpiry: metadata.DaysUntilExpiry(),
+			}
+			if metadata.IsExpiringSoon() {
+				info.Warning = fmt.Sprintf("Token expires in %d days. Please create a new token and update it.", info.DaysUntilExpiry)
+			}
+
+			data, err := json.MarshalIndent(info, "", "  ")
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal token info: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
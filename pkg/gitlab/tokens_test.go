@@ -0,0 +1,183 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+// TestGetTokenInfoHandler tests the GetTokenInfo tool
+func TestGetTokenInfoHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetTokenInfo(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	expiresIn := func(days int) *time.Time {
+		expiresAt := time.Now().Add(time.Duration(days)*24*time.Hour + time.Hour)
+		return &expiresAt
+	}
+
+	tests := []struct {
+		name          string
+		metadata      *TokenMetadata
+		expectError   bool
+		expectWarning bool
+	}{
+		{
+			name: "Success - Token expiring soon",
+			metadata: &TokenMetadata{
+				Token:      "glpat-secret",
+				GitLabHost: "https://gitlab.com",
+				Username:   "user1",
+				UserID:     123,
+				ExpiresAt:  expiresIn(5),
+			},
+			expectWarning: true,
+		},
+		{
+			name: "Success - Token not expiring soon",
+			metadata: &TokenMetadata{
+				Token:      "glpat-secret",
+				GitLabHost: "https://gitlab.com",
+				Username:   "user1",
+				UserID:     123,
+				ExpiresAt:  expiresIn(90),
+			},
+		},
+		{
+			name: "Success - Token without expiry",
+			metadata: &TokenMetadata{
+				Token:      "glpat-secret",
+				GitLabHost: "https://gitlab.com",
+				Username:   "user1",
+				UserID:     123,
+			},
+		},
+		{
+			name:        "Error - No token configured",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tokenStore := NewTokenStore()
+			if tc.metadata != nil {
+				require.NoError(t, tokenStore.AddToken("work", tc.metadata))
+			}
+			_, handler := GetTokenInfo(tokenStore, nil)
+
+			result, err := handler(context.Background(), mcp.CallToolRequest{})
+			require.NoError(t, err)
+			require.NotNil(t, result)
+
+			textContent := getTextResult(t, result)
+			if tc.expectError {
+				assert.True(t, result.IsError)
+				assert.Contains(t, textContent.Text, "no default token configured")
+				return
+			}
+			require.False(t, result.IsError)
+			assert.NotContains(t, textContent.Text, "glpat-secret")
+
+			var info map[string]any
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &info))
+			assert.Equal(t, "[REDACTED]", info["token"])
+			assert.Equal(t, "work", info["name"])
+			assert.Equal(t, "https://gitlab.com", info["gitlabHost"])
+			assert.Equal(t, "user1", info["username"])
+			assert.Equal(t, float64(123), info["userId"])
+			assert.Equal(t, false, info["isExpired"])
+			if tc.expectWarning {
+				assert.Equal(t, "Token expires in 5 days. Please create a new token and update it.", info["warning"])
+			} else {
+				assert.NotContains(t, info, "warning")
+			}
+		})
+	}
+}
+
+func TestTokenMetadata_IsExpiringSoon(t *testing.T) {
+	// An extra hour keeps DaysUntilExpiry from rounding down while the test runs
+	expiresIn := func(days int) *time.Time {
+		expiresAt := time.Now().Add(time.Duration(days)*24*time.Hour + time.Hour)
+		return &expiresAt
+	}
+	past := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name              string
+		metadata          *TokenMetadata
+		expected          bool
+		expectedThreshold int
+	}{
+		{
+			name:              "No expiration set",
+			metadata:          &TokenMetadata{},
+			expected:          false,
+			expectedThreshold: DefaultTokenExpiryWarningDays,
+		},
+		{
+			name:              "Default threshold - 29 days remaining",
+			metadata:          &TokenMetadata{ExpiresAt: expiresIn(29)},
+			expected:          true,
+			expectedThreshold: DefaultTokenExpiryWarningDays,
+		},
+		{
+			name:              "Default threshold - exactly 30 days remaining",
+			metadata:          &TokenMetadata{ExpiresAt: expiresIn(30)},
+			expected:          true,
+			expectedThreshold: DefaultTokenExpiryWarningDays,
+		},
+		{
+			name:              "Default threshold - 31 days remaining",
+			metadata:          &TokenMetadata{ExpiresAt: expiresIn(31)},
+			expected:          false,
+			expectedThreshold: DefaultTokenExpiryWarningDays,
+		},
+		{
+			name:              "Custom threshold - exactly 7 days remaining",
+			metadata:          &TokenMetadata{ExpiresAt: expiresIn(7), ExpiryWarningDays: 7},
+			expected:          true,
+			expectedThreshold: 7,
+		},
+		{
+			name:              "Custom threshold - 8 days remaining",
+			metadata:          &TokenMetadata{ExpiresAt: expiresIn(8), ExpiryWarningDays: 7},
+			expected:          false,
+			expectedThreshold: 7,
+		},
+		{
+			name:              "Custom threshold - 45 days remaining",
+			metadata:          &TokenMetadata{ExpiresAt: expiresIn(45), ExpiryWarningDays: 60},
+			expected:          true,
+			expectedThreshold: 60,
+		},
+		{
+			name:              "Negative threshold uses default",
+			metadata:          &TokenMetadata{ExpiresAt: expiresIn(30), ExpiryWarningDays: -1},
+			expected:          true,
+			expectedThreshold: DefaultTokenExpiryWarningDays,
+		},
+		{
+			name:              "Already expired",
+			metadata:          &TokenMetadata{ExpiresAt: &past},
+			expected:          false,
+			expectedThreshold: DefaultTokenExpiryWarningDays,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedThreshold, tc.metadata.ExpiryWarningThreshold())
+			assert.Equal(t, tc.expected, tc.metadata.IsExpiringSoon())
+		})
+	}
+}
@@ -2,6 +2,8 @@ package gitlab
 
 import (
 	"context" // Added for GetClientFn
+	"slices"
+
 	// Import necessary packages, including your toolsets package
 	"github.com/InkyQuill/gitlab-mcp-server/pkg/toolsets" // Adjust path if needed
 	log "github.com/sirupsen/logrus"                      // Import logger
@@ -13,7 +15,8 @@ import (
 // This allows decoupling toolset initialization from direct client creation.
 type GetClientFn func(context.Context) (*gl.Client, error)
 
-// DefaultTools defines the list of toolsets enabled by default.
+// DefaultTools defines the list of toolsets enabled by default. "all" leaves out
+// optional toolsets such as "requirements", which must be requested by name.
 var DefaultTools = []string{"all"}
 
 // InitToolsets initializes the ToolsetGroup with GitLab-specific toolsets.
@@ -24,6 +27,7 @@ func InitToolsets(
 	getClient GetClientFn, // Restore parameter name
 	logger *log.Logger, // Logger for notifications
 	tokenStore *TokenStore, // Token store for token management
+	clientFactory ClientFactory, // Creates the clients that validate tokens; nil uses DefaultClientFactory
 	translations map[string]string, // Translation map for i18n
 	dynamicMode bool, // Enable dynamic toolset discovery mode
 ) (*toolsets.ToolsetGroup, error) {
@@ -47,6 +51,17 @@ func InitToolsets(
 	searchTS := toolsets.NewToolset("search", "Tools for utilizing GitLab's scoped search capabilities.")
 	tagsTS := toolsets.NewToolset("tags", "Tools for managing GitLab repository tags and releases.")
 	pipelineJobsTS := toolsets.NewToolset("pipeline_jobs", "Tools for monitoring and controlling GitLab CI/CD pipeline jobs.")
+	variablesTS := toolsets.NewToolset("variables", "Tools for managing GitLab CI/CD variables.")
+	environmentsTS := toolsets.NewToolset("environments", "Tools for managing GitLab deployment environments, deployments and feature flags.")
+	releasesTS := toolsets.NewToolset("releases", "Tools for managing GitLab project releases and their asset links.")
+	wikisTS := toolsets.NewToolset("wikis", "Tools for reading and editing GitLab project wiki pages.")
+	snippetsTS := toolsets.NewToolset("snippets", "Tools for sharing code fragments as GitLab project snippets.")
+	packagesTS := toolsets.NewToolset("packages", "Tools for browsing and cleaning up the GitLab package and container registries.")
+	// Optional toolsets need a paid GitLab tier and are not part of "all"
+	requirementsTS := toolsets.NewOptionalToolset("requirements", "Tools for GitLab Premium requirements management.")
+
+	// Pipelines are looked up by project, so the project tools come along with them
+	pipelineJobsTS.DependsOn = []string{"projects"}
 
 	// 3. Add Tools to Toolsets (Actual tool implementation TBD in separate tasks)
 	//    Tool definition functions will need to accept GetClientFn or call it.
@@ -56,11 +71,12 @@ func InitToolsets(
 	// --- Add tools to tokenManagementTS (Token management) ---
 	tokenManagementTS.AddReadTools(
 		toolsets.NewServerTool(ListTokens(tokenStore)),
-		toolsets.NewServerTool(ValidateToken(nil, logger, tokenStore)),
+		toolsets.NewServerTool(GetTokenInfo(tokenStore, translations)),
+		toolsets.NewServerTool(ValidateToken(clientFactory, logger, tokenStore)),
 		toolsets.NewServerTool(GetNotificationsTool(logger)),
 	)
 	tokenManagementTS.AddWriteTools(
-		toolsets.NewServerTool(UpdateToken(nil, logger, tokenStore)),
+		toolsets.NewServerTool(UpdateToken(clientFactory, logger, tokenStore)),
 		toolsets.NewServerTool(RemoveToken(tokenStore)),
 		toolsets.NewServerTool(ClearNotificationsTool(logger)),
 	)
@@ -76,39 +92,160 @@ func InitToolsets(
 	// --- Add tools to projectsTS (Task 7 & 12) ---
 	projectsTS.AddReadTools(
 		toolsets.NewServerTool(GetProject(getClient, translations)),
+		toolsets.NewServerTool(GetProjectStatistics(getClient, translations)),
+		toolsets.NewServerTool(GetProjectLanguages(getClient, translations)),
+		toolsets.NewServerTool(GetProjectInsights(getClient, translations)),
 		toolsets.NewServerTool(ListProjects(getClient, translations)),
+		toolsets.NewServerTool(ListNamespaces(getClient, translations)),
+		toolsets.NewServerTool(GetNamespace(getClient, translations)),
 		toolsets.NewServerTool(GetProjectFile(getClient, translations)),
 		toolsets.NewServerTool(ListProjectFiles(getClient, translations)),
 		toolsets.NewServerTool(GetProjectBranches(getClient, translations)),
 		toolsets.NewServerTool(GetProjectCommits(getClient, translations)),
+		toolsets.NewServerTool(GetContributors(getClient, translations)),
+		toolsets.NewServerTool(CompareRepositoryRefs(getClient, translations)),
+		toolsets.NewServerTool(GetRepositoryArchiveLink(getClient, translations)),
+		toolsets.NewServerTool(GetCommitStatuses(getClient, translations)),
+		toolsets.NewServerTool(ListProjectHooks(getClient, translations)),
+		toolsets.NewServerTool(ListProjectAccessTokens(getClient, translations)),
+		toolsets.NewServerTool(ListProjectDeployKeys(getClient, translations)),
+		toolsets.NewServerTool(ListDeployTokens(getClient, translations)),
+		toolsets.NewServerTool(ListProtectedBranches(getClient, translations)),
+		toolsets.NewServerTool(GetProtectedBranch(getClient, translations)),
+	)
+	projectsTS.AddWriteTools(
+		toolsets.NewServerTool(CreateProjectHook(getClient, translations)),
+		toolsets.NewServerTool(DeleteProjectHook(getClient, translations)),
+		toolsets.NewServerTool(CreateProjectAccessToken(getClient, translations)),
+		toolsets.NewServerTool(RevokeProjectAccessToken(getClient, translations)),
+		toolsets.NewServerTool(AddProjectDeployKey(getClient, translations)),
+		toolsets.NewServerTool(DeleteProjectDeployKey(getClient, translations)),
+		toolsets.NewServerTool(CreateDeployToken(getClient, translations)),
+		toolsets.NewServerTool(RevokeDeployToken(getClient, translations)),
+		toolsets.NewServerTool(SetCommitStatus(getClient, translations)),
+		toolsets.NewServerTool(CreateCommitWithMultipleActions(getClient, translations)),
+		toolsets.NewServerTool(ProtectBranch(getClient, translations)),
+		toolsets.NewServerTool(UnprotectBranch(getClient, translations)),
 	)
-	// projectsTS.AddWriteTools(...)
 
 	// --- Add tools to issuesTS (Task 8 & 13) ---
 	issuesTS.AddReadTools(
 		toolsets.NewServerTool(GetIssue(getClient, translations)),
 		toolsets.NewServerTool(ListIssues(getClient, translations)),
 		toolsets.NewServerTool(GetIssueLabels(getClient, translations)),
+		toolsets.NewServerTool(GetIssueRelatedMergeRequests(getClient, translations)),
+		toolsets.NewServerTool(GetIssueClosingMergeRequests(getClient, translations)),
+		toolsets.NewServerTool(GetIssueParticipants(getClient, translations)),
+		toolsets.NewServerTool(ListIssueStateEvents(getClient, translations)),
+		toolsets.NewServerTool(GetIssueResourceLabelEvents(getClient, translations)),
+		toolsets.NewServerTool(GetIssueResourceMilestoneEvents(getClient, translations)),
 		// Milestones list tool
 		toolsets.NewServerTool(ListMilestones(getClient, translations)),
+		toolsets.NewServerTool(ListProjectMilestones(getClient, translations)),
+		toolsets.NewServerTool(ListGroupMilestones(getClient, translations)),
+		toolsets.NewServerTool(GetGroupMilestone(getClient, translations)),
+		toolsets.NewServerTool(ListGroupLabels(getClient, translations)),
+		// Discussions read tools
+		toolsets.NewServerTool(ListIssueDiscussions(getClient, translations)),
+		// Time tracking read tools
+		toolsets.NewServerTool(GetTimeTrackingStats(getClient, translations)),
+		// Award emoji read tools
+		toolsets.NewServerTool(ListIssueAwardEmoji(getClient, translations)),
+		// Board read tools
+		toolsets.NewServerTool(ListProjectBoards(getClient, translations)),
+		toolsets.NewServerTool(GetProjectBoard(getClient, translations)),
+		toolsets.NewServerTool(ListProjectBoardLists(getClient, translations)),
+		// Epic read tools
+		toolsets.NewServerTool(ListEpics(getClient, translations)),
+		toolsets.NewServerTool(GetEpic(getClient, translations)),
+		toolsets.NewServerTool(ListEpicIssues(getClient, translations)),
+		toolsets.NewServerTool(ListGroupEpicBoards(getClient, translations)),
+		toolsets.NewServerTool(GetGroupEpicBoard(getClient, translations)),
+		toolsets.NewServerTool(ListEpicBoardLists(getClient, translations)),
 	)
 	issuesTS.AddWriteTools(
 		toolsets.NewServerTool(CreateIssue(getClient, translations)),
 		toolsets.NewServerTool(UpdateIssue(getClient, translations)),
+		toolsets.NewServerTool(MoveIssue(getClient, translations)),
+		toolsets.NewServerTool(BulkCreateIssues(getClient, translations)),
+		toolsets.NewServerTool(BulkCloseIssues(getClient, translations)),
+		toolsets.NewServerTool(CloseIssuesByMilestone(getClient, translations)),
+		toolsets.NewServerTool(SubscribeToIssue(getClient, translations)),
+		toolsets.NewServerTool(UnsubscribeFromIssue(getClient, translations)),
+		toolsets.NewServerTool(AddIssueLabels(getClient, translations)),
+		toolsets.NewServerTool(RemoveIssueLabels(getClient, translations)),
+		toolsets.NewServerTool(SetIssueMilestone(getClient, translations)),
+		toolsets.NewServerTool(ClearIssueMilestone(getClient, translations)),
+		toolsets.NewServerTool(SetIssueAssignees(getClient, translations)),
+		toolsets.NewServerTool(ClearIssueAssignees(getClient, translations)),
+		toolsets.NewServerTool(CloseIssue(getClient, translations)),
+		toolsets.NewServerTool(ReopenIssue(getClient, translations)),
 		toolsets.NewServerTool(IssueComment(getClient, translations)),
 		// Milestones write tools
 		toolsets.NewServerTool(Milestone(getClient, translations)),
+		toolsets.NewServerTool(CreateProjectMilestone(getClient, translations)),
+		toolsets.NewServerTool(UpdateProjectMilestone(getClient, translations)),
+		toolsets.NewServerTool(CloseProjectMilestone(getClient, translations)),
+		toolsets.NewServerTool(CreateGroupMilestone(getClient, translations)),
+		toolsets.NewServerTool(UpdateGroupMilestone(getClient, translations)),
+		toolsets.NewServerTool(CreateGroupLabel(getClient, translations)),
+		toolsets.NewServerTool(UpdateGroupLabel(getClient, translations)),
+		toolsets.NewServerTool(DeleteGroupLabel(getClient, translations)),
+		// Discussions write tools
+		toolsets.NewServerTool(CreateIssueDiscussion(getClient, translations)),
+		// Time tracking write tools
+		toolsets.NewServerTool(SetTimeEstimate(getClient, translations)),
+		toolsets.NewServerTool(ResetTimeEstimate(getClient, translations)),
+		toolsets.NewServerTool(AddTimeSpent(getClient, translations)),
+		// Award emoji write tools
+		toolsets.NewServerTool(AddIssueAwardEmoji(getClient, translations)),
+		toolsets.NewServerTool(DeleteIssueAwardEmoji(getClient, translations)),
+		// Board write tools
+		toolsets.NewServerTool(CreateBoardList(getClient, translations)),
+		toolsets.NewServerTool(DeleteBoardList(getClient, translations)),
+		// Epic write tools
+		toolsets.NewServerTool(CreateEpic(getClient, translations)),
+		toolsets.NewServerTool(UpdateEpic(getClient, translations)),
+		toolsets.NewServerTool(AddEpicIssue(getClient, translations)),
+		toolsets.NewServerTool(RemoveEpicIssue(getClient, translations)),
 	)
 
 	// --- Add tools to mergeRequestsTS (Task 9 & 14) ---
 	mergeRequestsTS.AddReadTools(
 		toolsets.NewServerTool(GetMergeRequest(getClient, translations)),
 		toolsets.NewServerTool(ListMergeRequests(getClient, translations)),
+		toolsets.NewServerTool(GetMergeRequestDiff(getClient, translations)),
+		toolsets.NewServerTool(GetMergeRequestDiffStats(getClient, translations)),
+		toolsets.NewServerTool(ListMergeRequestApprovals(getClient, translations)),
+		toolsets.NewServerTool(GetMergeRequestApprovalState(getClient, translations)),
+		toolsets.NewServerTool(ListApprovalRules(getClient, translations)),
+		toolsets.NewServerTool(ListMergeRequestReviewers(getClient, translations)),
+		toolsets.NewServerTool(GetMergeRequestParticipants(getClient, translations)),
+		toolsets.NewServerTool(ListMergeRequestStateEvents(getClient, translations)),
+		toolsets.NewServerTool(GetMergeRequestResourceLabelEvents(getClient, translations)),
+		toolsets.NewServerTool(GetMergeRequestResourceMilestoneEvents(getClient, translations)),
+		toolsets.NewServerTool(GetMergeRequestPipelines(getClient, translations)),
+		toolsets.NewServerTool(GetLatestMergeRequestPipeline(getClient, translations)),
+		toolsets.NewServerTool(ListMergeRequestDiscussions(getClient, translations)),
+		toolsets.NewServerTool(ListMergeRequestAwardEmoji(getClient, translations)),
 	)
 	mergeRequestsTS.AddWriteTools(
 		toolsets.NewServerTool(CreateMergeRequest(getClient, translations)),
 		toolsets.NewServerTool(UpdateMergeRequest(getClient, translations)),
 		toolsets.NewServerTool(MergeRequestComment(getClient, translations)),
+		toolsets.NewServerTool(MergeMergeRequest(getClient, translations)),
+		toolsets.NewServerTool(RebaseMergeRequest(getClient, translations)),
+		toolsets.NewServerTool(ApproveMergeRequest(getClient, translations)),
+		toolsets.NewServerTool(UnapproveMergeRequest(getClient, translations)),
+		toolsets.NewServerTool(CreateApprovalRule(getClient, translations)),
+		toolsets.NewServerTool(AddMergeRequestReviewer(getClient, translations)),
+		toolsets.NewServerTool(RemoveMergeRequestReviewer(getClient, translations)),
+		toolsets.NewServerTool(SubscribeToMergeRequest(getClient, translations)),
+		toolsets.NewServerTool(UnsubscribeFromMergeRequest(getClient, translations)),
+		toolsets.NewServerTool(CreateMergeRequestDiscussion(getClient, translations)),
+		toolsets.NewServerTool(ResolveMergeRequestDiscussion(getClient, translations)),
+		toolsets.NewServerTool(AddMergeRequestAwardEmoji(getClient, translations)),
+		toolsets.NewServerTool(DeleteMergeRequestAwardEmoji(getClient, translations)),
 	)
 
 	// --- Add tools to securityTS (Security scanning reports) ---
@@ -128,32 +265,144 @@ func InitToolsets(
 		toolsets.NewServerTool(GetUserStatus(getClient, translations)),
 		toolsets.NewServerTool(ListUsers(getClient, translations)),
 		toolsets.NewServerTool(ListProjectUsers(getClient, translations)),
+		toolsets.NewServerTool(ListTodos(getClient, translations)),
 	)
 	usersTS.AddWriteTools(
 		toolsets.NewServerTool(ManageUserState(getClient, translations)),
+		toolsets.NewServerTool(MarkTodoDone(getClient, translations)),
+		toolsets.NewServerTool(MarkAllTodosDone(getClient, translations)),
 	)
 
 	// --- Add tools to searchTS (Search capabilities) ---
 	searchTS.AddReadTools(
 		toolsets.NewServerTool(Search(getClient, translations)),
+		toolsets.NewServerTool(SearchUsers(getClient, translations)),
 	)
 
 	// --- Add tools to tagsTS (Tags Management) ---
 	tagsTS.AddReadTools(
 		toolsets.NewServerTool(ListRepositoryTags(getClient, translations)),
+		toolsets.NewServerTool(ListProtectedTags(getClient, translations)),
 	)
 	tagsTS.AddWriteTools(
 		toolsets.NewServerTool(Tag(getClient, translations)),
+		toolsets.NewServerTool(ProtectTag(getClient, translations)),
+		toolsets.NewServerTool(UnprotectTag(getClient, translations)),
 	)
 
 	// --- Add tools to pipelineJobsTS (CI/CD Pipeline Jobs) ---
 	pipelineJobsTS.AddReadTools(
 		toolsets.NewServerTool(PipelineJob(getClient, translations)),
+		toolsets.NewServerTool(LintCIConfig(getClient, translations)),
+		// Pipeline schedule read tools
+		toolsets.NewServerTool(ListScheduledPipelines(getClient, translations)),
+		// Test report read tools
+		toolsets.NewServerTool(GetTestReport(getClient, translations)),
+		toolsets.NewServerTool(GetPipelineTestSummary(getClient, translations)),
+		// Downstream pipeline read tools
+		toolsets.NewServerTool(GetPipelineBridges(getClient, translations)),
+		// Job artifact read tools
+		toolsets.NewServerTool(ListJobArtifacts(getClient, translations)),
+		toolsets.NewServerTool(DownloadJobArtifact(getClient, translations)),
+		// Runner read tools
+		toolsets.NewServerTool(ListRunners(getClient, translations)),
+		toolsets.NewServerTool(GetRunner(getClient, translations)),
 	)
 	pipelineJobsTS.AddWriteTools(
 		toolsets.NewServerTool(Pipeline(getClient, translations)),
 		toolsets.NewServerTool(RetryPipelineJob(getClient, translations)),
 		toolsets.NewServerTool(PlayPipelineJob(getClient, translations)),
+		// Pipeline schedule write tools
+		toolsets.NewServerTool(CreateScheduledPipeline(getClient, translations)),
+		toolsets.NewServerTool(UpdateScheduledPipeline(getClient, translations)),
+		toolsets.NewServerTool(DeleteScheduledPipeline(getClient, translations)),
+		toolsets.NewServerTool(RunScheduledPipeline(getClient, translations)),
+		// Runner write tools
+		toolsets.NewServerTool(EnableProjectRunner(getClient, translations)),
+		toolsets.NewServerTool(DisableProjectRunner(getClient, translations)),
+	)
+
+	// --- Add tools to variablesTS (CI/CD variables) ---
+	variablesTS.AddReadTools(
+		toolsets.NewServerTool(ListProjectVariables(getClient, translations)),
+		toolsets.NewServerTool(ListGroupVariables(getClient, translations)),
+		toolsets.NewServerTool(GetGroupVariable(getClient, translations)),
+	)
+	variablesTS.AddWriteTools(
+		toolsets.NewServerTool(CreateProjectVariable(getClient, translations)),
+		toolsets.NewServerTool(UpdateProjectVariable(getClient, translations)),
+		toolsets.NewServerTool(DeleteProjectVariable(getClient, translations)),
+		toolsets.NewServerTool(CreateGroupVariable(getClient, translations)),
+		toolsets.NewServerTool(UpdateGroupVariable(getClient, translations)),
+		toolsets.NewServerTool(DeleteGroupVariable(getClient, translations)),
+	)
+
+	// --- Add tools to environmentsTS (Environments, deployments and feature flags) ---
+	environmentsTS.AddReadTools(
+		toolsets.NewServerTool(ListEnvironments(getClient, translations)),
+		toolsets.NewServerTool(ListDeployments(getClient, translations)),
+		toolsets.NewServerTool(GetDeployment(getClient, translations)),
+		toolsets.NewServerTool(ListFeatureFlags(getClient, translations)),
+	)
+	environmentsTS.AddWriteTools(
+		toolsets.NewServerTool(CreateEnvironment(getClient, translations)),
+		toolsets.NewServerTool(StopEnvironment(getClient, translations)),
+		toolsets.NewServerTool(CreateFeatureFlag(getClient, translations)),
+		toolsets.NewServerTool(UpdateFeatureFlag(getClient, translations)),
+		toolsets.NewServerTool(DeleteFeatureFlag(getClient, translations)),
+	)
+
+	// --- Add tools to releasesTS (Release management) ---
+	releasesTS.AddReadTools(
+		toolsets.NewServerTool(ListReleases(getClient, translations)),
+		toolsets.NewServerTool(GetRelease(getClient, translations)),
+		toolsets.NewServerTool(ListReleaseLinks(getClient, translations)),
+	)
+	releasesTS.AddWriteTools(
+		toolsets.NewServerTool(CreateRelease(getClient, translations)),
+		toolsets.NewServerTool(UpdateRelease(getClient, translations)),
+		toolsets.NewServerTool(DeleteRelease(getClient, translations)),
+		toolsets.NewServerTool(CreateReleaseLink(getClient, translations)),
+		toolsets.NewServerTool(DeleteReleaseLink(getClient, translations)),
+	)
+
+	// --- Add tools to wikisTS (Project wikis) ---
+	wikisTS.AddReadTools(
+		toolsets.NewServerTool(ListWikiPages(getClient, translations)),
+		toolsets.NewServerTool(GetWikiPage(getClient, translations)),
+	)
+	wikisTS.AddWriteTools(
+		toolsets.NewServerTool(CreateWikiPage(getClient, translations)),
+		toolsets.NewServerTool(UpdateWikiPage(getClient, translations)),
+		toolsets.NewServerTool(DeleteWikiPage(getClient, translations)),
+	)
+
+	// --- Add tools to snippetsTS (Project snippets) ---
+	snippetsTS.AddReadTools(
+		toolsets.NewServerTool(ListProjectSnippets(getClient, translations)),
+		toolsets.NewServerTool(GetSnippet(getClient, translations)),
+	)
+	snippetsTS.AddWriteTools(
+		toolsets.NewServerTool(CreateSnippet(getClient, translations)),
+		toolsets.NewServerTool(UpdateSnippet(getClient, translations)),
+		toolsets.NewServerTool(DeleteSnippet(getClient, translations)),
+	)
+
+	// --- Add tools to packagesTS (Package and container registries) ---
+	packagesTS.AddReadTools(
+		toolsets.NewServerTool(ListPackages(getClient, translations)),
+		toolsets.NewServerTool(GetPackage(getClient, translations)),
+		toolsets.NewServerTool(ListContainerRegistryRepositories(getClient, translations)),
+		toolsets.NewServerTool(ListContainerRegistryTags(getClient, translations)),
+	)
+	packagesTS.AddWriteTools(
+		toolsets.NewServerTool(DeletePackage(getClient, translations)),
+		toolsets.NewServerTool(DeleteContainerRegistryTag(getClient, translations)),
+	)
+
+	// --- Add tools to requirementsTS (Requirements management, Premium) ---
+	requirementsTS.AddReadTools(
+		toolsets.NewServerTool(GetProjectRequirements(getClient, translations)),
 	)
 
 	// 4. Add defined Toolsets to the Group
@@ -167,11 +416,28 @@ func InitToolsets(
 	tg.AddToolset(searchTS)
 	tg.AddToolset(tagsTS)
 	tg.AddToolset(pipelineJobsTS)
+	tg.AddToolset(variablesTS)
+	tg.AddToolset(environmentsTS)
+	tg.AddToolset(releasesTS)
+	tg.AddToolset(wikisTS)
+	tg.AddToolset(snippetsTS)
+	tg.AddToolset(packagesTS)
+	tg.AddToolset(requirementsTS)
 
 	// 5. Enable Toolsets based on configuration
 	// In dynamic mode, toolsets are enabled on-demand, so we skip this step
 	if !dynamicMode {
-		err := tg.EnableToolsets(enabledToolsets)
+		resolved, err := tg.ResolveDependencies(enabledToolsets)
+		if err != nil {
+			return nil, err
+		}
+		if logger != nil {
+			for _, name := range autoIncluded(enabledToolsets, resolved) {
+				logger.Infof("Toolset '%s' enabled as a dependency of the requested toolsets", name)
+			}
+		}
+
+		err = tg.EnableToolsets(resolved)
 		if err != nil {
 			// Consider logging the error here in a real implementation
 			return nil, err // Return error if enabling failed (e.g., unknown toolset name)
@@ -181,3 +447,14 @@ func InitToolsets(
 	// 6. Return the configured group
 	return tg, nil
 }
+
+// autoIncluded returns the names in resolved that were not requested.
+func autoIncluded(requested, resolved []string) []string {
+	var added []string
+	for _, name := range resolved {
+		if !slices.Contains(requested, name) {
+			added = append(added, name)
+		}
+	}
+	return added
+}
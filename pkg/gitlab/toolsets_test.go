@@ -2,8 +2,12 @@ package gitlab
 
 import (
 	"context"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -24,7 +28,7 @@ func mockGetClientFn(_ context.Context) (*gitlab.Client, error) {
 
 func TestInitToolsets(t *testing.T) {
 	// Define the expected toolset names based on the implementation
-	// All 10 toolsets defined in InitToolsets
+	// All 17 toolsets defined in InitToolsets
 	expectedToolsetNames := []string{
 		"token_management",
 		"project_config",
@@ -36,7 +40,16 @@ func TestInitToolsets(t *testing.T) {
 		"search",
 		"tags",
 		"pipeline_jobs",
+		"variables",
+		"environments",
+		"releases",
+		"wikis",
+		"snippets",
+		"packages",
+		"requirements",
 	}
+	// "all" enables every toolset except the optional ones
+	allToolsetNames := expectedToolsetNames[:len(expectedToolsetNames)-1]
 
 	tests := []struct {
 		name            string
@@ -60,7 +73,7 @@ func TestInitToolsets(t *testing.T) {
 			enabledToolsets: []string{"all"},
 			readOnly:        false,
 			expectError:     false,
-			expectEnabled:   expectedToolsetNames, // All defined toolsets
+			expectEnabled:   allToolsetNames, // All non-optional toolsets
 		},
 		{
 			name:            "Enable specific toolsets, read-only group",
@@ -74,7 +87,21 @@ func TestInitToolsets(t *testing.T) {
 			enabledToolsets: []string{"all"},
 			readOnly:        true,
 			expectError:     false,
-			expectEnabled:   expectedToolsetNames,
+			expectEnabled:   allToolsetNames,
+		},
+		{
+			name:            "Enable optional toolset by name",
+			enabledToolsets: []string{"projects", "requirements"},
+			readOnly:        false,
+			expectError:     false,
+			expectEnabled:   []string{"projects", "requirements"},
+		},
+		{
+			name:            "Enable toolset with a dependency",
+			enabledToolsets: []string{"pipeline_jobs"},
+			readOnly:        false,
+			expectError:     false,
+			expectEnabled:   []string{"projects", "pipeline_jobs"},
 		},
 		{
 			name:            "Enable non-existent toolset",
@@ -97,8 +124,8 @@ func TestInitToolsets(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			// Call InitToolsets using the mock function
-			// Parameters: enabledToolsets, readOnly, getClient, logger, tokenStore, translations, dynamicMode
-			tg, err := InitToolsets(tc.enabledToolsets, tc.readOnly, mockGetClientFn, nil, nil, nil, false)
+			// Parameters: enabledToolsets, readOnly, getClient, logger, tokenStore, clientFactory, translations, dynamicMode
+			tg, err := InitToolsets(tc.enabledToolsets, tc.readOnly, mockGetClientFn, nil, nil, nil, nil, false)
 
 			if tc.expectError {
 				require.Error(t, err)
@@ -134,3 +161,39 @@ func TestInitToolsets(t *testing.T) {
 		})
 	}
 }
+
+// TestToolsnaps_AllTools checks that every tool the server can register has a schema
+// snapshot and that every snapshot belongs to such a tool.
+func TestToolsnaps_AllTools(t *testing.T) {
+	tg, err := InitToolsets([]string{"all"}, false, mockGetClientFn, nil, NewTokenStore(), nil, nil, false)
+	require.NoError(t, err)
+
+	// Every tool of every toolset, enabled or not
+	tools := make([]mcp.Tool, 0)
+	for _, ts := range tg.Toolsets {
+		for _, st := range ts.Tools() {
+			tools = append(tools, st.Tool)
+		}
+	}
+	// Tools registered outside of the toolsets
+	metadataTool, _ := GetServerMetadata(nil, nil, ServerInfo{}, nil)
+	tools = append(tools, metadataTool, listAvailableToolsetsTool(), enableToolsetTool())
+	// Deprecated tools that are still defined but no longer registered
+	addTokenTool, _ := AddToken(nil, nil, NewTokenStore())
+	detectProjectTool, _ := DetectProject(nil)
+	autoDetectTool, _ := AutoDetectAndSetProject(nil)
+	tools = append(tools, addTokenTool, detectProjectTool, autoDetectTool)
+
+	known := make(map[string]bool, len(tools))
+	for _, tool := range tools {
+		known[tool.Name] = true
+		require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot for %s", tool.Name)
+	}
+
+	snaps, err := filepath.Glob("__toolsnaps__/*.snap")
+	require.NoError(t, err)
+	for _, snap := range snaps {
+		name := strings.TrimSuffix(filepath.Base(snap), ".snap")
+		assert.True(t, known[name], "snapshot %s does not belong to a registered tool", snap)
+	}
+}
@@ -99,4 +99,10 @@ var (
 
 	// NoteFields returns fields to truncate in Note objects
 	NoteFields = []string{"body"}
+
+	// ReleaseFields returns fields to truncate in Release objects
+	ReleaseFields = []string{"description"}
+
+	// SnippetFields returns fields to truncate in Snippet objects
+	SnippetFields = []string{"description"}
 )
@@ -16,6 +16,7 @@ func GetCurrentUser(getClient GetClientFn, t map[string]string) (tool mcp.Tool,
 	return mcp.NewTool(
 			"getCurrentUser",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_CURRENT_USER_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        "Get Current GitLab User",
 				ReadOnlyHint: boolPtr(true),
@@ -49,6 +50,7 @@ func GetUser(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler
 	return mcp.NewTool(
 			"getUser",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_USER_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithNumber("userId",
 				mcp.Description("The ID of the user"),
 				mcp.Required(),
@@ -95,6 +97,7 @@ func GetUserStatus(getClient GetClientFn, t map[string]string) (tool mcp.Tool, h
 	return mcp.NewTool(
 			"getUserStatus",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_USER_STATUS_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithNumber("userId",
 				mcp.Description("The ID of the user"),
 				mcp.Required(),
@@ -141,6 +144,7 @@ func ListUsers(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handl
 	return mcp.NewTool(
 			"listUsers",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_USERS_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithString("search",
 				mcp.Description("Search users by username, name or email"),
 			),
@@ -150,6 +154,7 @@ func ListUsers(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handl
 			mcp.WithNumber("page",
 				mcp.Description("The page number to retrieve (default: 1)"),
 			),
+			WithKeysetPagination(),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        "List GitLab Users",
 				ReadOnlyHint: boolPtr(true),
@@ -164,6 +169,10 @@ func ListUsers(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handl
 			if err != nil {
 				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
 			}
+			paginationMode, cursor, err := OptionalPaginationModeParams(&req)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
 
 			opts := &gl.ListUsersOptions{
 				ListOptions: gl.ListOptions{
@@ -181,7 +190,19 @@ func ListUsers(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handl
 				opts.Search = &search
 			}
 
-			users, resp, err := client.Users.ListUsers(opts, gl.WithContext(ctx))
+			reqOpts := []gl.RequestOptionFunc{gl.WithContext(ctx)}
+			if paginationMode == PaginationModeKeyset {
+				// GitLab only supports keyset pagination of users ordered by id
+				opts.OrderBy = gl.Ptr("id")
+				opts.Sort = gl.Ptr("asc")
+				cursorOpts, err := applyKeysetPagination(&opts.ListOptions, cursor)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+				}
+				reqOpts = append(reqOpts, cursorOpts...)
+			}
+
+			users, resp, err := client.Users.ListUsers(opts, reqOpts...)
 			if err != nil {
 				result, apiErr := HandleListAPIError(err, resp, "users")
 				if result != nil {
@@ -208,6 +229,9 @@ func ListUsers(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handl
 			if err != nil {
 				return nil, fmt.Errorf("failed to optimize users response: %w", err)
 			}
+			if paginationMode == PaginationModeKeyset {
+				optimized.NextCursor = ExtractNextCursor(resp)
+			}
 
 			jsonData, err := json.Marshal(optimized)
 			if err != nil {
@@ -223,6 +247,7 @@ func ListProjectUsers(getClient GetClientFn, t map[string]string) (tool mcp.Tool
 	return mcp.NewTool(
 			"listProjectUsers",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_PROJECT_USERS_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithString("pid",
 				mcp.Description("The ID or URL-encoded path of the project"),
 				mcp.Required(),
@@ -305,6 +330,7 @@ func ManageUserState(getClient GetClientFn, t map[string]string) (tool mcp.Tool,
 	return mcp.NewTool(
 			"manageUserState",
 			mcp.WithDescription(translations.Translate(t, translations.TOOL_MANAGE_USER_STATE_DESCRIPTION)),
+			WithGitLabServer(),
 			mcp.WithNumber("userId",
 				mcp.Description("The ID of the user"),
 				mcp.Required(),
@@ -252,6 +252,27 @@ func TestListUsersHandler(t *testing.T) {
 					Return([]*gl.User{{ID: 1, Username: "testuser"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
 			},
 		},
+		{
+			name: "Success - Keyset pagination",
+			args: map[string]any{"pagination": "keyset"},
+			mockSetup: func() {
+				mockUsers.EXPECT().ListUsers(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(opts *gl.ListUsersOptions, _ ...gl.RequestOptionFunc) ([]*gl.User, *gl.Response, error) {
+						assert.Equal(t, "keyset", opts.Pagination)
+						assert.Zero(t, opts.Page)
+						require.NotNil(t, opts.OrderBy)
+						assert.Equal(t, "id", *opts.OrderBy)
+						return []*gl.User{{ID: 1, Username: "user1"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+					})
+			},
+		},
+		{
+			name:              "Error - Invalid pagination mode",
+			args:              map[string]any{"pagination": "seek"},
+			mockSetup:         func() {},
+			expectResultError: true,
+			errorContains:     "Validation Error: invalid 'pagination' parameter",
+		},
 		{
 			name: "Error - 401",
 			args: map[string]any{},
@@ -0,0 +1,873 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/InkyQuill/gitlab-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	gl "gitlab.com/gitlab-org/api/client-go"
+)
+
+// maskedVariableValue replaces the value of masked CI/CD variables in tool output
+// so secrets GitLab hides in job logs are not echoed back to the LLM either.
+const maskedVariableValue = "[MASKED]"
+
+// maskProjectVariable returns a copy of v with its value redacted when the variable is masked.
+func maskProjectVariable(v *gl.ProjectVariable) *gl.ProjectVariable {
+	if v == nil || !v.Masked {
+		return v
+	}
+	masked := *v
+	masked.Value = maskedVariableValue
+	return &masked
+}
+
+// ListProjectVariables defines the MCP tool for listing CI/CD variables of a GitLab project.
+func ListProjectVariables(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listProjectVariables",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_PROJECT_VARIABLES_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Project CI/CD Variables",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			opts := &gl.ListProjectVariablesOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+			}
+			variables, resp, err := glClient.ProjectVariables.ListVariables(projectID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, fmt.Sprintf("variables from project %q", projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(variables) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Redact masked values
+			for i, v := range variables {
+				variables[i] = maskProjectVariable(v)
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(variables)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal project variables list: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// CreateProjectVariable defines the MCP tool for creating a CI/CD variable in a GitLab project.
+func CreateProjectVariable(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"createProjectVariable",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_CREATE_PROJECT_VARIABLE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Create GitLab Project CI/CD Variable",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("key",
+				mcp.Description("The key of the variable (letters, digits and '_' only, max 255 characters)."),
+				mcp.Required(),
+			),
+			mcp.WithString("value",
+				mcp.Description("The value of the variable."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithString("variableType",
+				mcp.Description("The type of the variable (defaults to env_var)."),
+				mcp.Enum("env_var", "file"),
+			),
+			mcp.WithBoolean("protected",
+				mcp.Description("Only expose the variable to pipelines on protected branches and tags."),
+			),
+			mcp.WithBoolean("masked",
+				mcp.Description("Mask the variable value in job logs."),
+			),
+			mcp.WithString("environmentScope",
+				mcp.Description("The environment scope of the variable (defaults to '*')."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			key, err := requiredParam[string](&request, "key")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			value, err := requiredParam[string](&request, "value")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional parameters
+			variableType, err := OptionalParam[string](&request, "variableType")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			protected, err := OptionalBoolParam(&request, "protected")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			masked, err := OptionalBoolParam(&request, "masked")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			environmentScope, err := OptionalParam[string](&request, "environmentScope")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.CreateProjectVariableOptions{
+				Key:       &key,
+				Value:     &value,
+				Protected: protected,
+				Masked:    masked,
+			}
+
+			if variableType != "" {
+				opts.VariableType = gl.Ptr(gl.VariableTypeValue(variableType))
+			}
+
+			if environmentScope != "" {
+				opts.EnvironmentScope = &environmentScope
+			}
+
+			// --- Call GitLab API
+			variable, resp, err := glClient.ProjectVariables.CreateVariable(projectID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("project %q", projectID), "create project variable")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(maskProjectVariable(variable))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal project variable data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// UpdateProjectVariable defines the MCP tool for updating a CI/CD variable in a GitLab project.
+func UpdateProjectVariable(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"updateProjectVariable",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_UPDATE_PROJECT_VARIABLE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Update GitLab Project CI/CD Variable",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("key",
+				mcp.Description("The key of the variable to update."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithString("value",
+				mcp.Description("The new value of the variable."),
+			),
+			mcp.WithString("variableType",
+				mcp.Description("The type of the variable."),
+				mcp.Enum("env_var", "file"),
+			),
+			mcp.WithBoolean("protected",
+				mcp.Description("Only expose the variable to pipelines on protected branches and tags."),
+			),
+			mcp.WithBoolean("masked",
+				mcp.Description("Mask the variable value in job logs."),
+			),
+			mcp.WithString("environmentScope",
+				mcp.Description("The environment scope of the variable. Selects which variable to update when the key exists in several scopes."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			key, err := requiredParam[string](&request, "key")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional parameters
+			value, err := OptionalParam[string](&request, "value")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			variableType, err := OptionalParam[string](&request, "variableType")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			protected, err := OptionalBoolParam(&request, "protected")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			masked, err := OptionalBoolParam(&request, "masked")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			environmentScope, err := OptionalParam[string](&request, "environmentScope")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.UpdateProjectVariableOptions{
+				Protected: protected,
+				Masked:    masked,
+			}
+
+			if value != "" {
+				opts.Value = &value
+			}
+
+			if variableType != "" {
+				opts.VariableType = gl.Ptr(gl.VariableTypeValue(variableType))
+			}
+
+			if environmentScope != "" {
+				opts.EnvironmentScope = &environmentScope
+				opts.Filter = &gl.VariableFilter{EnvironmentScope: environmentScope}
+			}
+
+			// --- Call GitLab API
+			variable, resp, err := glClient.ProjectVariables.UpdateVariable(projectID, key, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("variable %q in project %q", key, projectID), "update project variable")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(maskProjectVariable(variable))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal project variable data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// DeleteProjectVariable defines the MCP tool for deleting a CI/CD variable from a GitLab project.
+func DeleteProjectVariable(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"deleteProjectVariable",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_DELETE_PROJECT_VARIABLE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Delete GitLab Project CI/CD Variable",
+			}),
+			// Required parameters
+			mcp.WithString("projectId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the project."),
+				mcp.Required(),
+			),
+			mcp.WithString("key",
+				mcp.Description("The key of the variable to delete."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithString("environmentScope",
+				mcp.Description("Only delete the variable with this environment scope, when the key exists in several scopes."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			projectID, err := requiredParam[string](&request, "projectId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			key, err := requiredParam[string](&request, "key")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional parameters
+			environmentScope, err := OptionalParam[string](&request, "environmentScope")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			var opts *gl.RemoveProjectVariableOptions
+			if environmentScope != "" {
+				opts = &gl.RemoveProjectVariableOptions{
+					Filter: &gl.VariableFilter{EnvironmentScope: environmentScope},
+				}
+			}
+			resp, err := glClient.ProjectVariables.RemoveVariable(projectID, key, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("variable %q in project %q", key, projectID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf(`{"message":"Variable %q successfully deleted from project %q"}`, key, projectID)), nil
+		}
+}
+
+// maskGroupVariable returns a copy of v with its value redacted when the variable is masked.
+func maskGroupVariable(v *gl.GroupVariable) *gl.GroupVariable {
+	if v == nil || !v.Masked {
+		return v
+	}
+	masked := *v
+	masked.Value = maskedVariableValue
+	return &masked
+}
+
+// ListGroupVariables defines the MCP tool for listing CI/CD variables of a GitLab group.
+func ListGroupVariables(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"listGroupVariables",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_LIST_GROUP_VARIABLES_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "List GitLab Group CI/CD Variables",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("groupId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the group."),
+				mcp.Required(),
+			),
+			// Add standard MCP pagination parameters
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			groupID, err := requiredParam[string](&request, "groupId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse pagination parameters
+			page, perPage, err := OptionalPaginationParams(&request)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			opts := &gl.ListGroupVariablesOptions{
+				ListOptions: gl.ListOptions{
+					Page:    int64(page),
+					PerPage: int64(perPage),
+				},
+			}
+			variables, resp, err := glClient.GroupVariables.ListVariables(groupID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleListAPIError(err, resp, fmt.Sprintf("variables from group %q", groupID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Handle empty result gracefully
+			if len(variables) == 0 {
+				return mcp.NewToolResultText("[]"), nil
+			}
+
+			// --- Redact masked values
+			for i, v := range variables {
+				variables[i] = maskGroupVariable(v)
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(variables)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal group variables list: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// GetGroupVariable defines the MCP tool for retrieving a single CI/CD variable of a GitLab group.
+func GetGroupVariable(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"getGroupVariable",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_GET_GROUP_VARIABLE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        "Get GitLab Group CI/CD Variable",
+				ReadOnlyHint: boolPtr(true),
+			}),
+			// Required parameters
+			mcp.WithString("groupId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the group."),
+				mcp.Required(),
+			),
+			mcp.WithString("key",
+				mcp.Description("The key of the variable."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithString("environmentScope",
+				mcp.Description("Only return the variable with this environment scope, when the key exists in several scopes."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			groupID, err := requiredParam[string](&request, "groupId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			key, err := requiredParam[string](&request, "key")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional parameters
+			environmentScope, err := OptionalParam[string](&request, "environmentScope")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			var opts *gl.GetGroupVariableOptions
+			if environmentScope != "" {
+				opts = &gl.GetGroupVariableOptions{
+					Filter: &gl.VariableFilter{EnvironmentScope: environmentScope},
+				}
+			}
+			variable, resp, err := glClient.GroupVariables.GetVariable(groupID, key, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("variable %q in group %q", key, groupID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(maskGroupVariable(variable))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal group variable data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// CreateGroupVariable defines the MCP tool for creating a CI/CD variable in a GitLab group.
+func CreateGroupVariable(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"createGroupVariable",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_CREATE_GROUP_VARIABLE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Create GitLab Group CI/CD Variable",
+			}),
+			// Required parameters
+			mcp.WithString("groupId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the group."),
+				mcp.Required(),
+			),
+			mcp.WithString("key",
+				mcp.Description("The key of the variable (letters, digits and '_' only, max 255 characters)."),
+				mcp.Required(),
+			),
+			mcp.WithString("value",
+				mcp.Description("The value of the variable."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithString("variableType",
+				mcp.Description("The type of the variable (defaults to env_var)."),
+				mcp.Enum("env_var", "file"),
+			),
+			mcp.WithBoolean("protected",
+				mcp.Description("Only expose the variable to pipelines on protected branches and tags."),
+			),
+			mcp.WithBoolean("masked",
+				mcp.Description("Mask the variable value in job logs."),
+			),
+			mcp.WithString("environmentScope",
+				mcp.Description("The environment scope of the variable (defaults to '*')."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			groupID, err := requiredParam[string](&request, "groupId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			key, err := requiredParam[string](&request, "key")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			value, err := requiredParam[string](&request, "value")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional parameters
+			variableType, err := OptionalParam[string](&request, "variableType")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			protected, err := OptionalBoolParam(&request, "protected")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			masked, err := OptionalBoolParam(&request, "masked")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			environmentScope, err := OptionalParam[string](&request, "environmentScope")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.CreateGroupVariableOptions{
+				Key:       &key,
+				Value:     &value,
+				Protected: protected,
+				Masked:    masked,
+			}
+
+			if variableType != "" {
+				opts.VariableType = gl.Ptr(gl.VariableTypeValue(variableType))
+			}
+
+			if environmentScope != "" {
+				opts.EnvironmentScope = &environmentScope
+			}
+
+			// --- Call GitLab API
+			variable, resp, err := glClient.GroupVariables.CreateVariable(groupID, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("group %q", groupID), "create group variable")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(maskGroupVariable(variable))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal group variable data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// UpdateGroupVariable defines the MCP tool for updating a CI/CD variable in a GitLab group.
+func UpdateGroupVariable(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"updateGroupVariable",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_UPDATE_GROUP_VARIABLE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Update GitLab Group CI/CD Variable",
+			}),
+			// Required parameters
+			mcp.WithString("groupId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the group."),
+				mcp.Required(),
+			),
+			mcp.WithString("key",
+				mcp.Description("The key of the variable to update."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithString("value",
+				mcp.Description("The new value of the variable."),
+			),
+			mcp.WithString("variableType",
+				mcp.Description("The type of the variable."),
+				mcp.Enum("env_var", "file"),
+			),
+			mcp.WithBoolean("protected",
+				mcp.Description("Only expose the variable to pipelines on protected branches and tags."),
+			),
+			mcp.WithBoolean("masked",
+				mcp.Description("Mask the variable value in job logs."),
+			),
+			mcp.WithString("environmentScope",
+				mcp.Description("The environment scope of the variable. Selects which variable to update when the key exists in several scopes."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			groupID, err := requiredParam[string](&request, "groupId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			key, err := requiredParam[string](&request, "key")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional parameters
+			value, err := OptionalParam[string](&request, "value")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			variableType, err := OptionalParam[string](&request, "variableType")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			protected, err := OptionalBoolParam(&request, "protected")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			masked, err := OptionalBoolParam(&request, "masked")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			environmentScope, err := OptionalParam[string](&request, "environmentScope")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Construct GitLab API options
+			opts := &gl.UpdateGroupVariableOptions{
+				Protected: protected,
+				Masked:    masked,
+			}
+
+			if value != "" {
+				opts.Value = &value
+			}
+
+			if variableType != "" {
+				opts.VariableType = gl.Ptr(gl.VariableTypeValue(variableType))
+			}
+
+			if environmentScope != "" {
+				opts.EnvironmentScope = &environmentScope
+				opts.Filter = &gl.VariableFilter{EnvironmentScope: environmentScope}
+			}
+
+			// --- Call GitLab API
+			variable, resp, err := glClient.GroupVariables.UpdateVariable(groupID, key, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleCreateUpdateAPIError(err, resp, fmt.Sprintf("variable %q in group %q", key, groupID), "update group variable")
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			// --- Marshal and return success
+			data, err := json.Marshal(maskGroupVariable(variable))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal group variable data: %w", err)
+			}
+			return mcp.NewToolResultText(string(data)), nil
+		}
+}
+
+// DeleteGroupVariable defines the MCP tool for deleting a CI/CD variable from a GitLab group.
+func DeleteGroupVariable(getClient GetClientFn, t map[string]string) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool(
+			"deleteGroupVariable",
+			mcp.WithDescription(translations.Translate(t, translations.TOOL_DELETE_GROUP_VARIABLE_DESCRIPTION)),
+			WithGitLabServer(),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title: "Delete GitLab Group CI/CD Variable",
+			}),
+			// Required parameters
+			mcp.WithString("groupId",
+				mcp.Description("The ID (integer) or URL-encoded path (string) of the group."),
+				mcp.Required(),
+			),
+			mcp.WithString("key",
+				mcp.Description("The key of the variable to delete."),
+				mcp.Required(),
+			),
+			// Optional parameters
+			mcp.WithString("environmentScope",
+				mcp.Description("Only delete the variable with this environment scope, when the key exists in several scopes."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// --- Parse required parameters
+			groupID, err := requiredParam[string](&request, "groupId")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			key, err := requiredParam[string](&request, "key")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Parse optional parameters
+			environmentScope, err := OptionalParam[string](&request, "environmentScope")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("Validation Error: %v", err)), nil
+			}
+
+			// --- Obtain GitLab client
+			glClient, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize GitLab client: %w", err)
+			}
+
+			// --- Call GitLab API
+			var opts *gl.RemoveGroupVariableOptions
+			if environmentScope != "" {
+				opts = &gl.RemoveGroupVariableOptions{
+					Filter: &gl.VariableFilter{EnvironmentScope: environmentScope},
+				}
+			}
+			resp, err := glClient.GroupVariables.RemoveVariable(groupID, key, opts, gl.WithContext(ctx))
+
+			// --- Handle API errors
+			if err != nil {
+				result, apiErr := HandleAPIError(err, resp, fmt.Sprintf("variable %q in group %q", key, groupID))
+				if result != nil {
+					return result, nil
+				}
+				return nil, apiErr
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf(`{"message":"Variable %q successfully deleted from group %q"}`, key, groupID)), nil
+		}
+}
@@ -0,0 +1,600 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+func TestListProjectVariablesHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListProjectVariables(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockVariables, ctrl := setupMockClientForProjectVariables(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListProjectVariables(mockGetClient, nil)
+
+	tests := []struct {
+		name               string
+		inputArgs          map[string]any
+		mockSetup          func()
+		expectedContains   []string
+		expectedMissing    []string
+		expectHandlerError bool
+		expectResultError  bool
+	}{
+		{
+			name:      "Success - Masked values are redacted",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockVariables.EXPECT().
+					ListVariables("group/project", gomock.Any(), gomock.Any()).
+					Return([]*gl.ProjectVariable{
+						{Key: "API_TOKEN", Value: "super-secret", Masked: true},
+						{Key: "LOG_LEVEL", Value: "debug"},
+					}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedContains: []string{`"value":"[MASKED]"`, `"value":"debug"`},
+			expectedMissing:  []string{"super-secret"},
+		},
+		{
+			name:      "Success - Empty list",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockVariables.EXPECT().
+					ListVariables("group/project", gomock.Any(), gomock.Any()).
+					Return([]*gl.ProjectVariable{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedContains: []string{"[]"},
+		},
+		{
+			name:              "Error - Missing projectId",
+			inputArgs:         map[string]any{},
+			mockSetup:         func() {},
+			expectedContains:  []string{"Validation Error: missing required parameter: projectId"},
+			expectResultError: true,
+		},
+		{
+			name:      "Error - Forbidden (403)",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockVariables.EXPECT().
+					ListVariables("group/project", gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 403}}, errors.New("gitlab: 403 Forbidden"))
+			},
+			expectHandlerError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.inputArgs}})
+
+			if tc.expectHandlerError {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, "failed to list variables from project")
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, tc.expectResultError, result.IsError)
+			text := getTextResult(t, result).Text
+			for _, s := range tc.expectedContains {
+				assert.Contains(t, text, s)
+			}
+			for _, s := range tc.expectedMissing {
+				assert.NotContains(t, text, s)
+			}
+		})
+	}
+}
+
+func TestCreateProjectVariableHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := CreateProjectVariable(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockVariables, ctrl := setupMockClientForProjectVariables(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := CreateProjectVariable(mockGetClient, nil)
+
+	t.Run("Success - Masked variable is redacted in result", func(t *testing.T) {
+		mockVariables.EXPECT().
+			CreateVariable("group/project", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.CreateProjectVariableOptions, _ ...gl.RequestOptionFunc) (*gl.ProjectVariable, *gl.Response, error) {
+				assert.Equal(t, "API_TOKEN", *opts.Key)
+				assert.Equal(t, "super-secret", *opts.Value)
+				assert.Equal(t, gl.FileVariableType, *opts.VariableType)
+				assert.True(t, *opts.Masked)
+				assert.True(t, *opts.Protected)
+				assert.Equal(t, "production", *opts.EnvironmentScope)
+				return &gl.ProjectVariable{Key: "API_TOKEN", Value: "super-secret", Masked: true, Protected: true}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":        "group/project",
+			"key":              "API_TOKEN",
+			"value":            "super-secret",
+			"variableType":     "file",
+			"masked":           true,
+			"protected":        true,
+			"environmentScope": "production",
+		}}})
+		require.NoError(t, err)
+		text := getTextResult(t, result).Text
+		assert.Contains(t, text, `"value":"[MASKED]"`)
+		assert.NotContains(t, text, "super-secret")
+	})
+
+	t.Run("Error - Missing value", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"key":       "API_TOKEN",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: value")
+	})
+
+	t.Run("Error - Duplicate key (400)", func(t *testing.T) {
+		mockVariables.EXPECT().
+			CreateVariable("group/project", gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 400}}, errors.New("gitlab: 400 key has already been taken"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"key":       "API_TOKEN",
+			"value":     "x",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
+
+func TestUpdateProjectVariableHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := UpdateProjectVariable(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockVariables, ctrl := setupMockClientForProjectVariables(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := UpdateProjectVariable(mockGetClient, nil)
+
+	t.Run("Success - Scoped update", func(t *testing.T) {
+		mockVariables.EXPECT().
+			UpdateVariable("group/project", "LOG_LEVEL", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ string, opts *gl.UpdateProjectVariableOptions, _ ...gl.RequestOptionFunc) (*gl.ProjectVariable, *gl.Response, error) {
+				assert.Equal(t, "info", *opts.Value)
+				assert.Equal(t, "staging", opts.Filter.EnvironmentScope)
+				assert.Nil(t, opts.Masked)
+				return &gl.ProjectVariable{Key: "LOG_LEVEL", Value: "info", EnvironmentScope: "staging"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":        "group/project",
+			"key":              "LOG_LEVEL",
+			"value":            "info",
+			"environmentScope": "staging",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"value":"info"`)
+	})
+
+	t.Run("Error - Variable Not Found (404)", func(t *testing.T) {
+		mockVariables.EXPECT().
+			UpdateVariable("group/project", "MISSING", gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Variable Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"key":       "MISSING",
+			"value":     "x",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, `variable "MISSING" in project "group/project" not found`)
+	})
+}
+
+func TestDeleteProjectVariableHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := DeleteProjectVariable(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockVariables, ctrl := setupMockClientForProjectVariables(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := DeleteProjectVariable(mockGetClient, nil)
+
+	t.Run("Success - Without filter", func(t *testing.T) {
+		mockVariables.EXPECT().
+			RemoveVariable("group/project", "LOG_LEVEL", (*gl.RemoveProjectVariableOptions)(nil), gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 204}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"key":       "LOG_LEVEL",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `Variable "LOG_LEVEL" successfully deleted`)
+	})
+
+	t.Run("Success - With environment scope filter", func(t *testing.T) {
+		mockVariables.EXPECT().
+			RemoveVariable("group/project", "LOG_LEVEL", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ string, opts *gl.RemoveProjectVariableOptions, _ ...gl.RequestOptionFunc) (*gl.Response, error) {
+				assert.Equal(t, "staging", opts.Filter.EnvironmentScope)
+				return &gl.Response{Response: &http.Response{StatusCode: 204}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId":        "group/project",
+			"key":              "LOG_LEVEL",
+			"environmentScope": "staging",
+		}}})
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+}
+
+func TestListGroupVariablesHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListGroupVariables(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockVariables, ctrl := setupMockClientForGroupVariables(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListGroupVariables(mockGetClient, nil)
+
+	tests := []struct {
+		name               string
+		inputArgs          map[string]any
+		mockSetup          func()
+		expectedContains   []string
+		expectedMissing    []string
+		expectHandlerError bool
+		expectResultError  bool
+	}{
+		{
+			name:      "Success - Masked values are redacted",
+			inputArgs: map[string]any{"groupId": "my-group"},
+			mockSetup: func() {
+				mockVariables.EXPECT().
+					ListVariables("my-group", gomock.Any(), gomock.Any()).
+					Return([]*gl.GroupVariable{
+						{Key: "API_TOKEN", Value: "super-secret", Masked: true},
+						{Key: "LOG_LEVEL", Value: "debug"},
+					}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedContains: []string{`"value":"[MASKED]"`, `"value":"debug"`},
+			expectedMissing:  []string{"super-secret"},
+		},
+		{
+			name:      "Success - Empty list",
+			inputArgs: map[string]any{"groupId": "my-group"},
+			mockSetup: func() {
+				mockVariables.EXPECT().
+					ListVariables("my-group", gomock.Any(), gomock.Any()).
+					Return([]*gl.GroupVariable{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedContains: []string{"[]"},
+		},
+		{
+			name:              "Error - Missing groupId",
+			inputArgs:         map[string]any{},
+			mockSetup:         func() {},
+			expectedContains:  []string{"Validation Error: missing required parameter: groupId"},
+			expectResultError: true,
+		},
+		{
+			name:      "Error - Forbidden (403)",
+			inputArgs: map[string]any{"groupId": "my-group"},
+			mockSetup: func() {
+				mockVariables.EXPECT().
+					ListVariables("my-group", gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 403}}, errors.New("gitlab: 403 Forbidden"))
+			},
+			expectHandlerError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.inputArgs}})
+
+			if tc.expectHandlerError {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, "failed to list variables from group")
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, tc.expectResultError, result.IsError)
+			text := getTextResult(t, result).Text
+			for _, s := range tc.expectedContains {
+				assert.Contains(t, text, s)
+			}
+			for _, s := range tc.expectedMissing {
+				assert.NotContains(t, text, s)
+			}
+		})
+	}
+}
+
+func TestGetGroupVariableHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetGroupVariable(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockVariables, ctrl := setupMockClientForGroupVariables(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := GetGroupVariable(mockGetClient, nil)
+
+	t.Run("Success - Unmasked value is returned", func(t *testing.T) {
+		mockVariables.EXPECT().
+			GetVariable("my-group", "LOG_LEVEL", (*gl.GetGroupVariableOptions)(nil), gomock.Any()).
+			Return(&gl.GroupVariable{Key: "LOG_LEVEL", Value: "debug"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+			"key":     "LOG_LEVEL",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"value":"debug"`)
+	})
+
+	t.Run("Success - Masked value is redacted", func(t *testing.T) {
+		mockVariables.EXPECT().
+			GetVariable("my-group", "DEPLOY_TOKEN", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ string, opts *gl.GetGroupVariableOptions, _ ...gl.RequestOptionFunc) (*gl.GroupVariable, *gl.Response, error) {
+				assert.Equal(t, "production", opts.Filter.EnvironmentScope)
+				return &gl.GroupVariable{Key: "DEPLOY_TOKEN", Value: "s3cr3t", Masked: true, EnvironmentScope: "production"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId":          "my-group",
+			"key":              "DEPLOY_TOKEN",
+			"environmentScope": "production",
+		}}})
+		require.NoError(t, err)
+		text := getTextResult(t, result).Text
+		assert.Contains(t, text, `"value":"[MASKED]"`)
+		assert.NotContains(t, text, "s3cr3t")
+	})
+
+	t.Run("Error - Missing key", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: key")
+	})
+
+	t.Run("Error - Not found (404)", func(t *testing.T) {
+		mockVariables.EXPECT().
+			GetVariable("my-group", "MISSING", gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Variable Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+			"key":     "MISSING",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, `variable "MISSING" in group "my-group" not found`)
+	})
+}
+
+func TestCreateGroupVariableHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := CreateGroupVariable(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockVariables, ctrl := setupMockClientForGroupVariables(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := CreateGroupVariable(mockGetClient, nil)
+
+	t.Run("Success - Masked variable is redacted in result", func(t *testing.T) {
+		mockVariables.EXPECT().
+			CreateVariable("my-group", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.CreateGroupVariableOptions, _ ...gl.RequestOptionFunc) (*gl.GroupVariable, *gl.Response, error) {
+				assert.Equal(t, "API_TOKEN", *opts.Key)
+				assert.Equal(t, "super-secret", *opts.Value)
+				assert.Equal(t, gl.FileVariableType, *opts.VariableType)
+				assert.True(t, *opts.Masked)
+				assert.True(t, *opts.Protected)
+				assert.Equal(t, "production", *opts.EnvironmentScope)
+				return &gl.GroupVariable{Key: "API_TOKEN", Value: "super-secret", Masked: true, Protected: true}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId":          "my-group",
+			"key":              "API_TOKEN",
+			"value":            "super-secret",
+			"variableType":     "file",
+			"masked":           true,
+			"protected":        true,
+			"environmentScope": "production",
+		}}})
+		require.NoError(t, err)
+		text := getTextResult(t, result).Text
+		assert.Contains(t, text, `"value":"[MASKED]"`)
+		assert.NotContains(t, text, "super-secret")
+	})
+
+	t.Run("Error - Missing value", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+			"key":     "API_TOKEN",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: value")
+	})
+
+	t.Run("Error - Duplicate key (400)", func(t *testing.T) {
+		mockVariables.EXPECT().
+			CreateVariable("my-group", gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 400}}, errors.New("gitlab: 400 key has already been taken"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+			"key":     "API_TOKEN",
+			"value":   "x",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+}
+
+func TestUpdateGroupVariableHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := UpdateGroupVariable(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockVariables, ctrl := setupMockClientForGroupVariables(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := UpdateGroupVariable(mockGetClient, nil)
+
+	t.Run("Success - Scoped update", func(t *testing.T) {
+		mockVariables.EXPECT().
+			UpdateVariable("my-group", "LOG_LEVEL", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ string, opts *gl.UpdateGroupVariableOptions, _ ...gl.RequestOptionFunc) (*gl.GroupVariable, *gl.Response, error) {
+				assert.Equal(t, "info", *opts.Value)
+				assert.Equal(t, "staging", opts.Filter.EnvironmentScope)
+				assert.Nil(t, opts.Masked)
+				return &gl.GroupVariable{Key: "LOG_LEVEL", Value: "info", EnvironmentScope: "staging"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId":          "my-group",
+			"key":              "LOG_LEVEL",
+			"value":            "info",
+			"environmentScope": "staging",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"value":"info"`)
+	})
+
+	t.Run("Error - Variable Not Found (404)", func(t *testing.T) {
+		mockVariables.EXPECT().
+			UpdateVariable("my-group", "MISSING", gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Variable Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+			"key":     "MISSING",
+			"value":   "x",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, `variable "MISSING" in group "my-group" not found`)
+	})
+}
+
+func TestDeleteGroupVariableHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := DeleteGroupVariable(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockVariables, ctrl := setupMockClientForGroupVariables(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := DeleteGroupVariable(mockGetClient, nil)
+
+	t.Run("Success - Without filter", func(t *testing.T) {
+		mockVariables.EXPECT().
+			RemoveVariable("my-group", "LOG_LEVEL", (*gl.RemoveGroupVariableOptions)(nil), gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 204}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId": "my-group",
+			"key":     "LOG_LEVEL",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `Variable "LOG_LEVEL" successfully deleted`)
+	})
+
+	t.Run("Success - With environment scope filter", func(t *testing.T) {
+		mockVariables.EXPECT().
+			RemoveVariable("my-group", "LOG_LEVEL", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ string, opts *gl.RemoveGroupVariableOptions, _ ...gl.RequestOptionFunc) (*gl.Response, error) {
+				assert.Equal(t, "staging", opts.Filter.EnvironmentScope)
+				return &gl.Response{Response: &http.Response{StatusCode: 204}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"groupId":          "my-group",
+			"key":              "LOG_LEVEL",
+			"environmentScope": "staging",
+		}}})
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+}
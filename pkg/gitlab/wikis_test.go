@@ -0,0 +1,271 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gl "gitlab.com/gitlab-org/api/client-go"
+	"go.uber.org/mock/gomock"
+
+	"github.com/InkyQuill/gitlab-mcp-server/internal/toolsnaps"
+)
+
+func TestListWikiPagesHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := ListWikiPages(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockWikis, ctrl := setupMockClientForWikis(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := ListWikiPages(mockGetClient, nil)
+
+	tests := []struct {
+		name               string
+		inputArgs          map[string]any
+		mockSetup          func()
+		expectedText       string
+		expectHandlerError bool
+		expectResultError  bool
+	}{
+		{
+			name:      "Success - With content",
+			inputArgs: map[string]any{"projectId": "group/project", "withContent": true},
+			mockSetup: func() {
+				mockWikis.EXPECT().
+					ListWikis("group/project", gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, opts *gl.ListWikisOptions, _ ...gl.RequestOptionFunc) ([]*gl.Wiki, *gl.Response, error) {
+						assert.True(t, *opts.WithContent)
+						return []*gl.Wiki{{Slug: "home", Title: "Home", Content: "Welcome"}}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+					})
+			},
+			expectedText: `"content":"Welcome"`,
+		},
+		{
+			name:      "Success - Empty list",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockWikis.EXPECT().
+					ListWikis("group/project", gomock.Any(), gomock.Any()).
+					Return([]*gl.Wiki{}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+			},
+			expectedText: "[]",
+		},
+		{
+			name:              "Error - Missing projectId",
+			inputArgs:         map[string]any{},
+			mockSetup:         func() {},
+			expectedText:      "Validation Error: missing required parameter: projectId",
+			expectResultError: true,
+		},
+		{
+			name:      "Error - Wiki disabled (403)",
+			inputArgs: map[string]any{"projectId": "group/project"},
+			mockSetup: func() {
+				mockWikis.EXPECT().
+					ListWikis("group/project", gomock.Any(), gomock.Any()).
+					Return(nil, &gl.Response{Response: &http.Response{StatusCode: 403}}, errors.New("gitlab: 403 Forbidden"))
+			},
+			expectHandlerError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockSetup()
+
+			result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.inputArgs}})
+
+			if tc.expectHandlerError {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, "failed to list wiki pages from project")
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, tc.expectResultError, result.IsError)
+			assert.Contains(t, getTextResult(t, result).Text, tc.expectedText)
+		})
+	}
+}
+
+func TestGetWikiPageHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := GetWikiPage(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockWikis, ctrl := setupMockClientForWikis(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := GetWikiPage(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockWikis.EXPECT().
+			GetWikiPage("group/project", "guides/setup", gomock.Any(), gomock.Any()).
+			Return(&gl.Wiki{Slug: "guides/setup", Title: "Setup", Content: "Run make"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"slug":      "guides/setup",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"content":"Run make"`)
+	})
+
+	t.Run("Error - Page Not Found (404)", func(t *testing.T) {
+		mockWikis.EXPECT().
+			GetWikiPage("group/project", "missing", gomock.Any(), gomock.Any()).
+			Return(nil, &gl.Response{Response: &http.Response{StatusCode: 404}}, errors.New("gitlab: 404 Not Found"))
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"slug":      "missing",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, `wiki page "missing" in project "group/project" not found`)
+	})
+}
+
+func TestCreateWikiPageHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := CreateWikiPage(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockWikis, ctrl := setupMockClientForWikis(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := CreateWikiPage(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockWikis.EXPECT().
+			CreateWikiPage("group/project", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, opts *gl.CreateWikiPageOptions, _ ...gl.RequestOptionFunc) (*gl.Wiki, *gl.Response, error) {
+				assert.Equal(t, "Setup", *opts.Title)
+				assert.Equal(t, "= Setup", *opts.Content)
+				assert.Equal(t, gl.WikiFormatASCIIDoc, *opts.Format)
+				return &gl.Wiki{Slug: "Setup", Title: "Setup", Format: gl.WikiFormatASCIIDoc}, &gl.Response{Response: &http.Response{StatusCode: 201}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"title":     "Setup",
+			"content":   "= Setup",
+			"format":    "asciidoc",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"slug":"Setup"`)
+	})
+
+	t.Run("Error - Missing content", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"title":     "Setup",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: content")
+	})
+}
+
+func TestUpdateWikiPageHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := UpdateWikiPage(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockWikis, ctrl := setupMockClientForWikis(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := UpdateWikiPage(mockGetClient, nil)
+
+	t.Run("Success - Content only", func(t *testing.T) {
+		mockWikis.EXPECT().
+			EditWikiPage("group/project", "home", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, _ string, opts *gl.EditWikiPageOptions, _ ...gl.RequestOptionFunc) (*gl.Wiki, *gl.Response, error) {
+				assert.Equal(t, "Updated", *opts.Content)
+				assert.Nil(t, opts.Title)
+				assert.Nil(t, opts.Format)
+				return &gl.Wiki{Slug: "home", Content: "Updated"}, &gl.Response{Response: &http.Response{StatusCode: 200}}, nil
+			})
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"slug":      "home",
+			"content":   "Updated",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `"content":"Updated"`)
+	})
+
+	t.Run("Error - Nothing to update", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"slug":      "home",
+		}}})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, getTextResult(t, result).Text, "at least one of title, content or format")
+	})
+}
+
+func TestDeleteWikiPageHandler(t *testing.T) {
+	// Tool schema snapshot test
+	tool, _ := DeleteWikiPage(nil, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool), "tool schema should match snapshot")
+
+	ctx := context.Background()
+	mockClient, mockWikis, ctrl := setupMockClientForWikis(t)
+	defer ctrl.Finish()
+
+	mockGetClient := func(_ context.Context) (*gl.Client, error) {
+		return mockClient, nil
+	}
+
+	_, handler := DeleteWikiPage(mockGetClient, nil)
+
+	t.Run("Success", func(t *testing.T) {
+		mockWikis.EXPECT().
+			DeleteWikiPage("group/project", "home", gomock.Any()).
+			Return(&gl.Response{Response: &http.Response{StatusCode: 204}}, nil)
+
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+			"slug":      "home",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, `Wiki page "home" successfully deleted`)
+	})
+
+	t.Run("Error - Missing slug", func(t *testing.T) {
+		result, err := handler(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+			"projectId": "group/project",
+		}}})
+		require.NoError(t, err)
+		assert.Contains(t, getTextResult(t, result).Text, "Validation Error: missing required parameter: slug")
+	})
+}
@@ -9,25 +9,34 @@ import (
 
 // IOLogger wraps io.Reader/io.Writer to log JSON-RPC messages
 type IOLogger struct {
-	in     io.Reader
-	out    io.Writer
-	logger *log.Logger
+	in       io.Reader
+	out      io.Writer
+	logger   *log.Logger
+	redactor *Redactor
 }
 
 // NewIOLogger creates a new IOLogger instance
 func NewIOLogger(in io.Reader, out io.Writer, logger *log.Logger) *IOLogger {
 	return &IOLogger{
-		in:     in,
-		out:    out,
-		logger: logger,
+		in:       in,
+		out:      out,
+		logger:   logger,
+		redactor: NewRedactor(),
 	}
 }
 
+// WithSecrets additionally redacts the values returned by secrets, such as the tokens
+// of the configured GitLab servers, from logged messages.
+func (iol *IOLogger) WithSecrets(secrets func() []string) *IOLogger {
+	iol.redactor.WithSecrets(secrets)
+	return iol
+}
+
 // Read implements io.Reader, logging incoming messages
 func (iol *IOLogger) Read(p []byte) (n int, err error) {
 	n, err = iol.in.Read(p)
 	if n > 0 {
-		logged := redactSensitive(string(p[:n]))
+		logged := iol.redactor.RedactJSON(string(p[:n]))
 		iol.logger.Debugf("IN: %s", logged)
 	}
 	return
@@ -35,7 +44,7 @@ func (iol *IOLogger) Read(p []byte) (n int, err error) {
 
 // Write implements io.Writer, logging outgoing messages
 func (iol *IOLogger) Write(p []byte) (n int, err error) {
-	logged := redactSensitive(string(p))
+	logged := iol.redactor.RedactJSON(string(p))
 	iol.logger.Debugf("OUT: %s", logged)
 	return iol.out.Write(p)
 }
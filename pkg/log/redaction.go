@@ -11,6 +11,8 @@ import (
 var (
 	// GitLab tokens: glpat-xxxxxxxxxxxx
 	gitlabTokenPattern = regexp.MustCompile(`glpat-[a-zA-Z0-9_-]{20,}`)
+	// GitLab deploy tokens: gldt-xxxxxxxxxxxx
+	gitlabDeployTokenPattern = regexp.MustCompile(`gldt-[a-zA-Z0-9_-]{20,}`)
 	// GitLab personal access tokens (older format): private_token-xxxxxxxxxxxx
 	gitlabPrivateTokenPattern = regexp.MustCompile(`private[_-]?token\s*[:=]\s*[a-zA-Z0-9_-]{20,}`)
 	// GitHub tokens: ghp_xxxxxxxxxxxxxxxxxx, gho_xxxxxxxxxxxxxxxxxx, ghu_xxxxxxxxxxxxxxxxxx, ghs_xxxxxxxxxxxxxxxxxx
@@ -40,7 +42,8 @@ var (
 // Redactor recursively removes sensitive data from objects
 type Redactor struct {
 	sensitiveKeys map[string]bool
-	maxLength     int // Maximum length for redacted strings
+	maxLength     int             // Maximum length for redacted strings
+	secrets       func() []string // Known secret values, e.g. the configured tokens
 }
 
 // NewRedactor creates a new redactor with default sensitive keys
@@ -88,6 +91,13 @@ func (r *Redactor) WithSensitiveKeys(keys ...string) *Redactor {
 	return r
 }
 
+// WithSecrets redacts the values returned by secrets wherever they appear, whatever their
+// shape. secrets is called on every redaction so that values added later are covered.
+func (r *Redactor) WithSecrets(secrets func() []string) *Redactor {
+	r.secrets = secrets
+	return r
+}
+
 // RedactJSON removes sensitive data from JSON string
 func (r *Redactor) RedactJSON(input string) string {
 	var data interface{}
@@ -158,8 +168,18 @@ func (r *Redactor) redactArray(arr []interface{}) []interface{} {
 
 // redactString processes a string value
 func (r *Redactor) redactString(s string) string {
+	result := s
+	if r.secrets != nil {
+		for _, secret := range r.secrets() {
+			if secret != "" {
+				result = strings.ReplaceAll(result, secret, "[REDACTED]")
+			}
+		}
+	}
+
 	// Apply all patterns in order
-	result := gitlabTokenPattern.ReplaceAllString(s, "glpat-***REDACTED***")
+	result = gitlabTokenPattern.ReplaceAllString(result, "[REDACTED]")
+	result = gitlabDeployTokenPattern.ReplaceAllString(result, "[REDACTED]")
 	result = gitlabPrivateTokenPattern.ReplaceAllString(result, "private_token-***REDACTED***")
 	result = githubTokenPattern.ReplaceAllString(result, "***GITHUB_TOKEN_REDACTED***")
 	result = githubClassicTokenPattern.ReplaceAllString(result, "***GITHUB_TOKEN_REDACTED***")
@@ -3,6 +3,9 @@ package toolsets
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
 	"sync"
 
 	"github.com/mark3labs/mcp-go/mcp"
@@ -13,18 +16,20 @@ import (
 type Toolset struct {
 	Name        string
 	Description string
-	Enabled     bool // Whether this toolset is active based on configuration
-	readOnly    bool // Whether the toolset (and its tools) should operate in read-only mode
+	Enabled     bool     // Whether this toolset is active based on configuration
+	readOnly    bool     // Whether the toolset (and its tools) should operate in read-only mode
+	optional    bool     // Whether the toolset is left out of "all" and must be enabled by name
+	DependsOn   []string // Names of the toolsets that are enabled along with this one
 	writeTools  []server.ServerTool
 	readTools   []server.ServerTool
 }
 
 // ToolsetInfo provides metadata about a toolset.
 type ToolsetInfo struct {
-	Name        string
-	Description string
-	Enabled     bool
-	ToolCount   int
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Enabled     bool   `json:"enabled"`
+	ToolCount   int    `json:"toolCount"`
 }
 
 // ToolsetGroup manages a collection of Toolsets.
@@ -56,6 +61,14 @@ func NewToolset(name string, description string) *Toolset {
 	}
 }
 
+// NewOptionalToolset creates a new, disabled Toolset that is not enabled by the "all"
+// keyword. It is meant for features that need a paid GitLab tier and must be requested by name.
+func NewOptionalToolset(name string, description string) *Toolset {
+	ts := NewToolset(name, description)
+	ts.optional = true
+	return ts
+}
+
 // AddReadTools adds tools intended for read-only operations to the Toolset.
 // It enforces that the mcp.Tool definition includes Annotations.ReadOnlyHint = true.
 func (t *Toolset) AddReadTools(tools ...server.ServerTool) *Toolset {
@@ -131,6 +144,11 @@ func (t *Toolset) Tools() []server.ServerTool {
 	return allTools
 }
 
+// IsOptional returns whether the toolset is left out of the "all" keyword.
+func (t *Toolset) IsOptional() bool {
+	return t.optional
+}
+
 // IsEnabled returns whether the toolset is currently enabled.
 func (t *Toolset) IsEnabled() bool {
 	return t.Enabled
@@ -187,18 +205,25 @@ func (tg *ToolsetGroup) EnableToolset(name string) error {
 }
 
 // EnableToolsets enables multiple toolsets based on a list of names.
-// Handles the special "all" keyword to enable all known toolsets.
+// Handles the special "all" keyword to enable all known toolsets except optional ones.
+// Other names listed along with "all", e.g. optional toolsets, are enabled as well.
 func (tg *ToolsetGroup) EnableToolsets(names []string) error {
 	if len(names) == 0 {
 		return errors.New("no toolsets specified to enable") // Or enable none/default?
 	}
 
-	if len(names) == 1 && names[0] == "all" {
+	if slices.Contains(names, "all") {
 		tg.mu.Lock()
 		defer tg.mu.Unlock()
+		// Check all names first so that an unknown one leaves the toolsets unchanged
+		for _, name := range names {
+			if _, ok := tg.Toolsets[name]; !ok && name != "all" {
+				return fmt.Errorf("toolset '%s' not found", name)
+			}
+		}
 		tg.everythingOn = true
-		for _, ts := range tg.Toolsets {
-			ts.Enabled = true
+		for name, ts := range tg.Toolsets {
+			ts.Enabled = !ts.optional || slices.Contains(names, name)
 		}
 		return nil
 	}
@@ -221,6 +246,59 @@ func (tg *ToolsetGroup) EnableToolsets(names []string) error {
 	return nil
 }
 
+// ResolveDependencies expands names with the toolsets they depend on, directly or
+// transitively. Dependencies are ordered before the toolsets that need them and each name
+// appears once. Names that are not known toolsets are passed through so that enabling them
+// reports the error. An error is returned for an unknown dependency or a dependency cycle.
+func (tg *ToolsetGroup) ResolveDependencies(names []string) ([]string, error) {
+	tg.mu.RLock()
+	defer tg.mu.RUnlock()
+
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := make(map[string]int, len(names))
+	resolved := make([]string, 0, len(names))
+
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("toolset dependency cycle: %s", strings.Join(append(path, name), " -> "))
+		}
+
+		ts, ok := tg.Toolsets[name]
+		if !ok {
+			if len(path) > 0 {
+				return fmt.Errorf("toolset '%s' depends on unknown toolset '%s'", path[len(path)-1], name)
+			}
+			state[name] = visited
+			resolved = append(resolved, name)
+			return nil
+		}
+
+		state[name] = visiting
+		for _, dep := range ts.DependsOn {
+			if err := visit(dep, append(path, name)); err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+		resolved = append(resolved, name)
+		return nil
+	}
+
+	for _, name := range names {
+		if err := visit(name, nil); err != nil {
+			return nil, err
+		}
+	}
+	return resolved, nil
+}
+
 // RegisterTools iterates through all managed Toolsets and registers the active tools
 // of the *enabled* ones with the provided MCP server.
 func (tg *ToolsetGroup) RegisterTools(s *server.MCPServer) {
@@ -234,7 +312,7 @@ func (tg *ToolsetGroup) RegisterTools(s *server.MCPServer) {
 	}
 }
 
-// ListToolsets returns information about all available toolsets.
+// ListToolsets returns information about all available toolsets, sorted by name.
 func (tg *ToolsetGroup) ListToolsets() []ToolsetInfo {
 	tg.mu.RLock()
 	defer tg.mu.RUnlock()
@@ -249,5 +327,26 @@ func (tg *ToolsetGroup) ListToolsets() []ToolsetInfo {
 		}
 		infos = append(infos, info)
 	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Name < infos[j].Name
+	})
 	return infos
 }
+
+// ListTools returns the definitions of all active tools of the enabled toolsets,
+// sorted by tool name.
+func (tg *ToolsetGroup) ListTools() []mcp.Tool {
+	tg.mu.RLock()
+	defer tg.mu.RUnlock()
+
+	tools := make([]mcp.Tool, 0)
+	for _, ts := range tg.Toolsets {
+		for _, st := range ts.GetActiveTools() {
+			tools = append(tools, st.Tool)
+		}
+	}
+	sort.Slice(tools, func(i, j int) bool {
+		return tools[i].Name < tools[j].Name
+	})
+	return tools
+}
@@ -118,6 +118,62 @@ func TestToolsetGroup_EnableToolsets(t *testing.T) {
 			expectEnabled: []string{"ts1", "ts2"}, // All initially added toolsets
 			expectAllOn:   true,
 		},
+		{
+			name: "Enable all keyword skips optional toolsets",
+			initialToolsets: map[string]*Toolset{
+				"ts1": NewToolset("ts1", ""),
+				"ts2": NewOptionalToolset("ts2", ""),
+			},
+			namesToEnable: []string{"all"},
+			expectError:   false,
+			expectEnabled: []string{"ts1"},
+			expectAllOn:   true,
+		},
+		{
+			name: "Enable all keyword with optional toolset",
+			initialToolsets: map[string]*Toolset{
+				"ts1": NewToolset("ts1", ""),
+				"ts2": NewOptionalToolset("ts2", ""),
+				"ts3": NewOptionalToolset("ts3", ""),
+			},
+			namesToEnable: []string{"all", "ts2"},
+			expectError:   false,
+			expectEnabled: []string{"ts1", "ts2"},
+			expectAllOn:   true,
+		},
+		{
+			name: "Enable all keyword with already included toolset",
+			initialToolsets: map[string]*Toolset{
+				"ts1": NewToolset("ts1", ""),
+				"ts2": NewToolset("ts2", ""),
+			},
+			namesToEnable: []string{"ts1", "all"},
+			expectError:   false,
+			expectEnabled: []string{"ts1", "ts2"},
+			expectAllOn:   true,
+		},
+		{
+			name: "Enable all keyword with non-existent",
+			initialToolsets: map[string]*Toolset{
+				"ts1": NewToolset("ts1", ""),
+			},
+			namesToEnable: []string{"all", "non-existent"},
+			expectError:   true,
+			errContains:   "toolset 'non-existent' not found",
+			expectEnabled: []string{},
+			expectAllOn:   false,
+		},
+		{
+			name: "Enable optional toolset by name",
+			initialToolsets: map[string]*Toolset{
+				"ts1": NewToolset("ts1", ""),
+				"ts2": NewOptionalToolset("ts2", ""),
+			},
+			namesToEnable: []string{"ts2"},
+			expectError:   false,
+			expectEnabled: []string{"ts2"},
+			expectAllOn:   false,
+		},
 		{
 			name: "Enable non-existent",
 			initialToolsets: map[string]*Toolset{
@@ -189,6 +245,94 @@ func TestToolsetGroup_EnableToolsets(t *testing.T) {
 
 // --- Toolset Tests ---
 
+func TestToolsetGroup_ResolveDependencies(t *testing.T) {
+	newGroup := func(deps map[string][]string) *ToolsetGroup {
+		tg := NewToolsetGroup(false)
+		for name, dependsOn := range deps {
+			ts := NewToolset(name, "")
+			ts.DependsOn = dependsOn
+			tg.AddToolset(ts)
+		}
+		return tg
+	}
+
+	tests := []struct {
+		name        string
+		deps        map[string][]string
+		names       []string
+		expected    []string
+		errContains string
+	}{
+		{
+			name:     "No dependencies",
+			deps:     map[string][]string{"ts1": nil, "ts2": nil},
+			names:    []string{"ts2", "ts1"},
+			expected: []string{"ts2", "ts1"},
+		},
+		{
+			name:     "Transitive dependencies come first",
+			deps:     map[string][]string{"a": {"b"}, "b": {"c"}, "c": nil, "d": nil},
+			names:    []string{"a"},
+			expected: []string{"c", "b", "a"},
+		},
+		{
+			name:     "Shared dependency appears once",
+			deps:     map[string][]string{"a": {"c"}, "b": {"c"}, "c": nil},
+			names:    []string{"a", "b", "c"},
+			expected: []string{"c", "a", "b"},
+		},
+		{
+			name:     "All keyword is passed through",
+			deps:     map[string][]string{"a": {"b"}, "b": nil},
+			names:    []string{"all"},
+			expected: []string{"all"},
+		},
+		{
+			name:     "All keyword is passed through with other toolsets",
+			deps:     map[string][]string{"a": {"b"}, "b": nil},
+			names:    []string{"all", "a"},
+			expected: []string{"all", "b", "a"},
+		},
+		{
+			name:     "Unknown requested toolset is passed through",
+			deps:     map[string][]string{"a": nil},
+			names:    []string{"a", "unknown"},
+			expected: []string{"a", "unknown"},
+		},
+		{
+			name:        "Unknown dependency",
+			deps:        map[string][]string{"a": {"missing"}},
+			names:       []string{"a"},
+			errContains: "toolset 'a' depends on unknown toolset 'missing'",
+		},
+		{
+			name:        "Cycle",
+			deps:        map[string][]string{"a": {"b"}, "b": {"c"}, "c": {"a"}},
+			names:       []string{"a"},
+			errContains: "toolset dependency cycle: a -> b -> c -> a",
+		},
+		{
+			name:        "Self dependency",
+			deps:        map[string][]string{"a": {"a"}},
+			names:       []string{"a"},
+			errContains: "toolset dependency cycle: a -> a",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resolved, err := newGroup(tc.deps).ResolveDependencies(tc.names)
+			if tc.errContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errContains)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, resolved)
+		})
+	}
+}
+
 func TestNewServerTool(t *testing.T) {
 	tool := mcp.Tool{
 		Name:        "test_tool",
@@ -617,3 +761,52 @@ func TestToolsetGroup_ListToolsets(t *testing.T) {
 		assert.True(t, infoMap["ts2"].Enabled)
 	})
 }
+
+func TestToolsetGroup_ListTools(t *testing.T) {
+	tg := NewToolsetGroup(false)
+
+	ts1 := NewToolset("ts1", "Toolset 1")
+	ts1.Enable()
+	ts1.AddReadTools(NewServerTool(mcp.Tool{Name: "zeta", Description: "Read zeta"}, nil))
+	ts1.AddWriteTools(NewServerTool(mcp.Tool{Name: "alpha", Description: "Write alpha"}, nil))
+
+	ts2 := NewToolset("ts2", "Toolset 2")
+	// ts2 is disabled
+	ts2.AddReadTools(NewServerTool(mcp.Tool{Name: "hidden"}, nil))
+
+	ts3 := NewToolset("ts3", "Toolset 3")
+	ts3.Enable()
+	ts3.AddReadTools(NewServerTool(mcp.Tool{Name: "beta"}, nil))
+
+	tg.AddToolset(ts1)
+	tg.AddToolset(ts2)
+	tg.AddToolset(ts3)
+
+	t.Run("Success - Tools of enabled toolsets sorted by name", func(t *testing.T) {
+		tools := tg.ListTools()
+
+		names := make([]string, 0, len(tools))
+		for _, tool := range tools {
+			names = append(names, tool.Name)
+		}
+		assert.Equal(t, []string{"alpha", "beta", "zeta"}, names)
+		assert.Equal(t, "Write alpha", tools[0].Description)
+	})
+
+	t.Run("Success - Read-only toolset omits write tools", func(t *testing.T) {
+		ts1.SetReadOnly()
+
+		names := make([]string, 0)
+		for _, tool := range tg.ListTools() {
+			names = append(names, tool.Name)
+		}
+		assert.Equal(t, []string{"beta", "zeta"}, names)
+	})
+
+	t.Run("Success - Empty group", func(t *testing.T) {
+		tools := NewToolsetGroup(false).ListTools()
+
+		assert.NotNil(t, tools)
+		assert.Len(t, tools, 0)
+	})
+}
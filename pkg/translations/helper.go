@@ -107,25 +107,162 @@ func getAllTranslationKeys() map[string]string {
 		TOOL_GET_PROJECT_BRANCHES_DESCRIPTION: "Lists all branches in a GitLab project.",
 		TOOL_GET_PROJECT_COMMITS_DESCRIPTION:  "Lists commits for a specific branch or ref in a GitLab project.",
 
+		TOOL_LIST_PROJECT_HOOKS_DESCRIPTION:  "Lists webhooks configured on a GitLab project.",
+		TOOL_CREATE_PROJECT_HOOK_DESCRIPTION: "Adds a webhook to a GitLab project, with per-event triggers.",
+		TOOL_DELETE_PROJECT_HOOK_DESCRIPTION: "Removes a webhook from a GitLab project.",
+
+		TOOL_LIST_PROJECT_ACCESS_TOKENS_DESCRIPTION:  "Lists the access tokens of a GitLab project. Token secrets are masked.",
+		TOOL_CREATE_PROJECT_ACCESS_TOKEN_DESCRIPTION: "Creates a project access token and returns its secret value, which GitLab only shows once.",
+		TOOL_REVOKE_PROJECT_ACCESS_TOKEN_DESCRIPTION: "Revokes an access token of a GitLab project.",
+
+		TOOL_LIST_PROJECT_DEPLOY_KEYS_DESCRIPTION:  "Lists the SSH deploy keys enabled on a GitLab project.",
+		TOOL_ADD_PROJECT_DEPLOY_KEY_DESCRIPTION:    "Adds an SSH deploy key to a GitLab project, read-only unless push access is granted.",
+		TOOL_DELETE_PROJECT_DEPLOY_KEY_DESCRIPTION: "Removes a deploy key from a GitLab project.",
+
+		TOOL_LIST_DEPLOY_TOKENS_DESCRIPTION:  "Lists the deploy tokens of a GitLab project or group. Token secrets are masked.",
+		TOOL_CREATE_DEPLOY_TOKEN_DESCRIPTION: "Creates a deploy token for a GitLab project or group. The token secret is only returned once, in this response.",
+		TOOL_REVOKE_DEPLOY_TOKEN_DESCRIPTION: "Revokes a deploy token of a GitLab project or group.",
+
+		TOOL_GET_COMMIT_STATUSES_DESCRIPTION:                 "Lists the build statuses reported on a commit, including those set by external CI systems.",
+		TOOL_SET_COMMIT_STATUS_DESCRIPTION:                   "Sets the build status of a commit, as reported by an external CI system.",
+		TOOL_CREATE_COMMIT_WITH_MULTIPLE_ACTIONS_DESCRIPTION: "Creates a commit that creates, updates, moves, deletes or changes the mode of several files in one atomic operation.",
+
+		TOOL_GET_PROJECT_STATISTICS_DESCRIPTION:      "Gets the commit count and storage usage (repository, wiki, LFS, artifacts, packages, registry) of a GitLab project.",
+		TOOL_GET_PROJECT_LANGUAGES_DESCRIPTION:       "Gets the programming languages used in a GitLab project's repository, as a map of language to percentage.",
+		TOOL_GET_PROJECT_INSIGHTS_DESCRIPTION:        "Gets summary analytics of a GitLab project: contributor, commit, open issue and open merge request counts, and storage size. Results are cached for 60 seconds.",
+		TOOL_GET_CONTRIBUTORS_DESCRIPTION:            "Lists the contributors of a GitLab project's repository with their commit, addition and deletion counts.",
+		TOOL_COMPARE_REPOSITORY_REFS_DESCRIPTION:     "Compares two branches, tags or commits of a GitLab project, returning the commits and file diffs between them. Large diffs are truncated.",
+		TOOL_GET_REPOSITORY_ARCHIVE_LINK_DESCRIPTION: "Returns the download URL of a repository archive for a branch, tag or commit, pinned to the resolved commit SHA. GitLab streams archives from this URL rather than redirecting; the archive is not downloaded or checked here. Downloading it requires a token with read_repository access to the project.",
+
+		TOOL_LIST_NAMESPACES_DESCRIPTION: "Lists the user and group namespaces visible to the current user, with optional search. Use it to find the namespace ID to create a project in.",
+		TOOL_GET_NAMESPACE_DESCRIPTION:   "Gets a single GitLab namespace (user or group) by ID or path.",
+
+		// Requirements toolset (GitLab Premium)
+		TOOL_GET_PROJECT_REQUIREMENTS_DESCRIPTION: "Lists the requirements of a GitLab project, with optional state, search and IID filters. Requires GitLab Premium; only available when the 'requirements' toolset is enabled by name.",
+
+		TOOL_LIST_PROTECTED_BRANCHES_DESCRIPTION: "Lists the protected branches of a GitLab project with their push, merge and unprotect access levels.",
+		TOOL_GET_PROTECTED_BRANCH_DESCRIPTION:    "Gets the protection rules of a single protected branch or wildcard.",
+		TOOL_PROTECT_BRANCH_DESCRIPTION:          "Protects a branch or wildcard of a GitLab project, setting who may push and merge.",
+		TOOL_UNPROTECT_BRANCH_DESCRIPTION:        "Removes the protection from a branch or wildcard of a GitLab project.",
+
+		TOOL_LIST_PROTECTED_TAGS_DESCRIPTION: "Lists the protected tags of a GitLab project with the access levels allowed to create them.",
+		TOOL_PROTECT_TAG_DESCRIPTION:         "Protects a tag or wildcard of a GitLab project, setting who may create matching tags.",
+		TOOL_UNPROTECT_TAG_DESCRIPTION:       "Removes the protection from a tag or wildcard of a GitLab project.",
+
 		// Issues toolset
 		TOOL_GET_ISSUE_DESCRIPTION:        "Retrieves details for a specific GitLab issue.",
 		TOOL_LIST_ISSUES_DESCRIPTION:      "Lists GitLab issues, with optional filtering.",
-		TOOL_CREATE_ISSUE_DESCRIPTION:     "Creates a new issue in a GitLab project.",
-		TOOL_UPDATE_ISSUE_DESCRIPTION:     "Updates an existing GitLab issue.",
+		TOOL_CREATE_ISSUE_DESCRIPTION:     "Creates a new issue in a GitLab project. Setting a weight requires GitLab Premium.",
+		TOOL_UPDATE_ISSUE_DESCRIPTION:     "Updates an existing GitLab issue. Setting a weight requires GitLab Premium.",
 		TOOL_ISSUE_COMMENT_DESCRIPTION:    "Manages comments on GitLab issues (list, create, update).",
 		TOOL_GET_ISSUE_LABELS_DESCRIPTION: "Retrieves labels for a specific GitLab project.",
 		TOOL_MILESTONE_DESCRIPTION:        "Manages GitLab milestones (get, create, update).",
 		TOOL_LIST_MILESTONES_DESCRIPTION:  "Lists milestones for a specific GitLab project.",
 
+		TOOL_GET_ISSUE_RELATED_MERGE_REQUESTS_DESCRIPTION: "Lists merge requests related to a GitLab issue, e.g. those that mention it.",
+		TOOL_GET_ISSUE_CLOSING_MERGE_REQUESTS_DESCRIPTION: "Lists merge requests that will close a GitLab issue when merged.",
+		TOOL_MOVE_ISSUE_DESCRIPTION:                       "Moves a GitLab issue to another project. The moved issue gets a new IID in the target project.",
+		TOOL_BULK_CREATE_ISSUES_DESCRIPTION:               "Creates up to 50 issues in a GitLab project with one call, reporting the IID or error of each.",
+		TOOL_BULK_CLOSE_ISSUES_DESCRIPTION:                "Closes up to 50 issues of a GitLab project with one call, reporting the outcome for each.",
+		TOOL_CLOSE_ISSUES_BY_MILESTONE_DESCRIPTION:        "Closes up to 100 open issues of a GitLab project milestone, reporting how many were closed and which failed.",
+		TOOL_GET_ISSUE_PARTICIPANTS_DESCRIPTION:           "Lists the participants of a GitLab issue: its author, assignees and commenters.",
+		TOOL_SUBSCRIBE_TO_ISSUE_DESCRIPTION:               "Subscribes the current user to notifications of a GitLab issue.",
+		TOOL_UNSUBSCRIBE_FROM_ISSUE_DESCRIPTION:           "Unsubscribes the current user from notifications of a GitLab issue.",
+		TOOL_ADD_ISSUE_LABELS_DESCRIPTION:                 "Adds labels to a GitLab issue, keeping its other labels.",
+		TOOL_REMOVE_ISSUE_LABELS_DESCRIPTION:              "Removes labels from a GitLab issue, keeping its other labels.",
+		TOOL_SET_ISSUE_MILESTONE_DESCRIPTION:              "Assigns a GitLab issue to a milestone.",
+		TOOL_CLEAR_ISSUE_MILESTONE_DESCRIPTION:            "Removes a GitLab issue from its milestone.",
+		TOOL_SET_ISSUE_ASSIGNEES_DESCRIPTION:              "Replaces the assignees of a GitLab issue.",
+		TOOL_CLEAR_ISSUE_ASSIGNEES_DESCRIPTION:            "Unassigns all users from a GitLab issue.",
+		TOOL_CLOSE_ISSUE_DESCRIPTION:                      "Closes a GitLab issue.",
+		TOOL_REOPEN_ISSUE_DESCRIPTION:                     "Reopens a closed GitLab issue.",
+
+		TOOL_LIST_PROJECT_MILESTONES_DESCRIPTION:  "Lists milestones for a GitLab project, filterable by state, title, search term, or IIDs.",
+		TOOL_CREATE_PROJECT_MILESTONE_DESCRIPTION: "Creates a new milestone in a GitLab project.",
+		TOOL_UPDATE_PROJECT_MILESTONE_DESCRIPTION: "Updates an existing milestone in a GitLab project.",
+		TOOL_CLOSE_PROJECT_MILESTONE_DESCRIPTION:  "Closes a milestone in a GitLab project.",
+		TOOL_LIST_GROUP_MILESTONES_DESCRIPTION:    "Lists the milestones of a group, which can be used by all projects in the group.",
+		TOOL_GET_GROUP_MILESTONE_DESCRIPTION:      "Retrieves a single milestone of a group.",
+		TOOL_CREATE_GROUP_MILESTONE_DESCRIPTION:   "Creates a milestone in a group.",
+		TOOL_UPDATE_GROUP_MILESTONE_DESCRIPTION:   "Updates a group milestone, or closes or reactivates it with stateEvent.",
+		TOOL_LIST_GROUP_LABELS_DESCRIPTION:        "Lists the labels of a group, which apply to all projects in the group.",
+		TOOL_CREATE_GROUP_LABEL_DESCRIPTION:       "Creates a label in a group.",
+		TOOL_UPDATE_GROUP_LABEL_DESCRIPTION:       "Renames a group label or changes its color or description.",
+		TOOL_DELETE_GROUP_LABEL_DESCRIPTION:       "Deletes a group label. The label is removed from all issues and merge requests of the group's projects.",
+
+		TOOL_LIST_ISSUE_DISCUSSIONS_DESCRIPTION:  "Lists the threaded discussions of a GitLab issue, including all notes of each thread.",
+		TOOL_CREATE_ISSUE_DISCUSSION_DESCRIPTION: "Starts a new discussion thread on a GitLab issue.",
+
+		TOOL_GET_TIME_TRACKING_STATS_DESCRIPTION: "Retrieves the time estimate and total time spent on a GitLab issue.",
+		TOOL_SET_TIME_ESTIMATE_DESCRIPTION:       "Sets the time estimate of a GitLab issue, e.g. '3h30m'.",
+		TOOL_RESET_TIME_ESTIMATE_DESCRIPTION:     "Resets the time estimate of a GitLab issue to zero.",
+		TOOL_ADD_TIME_SPENT_DESCRIPTION:          "Logs time spent on a GitLab issue, e.g. '1h15m'.",
+
+		TOOL_LIST_ISSUE_AWARD_EMOJI_DESCRIPTION:   "Lists the emoji reactions on a GitLab issue.",
+		TOOL_ADD_ISSUE_AWARD_EMOJI_DESCRIPTION:    "Adds an emoji reaction, e.g. 'thumbsup', to a GitLab issue.",
+		TOOL_DELETE_ISSUE_AWARD_EMOJI_DESCRIPTION: "Removes an emoji reaction from a GitLab issue.",
+
+		TOOL_LIST_PROJECT_BOARDS_DESCRIPTION:      "Lists the issue boards of a GitLab project.",
+		TOOL_GET_PROJECT_BOARD_DESCRIPTION:        "Gets a single issue board of a GitLab project, including its lists.",
+		TOOL_LIST_PROJECT_BOARD_LISTS_DESCRIPTION: "Lists the lists (columns) of a project issue board.",
+		TOOL_CREATE_BOARD_LIST_DESCRIPTION:        "Adds a label list (column) to a project issue board.",
+		TOOL_DELETE_BOARD_LIST_DESCRIPTION:        "Removes a list (column) from a project issue board.",
+
+		TOOL_LIST_EPICS_DESCRIPTION:             "Lists the epics of a GitLab group, with filters for author, labels, state, search and dates. Requires GitLab Premium.",
+		TOOL_GET_EPIC_DESCRIPTION:               "Gets a single epic of a GitLab group by its internal ID. Requires GitLab Premium.",
+		TOOL_CREATE_EPIC_DESCRIPTION:            "Creates an epic in a GitLab group. Requires GitLab Premium.",
+		TOOL_UPDATE_EPIC_DESCRIPTION:            "Updates an epic of a GitLab group, including closing or reopening it. Requires GitLab Premium.",
+		TOOL_LIST_EPIC_ISSUES_DESCRIPTION:       "Lists the issues assigned to an epic. Requires GitLab Premium.",
+		TOOL_ADD_EPIC_ISSUE_DESCRIPTION:         "Assigns an issue to an epic. Requires GitLab Premium.",
+		TOOL_REMOVE_EPIC_ISSUE_DESCRIPTION:      "Removes an issue from an epic. Requires GitLab Premium.",
+		TOOL_LIST_GROUP_EPIC_BOARDS_DESCRIPTION: "Lists the epic boards of a group (GitLab Premium).",
+		TOOL_GET_GROUP_EPIC_BOARD_DESCRIPTION:   "Retrieves a single epic board of a group, including its labels and lists (GitLab Premium).",
+		TOOL_LIST_EPIC_BOARD_LISTS_DESCRIPTION:  "Lists the lists (columns) of a group epic board (GitLab Premium).",
+
 		// Merge Requests toolset
-		TOOL_GET_MERGE_REQUEST_DESCRIPTION:     "Retrieves details for a specific GitLab merge request.",
-		TOOL_LIST_MERGE_REQUESTS_DESCRIPTION:   "Lists GitLab merge requests, with optional filtering.",
-		TOOL_CREATE_MERGE_REQUEST_DESCRIPTION:  "Creates a new merge request in a GitLab project.",
-		TOOL_UPDATE_MERGE_REQUEST_DESCRIPTION:  "Updates an existing GitLab merge request.",
-		TOOL_MERGE_REQUEST_COMMENT_DESCRIPTION: "Manages comments on GitLab merge requests (list, create, update).",
+		TOOL_GET_MERGE_REQUEST_DESCRIPTION:                 "Retrieves details for a specific GitLab merge request.",
+		TOOL_LIST_MERGE_REQUESTS_DESCRIPTION:               "Lists GitLab merge requests, with optional filtering.",
+		TOOL_CREATE_MERGE_REQUEST_DESCRIPTION:              "Creates a new merge request in a GitLab project.",
+		TOOL_UPDATE_MERGE_REQUEST_DESCRIPTION:              "Updates an existing GitLab merge request.",
+		TOOL_MERGE_REQUEST_COMMENT_DESCRIPTION:             "Manages comments on GitLab merge requests (list, create, update).",
+		TOOL_MERGE_MERGE_REQUEST_DESCRIPTION:               "Merges a GitLab merge request, optionally once its pipeline succeeds.",
+		TOOL_REBASE_MERGE_REQUEST_DESCRIPTION:              "Rebases the source branch of a GitLab merge request onto its target branch.",
+		TOOL_GET_MERGE_REQUEST_DIFF_DESCRIPTION:            "Retrieves the file diffs of the latest version of a GitLab merge request for code review.",
+		TOOL_GET_MERGE_REQUEST_DIFF_STATS_DESCRIPTION:      "Summarises the size of a GitLab merge request: the number of changed files and the lines added and removed, in total and per file.",
+		TOOL_LIST_MERGE_REQUEST_APPROVALS_DESCRIPTION:      "Retrieves the approval state of a GitLab merge request, including who approved it and how many approvals are still required.",
+		TOOL_GET_MERGE_REQUEST_APPROVAL_STATE_DESCRIPTION:  "Retrieves the approval rules of a GitLab merge request with, for each rule, who approved it and whether it is satisfied (GitLab Premium).",
+		TOOL_APPROVE_MERGE_REQUEST_DESCRIPTION:             "Approves a GitLab merge request as the current user.",
+		TOOL_UNAPPROVE_MERGE_REQUEST_DESCRIPTION:           "Removes the current user's approval from a GitLab merge request.",
+		TOOL_LIST_APPROVAL_RULES_DESCRIPTION:               "Lists the approval rules of a GitLab project, or of a merge request when mergeRequestIid is given (GitLab Premium).",
+		TOOL_CREATE_APPROVAL_RULE_DESCRIPTION:              "Creates an approval rule on a GitLab project, or on a merge request when mergeRequestIid is given (GitLab Premium).",
+		TOOL_LIST_MERGE_REQUEST_REVIEWERS_DESCRIPTION:      "Lists the users requested to review a GitLab merge request.",
+		TOOL_ADD_MERGE_REQUEST_REVIEWER_DESCRIPTION:        "Requests reviews of a GitLab merge request from additional users, keeping the existing reviewers.",
+		TOOL_REMOVE_MERGE_REQUEST_REVIEWER_DESCRIPTION:     "Removes a reviewer from a GitLab merge request.",
+		TOOL_GET_MERGE_REQUEST_PARTICIPANTS_DESCRIPTION:    "Lists the participants of a GitLab merge request: its author, assignees, reviewers and commenters.",
+		TOOL_SUBSCRIBE_TO_MERGE_REQUEST_DESCRIPTION:        "Subscribes the current user to notifications of a GitLab merge request.",
+		TOOL_UNSUBSCRIBE_FROM_MERGE_REQUEST_DESCRIPTION:    "Unsubscribes the current user from notifications of a GitLab merge request.",
+		TOOL_GET_MERGE_REQUEST_PIPELINES_DESCRIPTION:       "Lists the pipelines that ran for a merge request, newest first.",
+		TOOL_GET_LATEST_MERGE_REQUEST_PIPELINE_DESCRIPTION: "Returns the most recent pipeline of a merge request with a 'passed' flag that is true when its status is success.",
+
+		TOOL_LIST_MERGE_REQUEST_DISCUSSIONS_DESCRIPTION:   "Lists the threaded discussions of a GitLab merge request, including review threads and their resolution state.",
+		TOOL_CREATE_MERGE_REQUEST_DISCUSSION_DESCRIPTION:  "Starts a new discussion thread on a GitLab merge request.",
+		TOOL_RESOLVE_MERGE_REQUEST_DISCUSSION_DESCRIPTION: "Resolves or reopens a discussion thread on a GitLab merge request.",
+
+		TOOL_LIST_MERGE_REQUEST_AWARD_EMOJI_DESCRIPTION:   "Lists the emoji reactions on a GitLab merge request.",
+		TOOL_ADD_MERGE_REQUEST_AWARD_EMOJI_DESCRIPTION:    "Adds an emoji reaction, e.g. 'thumbsup', to a GitLab merge request.",
+		TOOL_DELETE_MERGE_REQUEST_AWARD_EMOJI_DESCRIPTION: "Removes an emoji reaction from a GitLab merge request.",
+
+		// Resource events (issues and merge requests toolsets)
+		TOOL_LIST_ISSUE_STATE_EVENTS_DESCRIPTION:                     "Lists the state changes (closed, reopened) of a GitLab issue with who made them and when.",
+		TOOL_LIST_MERGE_REQUEST_STATE_EVENTS_DESCRIPTION:             "Lists the state changes (closed, reopened, merged) of a GitLab merge request with who made them and when.",
+		TOOL_GET_ISSUE_RESOURCE_LABEL_EVENTS_DESCRIPTION:             "Lists the label changes (added, removed) of a GitLab issue with who made them and when.",
+		TOOL_GET_MERGE_REQUEST_RESOURCE_LABEL_EVENTS_DESCRIPTION:     "Lists the label changes (added, removed) of a GitLab merge request with who made them and when.",
+		TOOL_GET_ISSUE_RESOURCE_MILESTONE_EVENTS_DESCRIPTION:         "Lists the milestone changes (added, removed) of a GitLab issue with who made them and when.",
+		TOOL_GET_MERGE_REQUEST_RESOURCE_MILESTONE_EVENTS_DESCRIPTION: "Lists the milestone changes (added, removed) of a GitLab merge request with who made them and when.",
 
 		// Search toolset
-		TOOL_SEARCH_DESCRIPTION: "Searches across GitLab resources (projects, issues, merge requests, code, milestones, etc.) with support for global, group, and project scopes.",
+		TOOL_SEARCH_DESCRIPTION:       "Searches across GitLab resources (projects, issues, merge requests, code, milestones, etc.) with support for global, group, and project scopes.",
+		TOOL_SEARCH_USERS_DESCRIPTION: "Searches all users of the GitLab instance by username, name or email. Email addresses are only returned to administrators.",
 
 		// Users toolset
 		TOOL_GET_CURRENT_USER_DESCRIPTION:   "Retrieves the currently authenticated user's information.",
@@ -135,6 +272,10 @@ func getAllTranslationKeys() map[string]string {
 		TOOL_LIST_PROJECT_USERS_DESCRIPTION: "Lists users who are members of a specific GitLab project.",
 		TOOL_MANAGE_USER_STATE_DESCRIPTION:  "Manages user state (block/unblock, ban/unban, activate/deactivate, approve). Admin only.",
 
+		TOOL_LIST_TODOS_DESCRIPTION:          "Lists the to-do items of the current user, optionally filtered by action, target type and state.",
+		TOOL_MARK_TODO_DONE_DESCRIPTION:      "Marks a single to-do item of the current user as done.",
+		TOOL_MARK_ALL_TODOS_DONE_DESCRIPTION: "Marks all pending to-do items of the current user as done.",
+
 		// Token management toolset
 		TOOL_LIST_TOKENS_DESCRIPTION:       "Lists all configured GitLab tokens with their metadata.",
 		TOOL_ADD_TOKEN_DESCRIPTION:         "Adds a new GitLab token configuration.",
@@ -142,15 +283,93 @@ func getAllTranslationKeys() map[string]string {
 		TOOL_REMOVE_TOKEN_DESCRIPTION:      "Removes a GitLab token configuration.",
 		TOOL_VALIDATE_TOKEN_DESCRIPTION:    "Validates a GitLab token by checking with the API.",
 		TOOL_GET_NOTIFICATIONS_DESCRIPTION: "Retrieves notifications and warnings.",
+		TOOL_GET_TOKEN_INFO_DESCRIPTION:    "Returns the metadata of the default GitLab token (host, user, expiry) with the token value redacted. Includes a warning when the token expires within the expiry warning threshold.",
+
+		// Server metadata (always registered)
+		TOOL_GET_SERVER_METADATA_DESCRIPTION: "Returns the server version, the GitLab host and token status of the default server, the enabled toolsets and whether read-only mode is active.",
 
 		// Tags toolset
 		TOOL_TAG_DESCRIPTION:                  "Manages GitLab repository tags (get, create, delete, getCommit).",
 		TOOL_LIST_REPOSITORY_TAGS_DESCRIPTION: "Lists all tags in a GitLab repository.",
 
+		// Environments toolset
+		TOOL_LIST_ENVIRONMENTS_DESCRIPTION:   "Lists deployment environments of a GitLab project.",
+		TOOL_CREATE_ENVIRONMENT_DESCRIPTION:  "Creates a deployment environment in a GitLab project.",
+		TOOL_STOP_ENVIRONMENT_DESCRIPTION:    "Stops a deployment environment, running its on_stop action if defined.",
+		TOOL_LIST_DEPLOYMENTS_DESCRIPTION:    "Lists the deployment history of a GitLab project, optionally filtered by environment and status.",
+		TOOL_GET_DEPLOYMENT_DESCRIPTION:      "Gets details of a single deployment in a GitLab project.",
+		TOOL_LIST_FEATURE_FLAGS_DESCRIPTION:  "Lists the feature flags of a GitLab project with their rollout strategies.",
+		TOOL_CREATE_FEATURE_FLAG_DESCRIPTION: "Creates a feature flag in a GitLab project, optionally with rollout strategies.",
+		TOOL_UPDATE_FEATURE_FLAG_DESCRIPTION: "Activates, deactivates or changes the description or strategies of a feature flag.",
+		TOOL_DELETE_FEATURE_FLAG_DESCRIPTION: "Deletes a feature flag from a GitLab project.",
+
+		// Releases toolset
+		TOOL_LIST_RELEASES_DESCRIPTION:  "Lists releases in a GitLab project.",
+		TOOL_GET_RELEASE_DESCRIPTION:    "Retrieves a GitLab release by its tag name.",
+		TOOL_CREATE_RELEASE_DESCRIPTION: "Creates a release in a GitLab project, optionally creating its tag from a ref.",
+		TOOL_UPDATE_RELEASE_DESCRIPTION: "Updates an existing GitLab release.",
+		TOOL_DELETE_RELEASE_DESCRIPTION: "Deletes a GitLab release. The associated tag is kept.",
+
+		TOOL_LIST_RELEASE_LINKS_DESCRIPTION:  "Lists the asset links of a GitLab release.",
+		TOOL_CREATE_RELEASE_LINK_DESCRIPTION: "Adds an asset link (binary, package, runbook, image) to a GitLab release.",
+		TOOL_DELETE_RELEASE_LINK_DESCRIPTION: "Removes an asset link from a GitLab release.",
+
+		// Wikis toolset
+		TOOL_LIST_WIKI_PAGES_DESCRIPTION:  "Lists the wiki pages of a GitLab project, optionally with their content.",
+		TOOL_GET_WIKI_PAGE_DESCRIPTION:    "Retrieves a single wiki page of a GitLab project by its slug.",
+		TOOL_CREATE_WIKI_PAGE_DESCRIPTION: "Creates a new wiki page in a GitLab project.",
+		TOOL_UPDATE_WIKI_PAGE_DESCRIPTION: "Updates the title, content or format of an existing wiki page.",
+		TOOL_DELETE_WIKI_PAGE_DESCRIPTION: "Deletes a wiki page from a GitLab project.",
+
+		// Snippets toolset
+		TOOL_LIST_PROJECT_SNIPPETS_DESCRIPTION: "Lists the snippets of a GitLab project.",
+		TOOL_GET_SNIPPET_DESCRIPTION:           "Retrieves a project snippet including its raw content.",
+		TOOL_CREATE_SNIPPET_DESCRIPTION:        "Creates a single-file snippet in a GitLab project.",
+		TOOL_UPDATE_SNIPPET_DESCRIPTION:        "Updates the title, content, description or visibility of a project snippet.",
+		TOOL_DELETE_SNIPPET_DESCRIPTION:        "Deletes a snippet from a GitLab project.",
+
+		// Packages toolset
+		TOOL_LIST_PACKAGES_DESCRIPTION:                        "Lists the packages in a GitLab project's package registry, optionally filtered by type, name or status.",
+		TOOL_GET_PACKAGE_DESCRIPTION:                          "Retrieves a single package from a GitLab project's package registry.",
+		TOOL_DELETE_PACKAGE_DESCRIPTION:                       "Deletes a package and all its files from a GitLab project's package registry.",
+		TOOL_LIST_CONTAINER_REGISTRY_REPOSITORIES_DESCRIPTION: "Lists the container registry repositories of a GitLab project with their tag counts.",
+		TOOL_LIST_CONTAINER_REGISTRY_TAGS_DESCRIPTION:         "Lists the image tags in a container registry repository.",
+		TOOL_DELETE_CONTAINER_REGISTRY_TAG_DESCRIPTION:        "Deletes a single image tag from a container registry repository.",
+
+		// Variables toolset
+		TOOL_LIST_PROJECT_VARIABLES_DESCRIPTION:  "Lists CI/CD variables of a GitLab project. Values of masked variables are redacted.",
+		TOOL_CREATE_PROJECT_VARIABLE_DESCRIPTION: "Creates a CI/CD variable in a GitLab project.",
+		TOOL_UPDATE_PROJECT_VARIABLE_DESCRIPTION: "Updates a CI/CD variable in a GitLab project.",
+		TOOL_DELETE_PROJECT_VARIABLE_DESCRIPTION: "Deletes a CI/CD variable from a GitLab project.",
+		TOOL_LIST_GROUP_VARIABLES_DESCRIPTION:    "Lists CI/CD variables of a GitLab group. Values of masked variables are redacted.",
+		TOOL_GET_GROUP_VARIABLE_DESCRIPTION:      "Retrieves a single CI/CD variable of a GitLab group. The value of a masked variable is redacted.",
+		TOOL_CREATE_GROUP_VARIABLE_DESCRIPTION:   "Creates a CI/CD variable in a GitLab group.",
+		TOOL_UPDATE_GROUP_VARIABLE_DESCRIPTION:   "Updates a CI/CD variable in a GitLab group.",
+		TOOL_DELETE_GROUP_VARIABLE_DESCRIPTION:   "Deletes a CI/CD variable from a GitLab group.",
+
 		// Pipeline Jobs toolset
 		TOOL_PIPELINE_JOB_DESCRIPTION:       "Manages CI/CD pipeline jobs (list, get, trace).",
 		TOOL_PIPELINE_DESCRIPTION:           "Controls GitLab CI/CD pipelines (cancel, retry).",
 		TOOL_RETRY_PIPELINE_JOB_DESCRIPTION: "Retries a failed job in a pipeline.",
 		TOOL_PLAY_PIPELINE_JOB_DESCRIPTION:  "Triggers a manual job in a pipeline.",
+		TOOL_LINT_CI_CONFIG_DESCRIPTION:     "Validates .gitlab-ci.yml content in the context of a project and returns errors, warnings, and the merged YAML.",
+
+		TOOL_LIST_SCHEDULED_PIPELINES_DESCRIPTION:  "Lists the pipeline schedules of a GitLab project.",
+		TOOL_CREATE_SCHEDULED_PIPELINE_DESCRIPTION: "Creates a pipeline schedule that runs a pipeline on a ref at a cron interval, e.g. for nightly builds.",
+		TOOL_UPDATE_SCHEDULED_PIPELINE_DESCRIPTION: "Updates the description, ref, cron, timezone or active state of a pipeline schedule.",
+		TOOL_DELETE_SCHEDULED_PIPELINE_DESCRIPTION: "Deletes a pipeline schedule.",
+		TOOL_RUN_SCHEDULED_PIPELINE_DESCRIPTION:    "Triggers a pipeline schedule to run immediately.",
+
+		TOOL_GET_TEST_REPORT_DESCRIPTION:           "Gets the full test report of a pipeline: totals, per-suite test cases and the names of failed tests.",
+		TOOL_GET_PIPELINE_TEST_SUMMARY_DESCRIPTION: "Gets a lightweight test summary of a pipeline with total and per-suite counts.",
+		TOOL_GET_PIPELINE_BRIDGES_DESCRIPTION:      "Lists the bridge (trigger) jobs of a pipeline, including the downstream child or multi-project pipeline each one started.",
+
+		TOOL_LIST_JOB_ARTIFACTS_DESCRIPTION:    "Lists the artifacts of a CI job (file types, names, sizes and expiry).",
+		TOOL_DOWNLOAD_JOB_ARTIFACT_DESCRIPTION: "Downloads a single file (up to 5 MB) from a job's artifacts archive and returns it base64-encoded with its content type.",
+
+		TOOL_LIST_RUNNERS_DESCRIPTION:           "Lists the CI runners available to the current user, optionally filtered by scope and tags.",
+		TOOL_GET_RUNNER_DESCRIPTION:             "Gets the details of a CI runner, including its projects and tags.",
+		TOOL_ENABLE_PROJECT_RUNNER_DESCRIPTION:  "Assigns an existing CI runner to a project.",
+		TOOL_DISABLE_PROJECT_RUNNER_DESCRIPTION: "Removes a CI runner from a project.",
 	}
 }
@@ -9,6 +9,48 @@ const (
 	TOOL_GET_PROJECT_BRANCHES_DESCRIPTION = "TOOL_GET_PROJECT_BRANCHES_DESCRIPTION"
 	TOOL_GET_PROJECT_COMMITS_DESCRIPTION  = "TOOL_GET_PROJECT_COMMITS_DESCRIPTION"
 
+	TOOL_LIST_PROJECT_HOOKS_DESCRIPTION  = "TOOL_LIST_PROJECT_HOOKS_DESCRIPTION"
+	TOOL_CREATE_PROJECT_HOOK_DESCRIPTION = "TOOL_CREATE_PROJECT_HOOK_DESCRIPTION"
+	TOOL_DELETE_PROJECT_HOOK_DESCRIPTION = "TOOL_DELETE_PROJECT_HOOK_DESCRIPTION"
+
+	TOOL_LIST_PROJECT_ACCESS_TOKENS_DESCRIPTION  = "TOOL_LIST_PROJECT_ACCESS_TOKENS_DESCRIPTION"
+	TOOL_CREATE_PROJECT_ACCESS_TOKEN_DESCRIPTION = "TOOL_CREATE_PROJECT_ACCESS_TOKEN_DESCRIPTION"
+	TOOL_REVOKE_PROJECT_ACCESS_TOKEN_DESCRIPTION = "TOOL_REVOKE_PROJECT_ACCESS_TOKEN_DESCRIPTION"
+
+	TOOL_LIST_PROJECT_DEPLOY_KEYS_DESCRIPTION  = "TOOL_LIST_PROJECT_DEPLOY_KEYS_DESCRIPTION"
+	TOOL_ADD_PROJECT_DEPLOY_KEY_DESCRIPTION    = "TOOL_ADD_PROJECT_DEPLOY_KEY_DESCRIPTION"
+	TOOL_DELETE_PROJECT_DEPLOY_KEY_DESCRIPTION = "TOOL_DELETE_PROJECT_DEPLOY_KEY_DESCRIPTION"
+
+	TOOL_LIST_DEPLOY_TOKENS_DESCRIPTION  = "TOOL_LIST_DEPLOY_TOKENS_DESCRIPTION"
+	TOOL_CREATE_DEPLOY_TOKEN_DESCRIPTION = "TOOL_CREATE_DEPLOY_TOKEN_DESCRIPTION"
+	TOOL_REVOKE_DEPLOY_TOKEN_DESCRIPTION = "TOOL_REVOKE_DEPLOY_TOKEN_DESCRIPTION"
+
+	TOOL_GET_COMMIT_STATUSES_DESCRIPTION                 = "TOOL_GET_COMMIT_STATUSES_DESCRIPTION"
+	TOOL_SET_COMMIT_STATUS_DESCRIPTION                   = "TOOL_SET_COMMIT_STATUS_DESCRIPTION"
+	TOOL_CREATE_COMMIT_WITH_MULTIPLE_ACTIONS_DESCRIPTION = "TOOL_CREATE_COMMIT_WITH_MULTIPLE_ACTIONS_DESCRIPTION"
+
+	TOOL_GET_PROJECT_STATISTICS_DESCRIPTION      = "TOOL_GET_PROJECT_STATISTICS_DESCRIPTION"
+	TOOL_GET_PROJECT_LANGUAGES_DESCRIPTION       = "TOOL_GET_PROJECT_LANGUAGES_DESCRIPTION"
+	TOOL_GET_PROJECT_INSIGHTS_DESCRIPTION        = "TOOL_GET_PROJECT_INSIGHTS_DESCRIPTION"
+	TOOL_GET_CONTRIBUTORS_DESCRIPTION            = "TOOL_GET_CONTRIBUTORS_DESCRIPTION"
+	TOOL_COMPARE_REPOSITORY_REFS_DESCRIPTION     = "TOOL_COMPARE_REPOSITORY_REFS_DESCRIPTION"
+	TOOL_GET_REPOSITORY_ARCHIVE_LINK_DESCRIPTION = "TOOL_GET_REPOSITORY_ARCHIVE_LINK_DESCRIPTION"
+
+	TOOL_LIST_NAMESPACES_DESCRIPTION = "TOOL_LIST_NAMESPACES_DESCRIPTION"
+	TOOL_GET_NAMESPACE_DESCRIPTION   = "TOOL_GET_NAMESPACE_DESCRIPTION"
+
+	// Requirements toolset (GitLab Premium)
+	TOOL_GET_PROJECT_REQUIREMENTS_DESCRIPTION = "TOOL_GET_PROJECT_REQUIREMENTS_DESCRIPTION"
+
+	TOOL_LIST_PROTECTED_BRANCHES_DESCRIPTION = "TOOL_LIST_PROTECTED_BRANCHES_DESCRIPTION"
+	TOOL_GET_PROTECTED_BRANCH_DESCRIPTION    = "TOOL_GET_PROTECTED_BRANCH_DESCRIPTION"
+	TOOL_PROTECT_BRANCH_DESCRIPTION          = "TOOL_PROTECT_BRANCH_DESCRIPTION"
+	TOOL_UNPROTECT_BRANCH_DESCRIPTION        = "TOOL_UNPROTECT_BRANCH_DESCRIPTION"
+
+	TOOL_LIST_PROTECTED_TAGS_DESCRIPTION = "TOOL_LIST_PROTECTED_TAGS_DESCRIPTION"
+	TOOL_PROTECT_TAG_DESCRIPTION         = "TOOL_PROTECT_TAG_DESCRIPTION"
+	TOOL_UNPROTECT_TAG_DESCRIPTION       = "TOOL_UNPROTECT_TAG_DESCRIPTION"
+
 	// Issues toolset
 	TOOL_GET_ISSUE_DESCRIPTION        = "TOOL_GET_ISSUE_DESCRIPTION"
 	TOOL_LIST_ISSUES_DESCRIPTION      = "TOOL_LIST_ISSUES_DESCRIPTION"
@@ -19,15 +61,110 @@ const (
 	TOOL_MILESTONE_DESCRIPTION        = "TOOL_MILESTONE_DESCRIPTION"
 	TOOL_LIST_MILESTONES_DESCRIPTION  = "TOOL_LIST_MILESTONES_DESCRIPTION"
 
+	TOOL_GET_ISSUE_RELATED_MERGE_REQUESTS_DESCRIPTION = "TOOL_GET_ISSUE_RELATED_MERGE_REQUESTS_DESCRIPTION"
+	TOOL_GET_ISSUE_CLOSING_MERGE_REQUESTS_DESCRIPTION = "TOOL_GET_ISSUE_CLOSING_MERGE_REQUESTS_DESCRIPTION"
+	TOOL_MOVE_ISSUE_DESCRIPTION                       = "TOOL_MOVE_ISSUE_DESCRIPTION"
+	TOOL_BULK_CREATE_ISSUES_DESCRIPTION               = "TOOL_BULK_CREATE_ISSUES_DESCRIPTION"
+	TOOL_BULK_CLOSE_ISSUES_DESCRIPTION                = "TOOL_BULK_CLOSE_ISSUES_DESCRIPTION"
+	TOOL_CLOSE_ISSUES_BY_MILESTONE_DESCRIPTION        = "TOOL_CLOSE_ISSUES_BY_MILESTONE_DESCRIPTION"
+	TOOL_GET_ISSUE_PARTICIPANTS_DESCRIPTION           = "TOOL_GET_ISSUE_PARTICIPANTS_DESCRIPTION"
+	TOOL_SUBSCRIBE_TO_ISSUE_DESCRIPTION               = "TOOL_SUBSCRIBE_TO_ISSUE_DESCRIPTION"
+	TOOL_UNSUBSCRIBE_FROM_ISSUE_DESCRIPTION           = "TOOL_UNSUBSCRIBE_FROM_ISSUE_DESCRIPTION"
+	TOOL_ADD_ISSUE_LABELS_DESCRIPTION                 = "TOOL_ADD_ISSUE_LABELS_DESCRIPTION"
+	TOOL_REMOVE_ISSUE_LABELS_DESCRIPTION              = "TOOL_REMOVE_ISSUE_LABELS_DESCRIPTION"
+	TOOL_SET_ISSUE_MILESTONE_DESCRIPTION              = "TOOL_SET_ISSUE_MILESTONE_DESCRIPTION"
+	TOOL_CLEAR_ISSUE_MILESTONE_DESCRIPTION            = "TOOL_CLEAR_ISSUE_MILESTONE_DESCRIPTION"
+	TOOL_SET_ISSUE_ASSIGNEES_DESCRIPTION              = "TOOL_SET_ISSUE_ASSIGNEES_DESCRIPTION"
+	TOOL_CLEAR_ISSUE_ASSIGNEES_DESCRIPTION            = "TOOL_CLEAR_ISSUE_ASSIGNEES_DESCRIPTION"
+	TOOL_CLOSE_ISSUE_DESCRIPTION                      = "TOOL_CLOSE_ISSUE_DESCRIPTION"
+	TOOL_REOPEN_ISSUE_DESCRIPTION                     = "TOOL_REOPEN_ISSUE_DESCRIPTION"
+
+	TOOL_LIST_PROJECT_MILESTONES_DESCRIPTION  = "TOOL_LIST_PROJECT_MILESTONES_DESCRIPTION"
+	TOOL_CREATE_PROJECT_MILESTONE_DESCRIPTION = "TOOL_CREATE_PROJECT_MILESTONE_DESCRIPTION"
+	TOOL_UPDATE_PROJECT_MILESTONE_DESCRIPTION = "TOOL_UPDATE_PROJECT_MILESTONE_DESCRIPTION"
+	TOOL_CLOSE_PROJECT_MILESTONE_DESCRIPTION  = "TOOL_CLOSE_PROJECT_MILESTONE_DESCRIPTION"
+	TOOL_LIST_GROUP_MILESTONES_DESCRIPTION    = "TOOL_LIST_GROUP_MILESTONES_DESCRIPTION"
+	TOOL_GET_GROUP_MILESTONE_DESCRIPTION      = "TOOL_GET_GROUP_MILESTONE_DESCRIPTION"
+	TOOL_CREATE_GROUP_MILESTONE_DESCRIPTION   = "TOOL_CREATE_GROUP_MILESTONE_DESCRIPTION"
+	TOOL_UPDATE_GROUP_MILESTONE_DESCRIPTION   = "TOOL_UPDATE_GROUP_MILESTONE_DESCRIPTION"
+	TOOL_LIST_GROUP_LABELS_DESCRIPTION        = "TOOL_LIST_GROUP_LABELS_DESCRIPTION"
+	TOOL_CREATE_GROUP_LABEL_DESCRIPTION       = "TOOL_CREATE_GROUP_LABEL_DESCRIPTION"
+	TOOL_UPDATE_GROUP_LABEL_DESCRIPTION       = "TOOL_UPDATE_GROUP_LABEL_DESCRIPTION"
+	TOOL_DELETE_GROUP_LABEL_DESCRIPTION       = "TOOL_DELETE_GROUP_LABEL_DESCRIPTION"
+
+	TOOL_LIST_ISSUE_DISCUSSIONS_DESCRIPTION  = "TOOL_LIST_ISSUE_DISCUSSIONS_DESCRIPTION"
+	TOOL_CREATE_ISSUE_DISCUSSION_DESCRIPTION = "TOOL_CREATE_ISSUE_DISCUSSION_DESCRIPTION"
+
+	TOOL_GET_TIME_TRACKING_STATS_DESCRIPTION = "TOOL_GET_TIME_TRACKING_STATS_DESCRIPTION"
+	TOOL_SET_TIME_ESTIMATE_DESCRIPTION       = "TOOL_SET_TIME_ESTIMATE_DESCRIPTION"
+	TOOL_RESET_TIME_ESTIMATE_DESCRIPTION     = "TOOL_RESET_TIME_ESTIMATE_DESCRIPTION"
+	TOOL_ADD_TIME_SPENT_DESCRIPTION          = "TOOL_ADD_TIME_SPENT_DESCRIPTION"
+
+	TOOL_LIST_ISSUE_AWARD_EMOJI_DESCRIPTION   = "TOOL_LIST_ISSUE_AWARD_EMOJI_DESCRIPTION"
+	TOOL_ADD_ISSUE_AWARD_EMOJI_DESCRIPTION    = "TOOL_ADD_ISSUE_AWARD_EMOJI_DESCRIPTION"
+	TOOL_DELETE_ISSUE_AWARD_EMOJI_DESCRIPTION = "TOOL_DELETE_ISSUE_AWARD_EMOJI_DESCRIPTION"
+
+	TOOL_LIST_PROJECT_BOARDS_DESCRIPTION      = "TOOL_LIST_PROJECT_BOARDS_DESCRIPTION"
+	TOOL_GET_PROJECT_BOARD_DESCRIPTION        = "TOOL_GET_PROJECT_BOARD_DESCRIPTION"
+	TOOL_LIST_PROJECT_BOARD_LISTS_DESCRIPTION = "TOOL_LIST_PROJECT_BOARD_LISTS_DESCRIPTION"
+	TOOL_CREATE_BOARD_LIST_DESCRIPTION        = "TOOL_CREATE_BOARD_LIST_DESCRIPTION"
+	TOOL_DELETE_BOARD_LIST_DESCRIPTION        = "TOOL_DELETE_BOARD_LIST_DESCRIPTION"
+
+	TOOL_LIST_EPICS_DESCRIPTION             = "TOOL_LIST_EPICS_DESCRIPTION"
+	TOOL_GET_EPIC_DESCRIPTION               = "TOOL_GET_EPIC_DESCRIPTION"
+	TOOL_CREATE_EPIC_DESCRIPTION            = "TOOL_CREATE_EPIC_DESCRIPTION"
+	TOOL_UPDATE_EPIC_DESCRIPTION            = "TOOL_UPDATE_EPIC_DESCRIPTION"
+	TOOL_LIST_EPIC_ISSUES_DESCRIPTION       = "TOOL_LIST_EPIC_ISSUES_DESCRIPTION"
+	TOOL_ADD_EPIC_ISSUE_DESCRIPTION         = "TOOL_ADD_EPIC_ISSUE_DESCRIPTION"
+	TOOL_REMOVE_EPIC_ISSUE_DESCRIPTION      = "TOOL_REMOVE_EPIC_ISSUE_DESCRIPTION"
+	TOOL_LIST_GROUP_EPIC_BOARDS_DESCRIPTION = "TOOL_LIST_GROUP_EPIC_BOARDS_DESCRIPTION"
+	TOOL_GET_GROUP_EPIC_BOARD_DESCRIPTION   = "TOOL_GET_GROUP_EPIC_BOARD_DESCRIPTION"
+	TOOL_LIST_EPIC_BOARD_LISTS_DESCRIPTION  = "TOOL_LIST_EPIC_BOARD_LISTS_DESCRIPTION"
+
 	// Merge Requests toolset
-	TOOL_GET_MERGE_REQUEST_DESCRIPTION     = "TOOL_GET_MERGE_REQUEST_DESCRIPTION"
-	TOOL_LIST_MERGE_REQUESTS_DESCRIPTION   = "TOOL_LIST_MERGE_REQUESTS_DESCRIPTION"
-	TOOL_CREATE_MERGE_REQUEST_DESCRIPTION  = "TOOL_CREATE_MERGE_REQUEST_DESCRIPTION"
-	TOOL_UPDATE_MERGE_REQUEST_DESCRIPTION  = "TOOL_UPDATE_MERGE_REQUEST_DESCRIPTION"
-	TOOL_MERGE_REQUEST_COMMENT_DESCRIPTION = "TOOL_MERGE_REQUEST_COMMENT_DESCRIPTION"
+	TOOL_GET_MERGE_REQUEST_DESCRIPTION                 = "TOOL_GET_MERGE_REQUEST_DESCRIPTION"
+	TOOL_LIST_MERGE_REQUESTS_DESCRIPTION               = "TOOL_LIST_MERGE_REQUESTS_DESCRIPTION"
+	TOOL_CREATE_MERGE_REQUEST_DESCRIPTION              = "TOOL_CREATE_MERGE_REQUEST_DESCRIPTION"
+	TOOL_UPDATE_MERGE_REQUEST_DESCRIPTION              = "TOOL_UPDATE_MERGE_REQUEST_DESCRIPTION"
+	TOOL_MERGE_REQUEST_COMMENT_DESCRIPTION             = "TOOL_MERGE_REQUEST_COMMENT_DESCRIPTION"
+	TOOL_MERGE_MERGE_REQUEST_DESCRIPTION               = "TOOL_MERGE_MERGE_REQUEST_DESCRIPTION"
+	TOOL_REBASE_MERGE_REQUEST_DESCRIPTION              = "TOOL_REBASE_MERGE_REQUEST_DESCRIPTION"
+	TOOL_GET_MERGE_REQUEST_DIFF_DESCRIPTION            = "TOOL_GET_MERGE_REQUEST_DIFF_DESCRIPTION"
+	TOOL_GET_MERGE_REQUEST_DIFF_STATS_DESCRIPTION      = "TOOL_GET_MERGE_REQUEST_DIFF_STATS_DESCRIPTION"
+	TOOL_LIST_MERGE_REQUEST_APPROVALS_DESCRIPTION      = "TOOL_LIST_MERGE_REQUEST_APPROVALS_DESCRIPTION"
+	TOOL_GET_MERGE_REQUEST_APPROVAL_STATE_DESCRIPTION  = "TOOL_GET_MERGE_REQUEST_APPROVAL_STATE_DESCRIPTION"
+	TOOL_APPROVE_MERGE_REQUEST_DESCRIPTION             = "TOOL_APPROVE_MERGE_REQUEST_DESCRIPTION"
+	TOOL_UNAPPROVE_MERGE_REQUEST_DESCRIPTION           = "TOOL_UNAPPROVE_MERGE_REQUEST_DESCRIPTION"
+	TOOL_LIST_APPROVAL_RULES_DESCRIPTION               = "TOOL_LIST_APPROVAL_RULES_DESCRIPTION"
+	TOOL_CREATE_APPROVAL_RULE_DESCRIPTION              = "TOOL_CREATE_APPROVAL_RULE_DESCRIPTION"
+	TOOL_LIST_MERGE_REQUEST_REVIEWERS_DESCRIPTION      = "TOOL_LIST_MERGE_REQUEST_REVIEWERS_DESCRIPTION"
+	TOOL_ADD_MERGE_REQUEST_REVIEWER_DESCRIPTION        = "TOOL_ADD_MERGE_REQUEST_REVIEWER_DESCRIPTION"
+	TOOL_REMOVE_MERGE_REQUEST_REVIEWER_DESCRIPTION     = "TOOL_REMOVE_MERGE_REQUEST_REVIEWER_DESCRIPTION"
+	TOOL_GET_MERGE_REQUEST_PARTICIPANTS_DESCRIPTION    = "TOOL_GET_MERGE_REQUEST_PARTICIPANTS_DESCRIPTION"
+	TOOL_SUBSCRIBE_TO_MERGE_REQUEST_DESCRIPTION        = "TOOL_SUBSCRIBE_TO_MERGE_REQUEST_DESCRIPTION"
+	TOOL_UNSUBSCRIBE_FROM_MERGE_REQUEST_DESCRIPTION    = "TOOL_UNSUBSCRIBE_FROM_MERGE_REQUEST_DESCRIPTION"
+	TOOL_GET_MERGE_REQUEST_PIPELINES_DESCRIPTION       = "TOOL_GET_MERGE_REQUEST_PIPELINES_DESCRIPTION"
+	TOOL_GET_LATEST_MERGE_REQUEST_PIPELINE_DESCRIPTION = "TOOL_GET_LATEST_MERGE_REQUEST_PIPELINE_DESCRIPTION"
+
+	TOOL_LIST_MERGE_REQUEST_DISCUSSIONS_DESCRIPTION   = "TOOL_LIST_MERGE_REQUEST_DISCUSSIONS_DESCRIPTION"
+	TOOL_CREATE_MERGE_REQUEST_DISCUSSION_DESCRIPTION  = "TOOL_CREATE_MERGE_REQUEST_DISCUSSION_DESCRIPTION"
+	TOOL_RESOLVE_MERGE_REQUEST_DISCUSSION_DESCRIPTION = "TOOL_RESOLVE_MERGE_REQUEST_DISCUSSION_DESCRIPTION"
+
+	TOOL_LIST_MERGE_REQUEST_AWARD_EMOJI_DESCRIPTION   = "TOOL_LIST_MERGE_REQUEST_AWARD_EMOJI_DESCRIPTION"
+	TOOL_ADD_MERGE_REQUEST_AWARD_EMOJI_DESCRIPTION    = "TOOL_ADD_MERGE_REQUEST_AWARD_EMOJI_DESCRIPTION"
+	TOOL_DELETE_MERGE_REQUEST_AWARD_EMOJI_DESCRIPTION = "TOOL_DELETE_MERGE_REQUEST_AWARD_EMOJI_DESCRIPTION"
+
+	// Resource events (issues and merge requests toolsets)
+	TOOL_LIST_ISSUE_STATE_EVENTS_DESCRIPTION                     = "TOOL_LIST_ISSUE_STATE_EVENTS_DESCRIPTION"
+	TOOL_LIST_MERGE_REQUEST_STATE_EVENTS_DESCRIPTION             = "TOOL_LIST_MERGE_REQUEST_STATE_EVENTS_DESCRIPTION"
+	TOOL_GET_ISSUE_RESOURCE_LABEL_EVENTS_DESCRIPTION             = "TOOL_GET_ISSUE_RESOURCE_LABEL_EVENTS_DESCRIPTION"
+	TOOL_GET_MERGE_REQUEST_RESOURCE_LABEL_EVENTS_DESCRIPTION     = "TOOL_GET_MERGE_REQUEST_RESOURCE_LABEL_EVENTS_DESCRIPTION"
+	TOOL_GET_ISSUE_RESOURCE_MILESTONE_EVENTS_DESCRIPTION         = "TOOL_GET_ISSUE_RESOURCE_MILESTONE_EVENTS_DESCRIPTION"
+	TOOL_GET_MERGE_REQUEST_RESOURCE_MILESTONE_EVENTS_DESCRIPTION = "TOOL_GET_MERGE_REQUEST_RESOURCE_MILESTONE_EVENTS_DESCRIPTION"
 
 	// Search toolset
-	TOOL_SEARCH_DESCRIPTION = "TOOL_SEARCH_DESCRIPTION"
+	TOOL_SEARCH_DESCRIPTION       = "TOOL_SEARCH_DESCRIPTION"
+	TOOL_SEARCH_USERS_DESCRIPTION = "TOOL_SEARCH_USERS_DESCRIPTION"
 
 	// Users toolset
 	TOOL_GET_CURRENT_USER_DESCRIPTION   = "TOOL_GET_CURRENT_USER_DESCRIPTION"
@@ -37,6 +174,10 @@ const (
 	TOOL_LIST_PROJECT_USERS_DESCRIPTION = "TOOL_LIST_PROJECT_USERS_DESCRIPTION"
 	TOOL_MANAGE_USER_STATE_DESCRIPTION  = "TOOL_MANAGE_USER_STATE_DESCRIPTION"
 
+	TOOL_LIST_TODOS_DESCRIPTION          = "TOOL_LIST_TODOS_DESCRIPTION"
+	TOOL_MARK_TODO_DONE_DESCRIPTION      = "TOOL_MARK_TODO_DONE_DESCRIPTION"
+	TOOL_MARK_ALL_TODOS_DONE_DESCRIPTION = "TOOL_MARK_ALL_TODOS_DONE_DESCRIPTION"
+
 	// Security toolset
 	TOOL_GET_PROJECT_SAST_DESCRIPTION                = "TOOL_GET_PROJECT_SAST_DESCRIPTION"
 	TOOL_GET_PROJECT_DAST_DESCRIPTION                = "TOOL_GET_PROJECT_DAST_DESCRIPTION"
@@ -49,11 +190,85 @@ const (
 	TOOL_TAG_DESCRIPTION                  = "TOOL_TAG_DESCRIPTION"
 	TOOL_LIST_REPOSITORY_TAGS_DESCRIPTION = "TOOL_LIST_REPOSITORY_TAGS_DESCRIPTION"
 
+	// Environments toolset
+	TOOL_LIST_ENVIRONMENTS_DESCRIPTION   = "TOOL_LIST_ENVIRONMENTS_DESCRIPTION"
+	TOOL_CREATE_ENVIRONMENT_DESCRIPTION  = "TOOL_CREATE_ENVIRONMENT_DESCRIPTION"
+	TOOL_STOP_ENVIRONMENT_DESCRIPTION    = "TOOL_STOP_ENVIRONMENT_DESCRIPTION"
+	TOOL_LIST_DEPLOYMENTS_DESCRIPTION    = "TOOL_LIST_DEPLOYMENTS_DESCRIPTION"
+	TOOL_GET_DEPLOYMENT_DESCRIPTION      = "TOOL_GET_DEPLOYMENT_DESCRIPTION"
+	TOOL_LIST_FEATURE_FLAGS_DESCRIPTION  = "TOOL_LIST_FEATURE_FLAGS_DESCRIPTION"
+	TOOL_CREATE_FEATURE_FLAG_DESCRIPTION = "TOOL_CREATE_FEATURE_FLAG_DESCRIPTION"
+	TOOL_UPDATE_FEATURE_FLAG_DESCRIPTION = "TOOL_UPDATE_FEATURE_FLAG_DESCRIPTION"
+	TOOL_DELETE_FEATURE_FLAG_DESCRIPTION = "TOOL_DELETE_FEATURE_FLAG_DESCRIPTION"
+
+	// Releases toolset
+	TOOL_LIST_RELEASES_DESCRIPTION  = "TOOL_LIST_RELEASES_DESCRIPTION"
+	TOOL_GET_RELEASE_DESCRIPTION    = "TOOL_GET_RELEASE_DESCRIPTION"
+	TOOL_CREATE_RELEASE_DESCRIPTION = "TOOL_CREATE_RELEASE_DESCRIPTION"
+	TOOL_UPDATE_RELEASE_DESCRIPTION = "TOOL_UPDATE_RELEASE_DESCRIPTION"
+	TOOL_DELETE_RELEASE_DESCRIPTION = "TOOL_DELETE_RELEASE_DESCRIPTION"
+
+	TOOL_LIST_RELEASE_LINKS_DESCRIPTION  = "TOOL_LIST_RELEASE_LINKS_DESCRIPTION"
+	TOOL_CREATE_RELEASE_LINK_DESCRIPTION = "TOOL_CREATE_RELEASE_LINK_DESCRIPTION"
+	TOOL_DELETE_RELEASE_LINK_DESCRIPTION = "TOOL_DELETE_RELEASE_LINK_DESCRIPTION"
+
+	// Wikis toolset
+	TOOL_LIST_WIKI_PAGES_DESCRIPTION  = "TOOL_LIST_WIKI_PAGES_DESCRIPTION"
+	TOOL_GET_WIKI_PAGE_DESCRIPTION    = "TOOL_GET_WIKI_PAGE_DESCRIPTION"
+	TOOL_CREATE_WIKI_PAGE_DESCRIPTION = "TOOL_CREATE_WIKI_PAGE_DESCRIPTION"
+	TOOL_UPDATE_WIKI_PAGE_DESCRIPTION = "TOOL_UPDATE_WIKI_PAGE_DESCRIPTION"
+	TOOL_DELETE_WIKI_PAGE_DESCRIPTION = "TOOL_DELETE_WIKI_PAGE_DESCRIPTION"
+
+	// Snippets toolset
+	TOOL_LIST_PROJECT_SNIPPETS_DESCRIPTION = "TOOL_LIST_PROJECT_SNIPPETS_DESCRIPTION"
+	TOOL_GET_SNIPPET_DESCRIPTION           = "TOOL_GET_SNIPPET_DESCRIPTION"
+	TOOL_CREATE_SNIPPET_DESCRIPTION        = "TOOL_CREATE_SNIPPET_DESCRIPTION"
+	TOOL_UPDATE_SNIPPET_DESCRIPTION        = "TOOL_UPDATE_SNIPPET_DESCRIPTION"
+	TOOL_DELETE_SNIPPET_DESCRIPTION        = "TOOL_DELETE_SNIPPET_DESCRIPTION"
+
+	// Packages toolset
+	TOOL_LIST_PACKAGES_DESCRIPTION                        = "TOOL_LIST_PACKAGES_DESCRIPTION"
+	TOOL_GET_PACKAGE_DESCRIPTION                          = "TOOL_GET_PACKAGE_DESCRIPTION"
+	TOOL_DELETE_PACKAGE_DESCRIPTION                       = "TOOL_DELETE_PACKAGE_DESCRIPTION"
+	TOOL_LIST_CONTAINER_REGISTRY_REPOSITORIES_DESCRIPTION = "TOOL_LIST_CONTAINER_REGISTRY_REPOSITORIES_DESCRIPTION"
+	TOOL_LIST_CONTAINER_REGISTRY_TAGS_DESCRIPTION         = "TOOL_LIST_CONTAINER_REGISTRY_TAGS_DESCRIPTION"
+	TOOL_DELETE_CONTAINER_REGISTRY_TAG_DESCRIPTION        = "TOOL_DELETE_CONTAINER_REGISTRY_TAG_DESCRIPTION"
+
+	// Variables toolset
+	TOOL_LIST_PROJECT_VARIABLES_DESCRIPTION  = "TOOL_LIST_PROJECT_VARIABLES_DESCRIPTION"
+	TOOL_CREATE_PROJECT_VARIABLE_DESCRIPTION = "TOOL_CREATE_PROJECT_VARIABLE_DESCRIPTION"
+	TOOL_UPDATE_PROJECT_VARIABLE_DESCRIPTION = "TOOL_UPDATE_PROJECT_VARIABLE_DESCRIPTION"
+	TOOL_DELETE_PROJECT_VARIABLE_DESCRIPTION = "TOOL_DELETE_PROJECT_VARIABLE_DESCRIPTION"
+	TOOL_LIST_GROUP_VARIABLES_DESCRIPTION    = "TOOL_LIST_GROUP_VARIABLES_DESCRIPTION"
+	TOOL_GET_GROUP_VARIABLE_DESCRIPTION      = "TOOL_GET_GROUP_VARIABLE_DESCRIPTION"
+	TOOL_CREATE_GROUP_VARIABLE_DESCRIPTION   = "TOOL_CREATE_GROUP_VARIABLE_DESCRIPTION"
+	TOOL_UPDATE_GROUP_VARIABLE_DESCRIPTION   = "TOOL_UPDATE_GROUP_VARIABLE_DESCRIPTION"
+	TOOL_DELETE_GROUP_VARIABLE_DESCRIPTION   = "TOOL_DELETE_GROUP_VARIABLE_DESCRIPTION"
+
 	// Pipeline Jobs toolset
 	TOOL_PIPELINE_JOB_DESCRIPTION       = "TOOL_PIPELINE_JOB_DESCRIPTION"
 	TOOL_PIPELINE_DESCRIPTION           = "TOOL_PIPELINE_DESCRIPTION"
 	TOOL_RETRY_PIPELINE_JOB_DESCRIPTION = "TOOL_RETRY_PIPELINE_JOB_DESCRIPTION"
 	TOOL_PLAY_PIPELINE_JOB_DESCRIPTION  = "TOOL_PLAY_PIPELINE_JOB_DESCRIPTION"
+	TOOL_LINT_CI_CONFIG_DESCRIPTION     = "TOOL_LINT_CI_CONFIG_DESCRIPTION"
+
+	TOOL_LIST_SCHEDULED_PIPELINES_DESCRIPTION  = "TOOL_LIST_SCHEDULED_PIPELINES_DESCRIPTION"
+	TOOL_CREATE_SCHEDULED_PIPELINE_DESCRIPTION = "TOOL_CREATE_SCHEDULED_PIPELINE_DESCRIPTION"
+	TOOL_UPDATE_SCHEDULED_PIPELINE_DESCRIPTION = "TOOL_UPDATE_SCHEDULED_PIPELINE_DESCRIPTION"
+	TOOL_DELETE_SCHEDULED_PIPELINE_DESCRIPTION = "TOOL_DELETE_SCHEDULED_PIPELINE_DESCRIPTION"
+	TOOL_RUN_SCHEDULED_PIPELINE_DESCRIPTION    = "TOOL_RUN_SCHEDULED_PIPELINE_DESCRIPTION"
+
+	TOOL_GET_TEST_REPORT_DESCRIPTION           = "TOOL_GET_TEST_REPORT_DESCRIPTION"
+	TOOL_GET_PIPELINE_TEST_SUMMARY_DESCRIPTION = "TOOL_GET_PIPELINE_TEST_SUMMARY_DESCRIPTION"
+	TOOL_GET_PIPELINE_BRIDGES_DESCRIPTION      = "TOOL_GET_PIPELINE_BRIDGES_DESCRIPTION"
+
+	TOOL_LIST_JOB_ARTIFACTS_DESCRIPTION    = "TOOL_LIST_JOB_ARTIFACTS_DESCRIPTION"
+	TOOL_DOWNLOAD_JOB_ARTIFACT_DESCRIPTION = "TOOL_DOWNLOAD_JOB_ARTIFACT_DESCRIPTION"
+
+	TOOL_LIST_RUNNERS_DESCRIPTION           = "TOOL_LIST_RUNNERS_DESCRIPTION"
+	TOOL_GET_RUNNER_DESCRIPTION             = "TOOL_GET_RUNNER_DESCRIPTION"
+	TOOL_ENABLE_PROJECT_RUNNER_DESCRIPTION  = "TOOL_ENABLE_PROJECT_RUNNER_DESCRIPTION"
+	TOOL_DISABLE_PROJECT_RUNNER_DESCRIPTION = "TOOL_DISABLE_PROJECT_RUNNER_DESCRIPTION"
 
 	// Token management toolset
 	TOOL_LIST_TOKENS_DESCRIPTION       = "TOOL_LIST_TOKENS_DESCRIPTION"
@@ -62,4 +277,8 @@ const (
 	TOOL_REMOVE_TOKEN_DESCRIPTION      = "TOOL_REMOVE_TOKEN_DESCRIPTION"
 	TOOL_VALIDATE_TOKEN_DESCRIPTION    = "TOOL_VALIDATE_TOKEN_DESCRIPTION"
 	TOOL_GET_NOTIFICATIONS_DESCRIPTION = "TOOL_GET_NOTIFICATIONS_DESCRIPTION"
+	TOOL_GET_TOKEN_INFO_DESCRIPTION    = "TOOL_GET_TOKEN_INFO_DESCRIPTION"
+
+	// Server metadata (always registered)
+	TOOL_GET_SERVER_METADATA_DESCRIPTION = "TOOL_GET_SERVER_METADATA_DESCRIPTION"
 )